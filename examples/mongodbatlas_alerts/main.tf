@@ -0,0 +1,22 @@
+data "mongodbatlas_alerts" "open" {
+  project_id   = var.project_id
+  status       = "OPEN"
+  acknowledged = false
+  event_types  = ["OUTSIDE_METRIC_THRESHOLD", "REPLICATION_OPLOG_WINDOW_RUNNING_OUT"]
+}
+
+check "no_unacknowledged_alerts" {
+  assert {
+    condition     = length(data.mongodbatlas_alerts.open.results) == 0
+    error_message = "Project has open alerts: ${join(", ", [for alert in data.mongodbatlas_alerts.open.results : alert.alert_id])}"
+  }
+}
+
+output "open_alerts" {
+  value = [for alert in data.mongodbatlas_alerts.open.results : {
+    alert_id        = alert.alert_id
+    event_type_name = alert.event_type_name
+    cluster_name    = alert.cluster_name
+    created         = alert.created
+  }]
+}
@@ -0,0 +1,28 @@
+data "mongodbatlas_cidr_check" "this" {
+  atlas_cidr_block = var.atlas_cidr_block
+  cidr_blocks      = [var.aws_vpc_cidr_block]
+}
+
+resource "mongodbatlas_network_container" "this" {
+  project_id       = var.project_id
+  atlas_cidr_block = var.atlas_cidr_block
+  provider_name    = "AWS"
+  region_name      = "US_EAST_1"
+
+  lifecycle {
+    precondition {
+      condition     = data.mongodbatlas_cidr_check.this.valid
+      error_message = join(", ", data.mongodbatlas_cidr_check.this.errors)
+    }
+  }
+}
+
+resource "mongodbatlas_network_peering" "this" {
+  project_id             = var.project_id
+  container_id           = mongodbatlas_network_container.this.container_id
+  accepter_region_name   = "us-east-1"
+  provider_name          = "AWS"
+  route_table_cidr_block = var.aws_vpc_cidr_block
+  vpc_id                 = var.aws_vpc_id
+  aws_account_id         = var.aws_account_id
+}
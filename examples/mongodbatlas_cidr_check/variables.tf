@@ -0,0 +1,30 @@
+variable "project_id" {
+  description = "Unique 24-hexadecimal digit string that identifies your project"
+  type        = string
+}
+
+variable "public_key" {
+  description = "Public API key to authenticate to Atlas"
+  type        = string
+}
+variable "private_key" {
+  description = "Private API key to authenticate to Atlas"
+  type        = string
+}
+variable "atlas_cidr_block" {
+  description = "CIDR block that Atlas uses for the network container"
+  type        = string
+  default     = "10.8.0.0/21"
+}
+variable "aws_vpc_cidr_block" {
+  description = "CIDR block of the AWS VPC to peer with"
+  type        = string
+}
+variable "aws_vpc_id" {
+  description = "ID of the AWS VPC to peer with"
+  type        = string
+}
+variable "aws_account_id" {
+  description = "ID of the AWS account that owns the VPC"
+  type        = string
+}
@@ -0,0 +1,40 @@
+data "aws_caller_identity" "current" {}
+
+resource "mongodbatlas_cloud_provider_access_role" "aws" {
+  project_id          = var.project_id
+  provider_name       = "AWS"
+  defer_authorization = true
+
+  aws = {
+    iam_assumed_role_arn = "arn:aws:iam::${data.aws_caller_identity.current.account_id}:role/${var.aws_iam_role_name}"
+  }
+}
+
+resource "aws_iam_role" "atlas" {
+  name = var.aws_iam_role_name
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect    = "Allow"
+      Principal = { AWS = mongodbatlas_cloud_provider_access_role.aws.aws.atlas_aws_account_arn }
+      Action    = "sts:AssumeRole"
+      Condition = {
+        StringEquals = { "sts:ExternalId" = mongodbatlas_cloud_provider_access_role.aws.aws.atlas_assumed_role_external_id }
+      }
+    }]
+  })
+}
+
+resource "mongodbatlas_cloud_provider_access_role" "gcp" {
+  project_id    = var.project_id
+  provider_name = "GCP"
+}
+
+output "aws_authorization_status" {
+  value = mongodbatlas_cloud_provider_access_role.aws.authorization_status
+}
+
+output "gcp_service_account_for_atlas" {
+  value = mongodbatlas_cloud_provider_access_role.gcp.gcp.service_account_for_atlas
+}
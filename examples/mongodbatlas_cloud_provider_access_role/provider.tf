@@ -0,0 +1,8 @@
+provider "mongodbatlas" {
+  public_key  = var.public_key
+  private_key = var.private_key
+}
+
+provider "aws" {
+  region = var.aws_region
+}
@@ -0,0 +1,20 @@
+variable "public_key" {
+  description = "Public API key to authenticate to Atlas"
+  type        = string
+}
+variable "private_key" {
+  description = "Private API key to authenticate to Atlas"
+  type        = string
+}
+variable "project_id" {
+  description = "Atlas Project ID"
+  type        = string
+}
+variable "aws_region" {
+  description = "AWS region"
+  type        = string
+}
+variable "aws_iam_role_name" {
+  description = "Name of the IAM role that Atlas assumes"
+  type        = string
+}
@@ -4,3 +4,13 @@ data "mongodbatlas_control_plane_ip_addresses" "test" {
 output "outbound-aws-ip-addresses" {
   value = data.mongodbatlas_control_plane_ip_addresses.test.outbound.aws
 }
+
+data "mongodbatlas_control_plane_ip_addresses" "outbound_aws" {
+  direction = "outbound"
+  providers = ["AWS"]
+  regions   = ["us-east-1", "us-west-2"]
+}
+
+output "outbound-aws-firewall-cidr-blocks" {
+  value = data.mongodbatlas_control_plane_ip_addresses.outbound_aws.aggregated_cidr_blocks
+}
@@ -0,0 +1,52 @@
+locals {
+  roles = {
+    reporting = {
+      actions         = [{ action = "FIND", database_name = "sales", collection_name = "orders" }]
+      inherited_roles = []
+    }
+    analytics = {
+      actions         = [{ action = "FIND", database_name = "sales", collection_name = "customers" }]
+      inherited_roles = [{ database_name = "admin", role_name = "reporting" }]
+    }
+  }
+}
+
+data "mongodbatlas_custom_db_role_inheritance_check" "this" {
+  project_id = var.project_id
+  roles = [for name, role in local.roles : {
+    role_name       = name
+    inherited_roles = role.inherited_roles
+  }]
+}
+
+resource "mongodbatlas_custom_db_role" "this" {
+  for_each   = local.roles
+  project_id = var.project_id
+  role_name  = each.key
+
+  dynamic "actions" {
+    for_each = each.value.actions
+    content {
+      action = actions.value.action
+      resources {
+        database_name   = actions.value.database_name
+        collection_name = actions.value.collection_name
+      }
+    }
+  }
+
+  dynamic "inherited_roles" {
+    for_each = each.value.inherited_roles
+    content {
+      database_name = inherited_roles.value.database_name
+      role_name     = inherited_roles.value.role_name
+    }
+  }
+
+  lifecycle {
+    precondition {
+      condition     = data.mongodbatlas_custom_db_role_inheritance_check.this.valid
+      error_message = join(", ", data.mongodbatlas_custom_db_role_inheritance_check.this.errors)
+    }
+  }
+}
@@ -0,0 +1,25 @@
+data "mongodbatlas_database_user_effective_privileges" "this" {
+  project_id         = var.project_id
+  auth_database_name = "admin"
+  username           = var.username
+  cluster_name       = var.cluster_name
+}
+
+check "no_drop_in_production" {
+  assert {
+    condition = !anytrue([
+      for p in data.mongodbatlas_database_user_effective_privileges.this.privileges :
+      contains(["DROP_COLLECTION", "DROP_DATABASE"], p.action) && contains(["", "production"], p.database_name)
+    ])
+    error_message = "User ${var.username} can drop collections in the production database."
+  }
+
+  assert {
+    condition     = length(data.mongodbatlas_database_user_effective_privileges.this.unresolved_roles) == 0
+    error_message = "Some roles of ${var.username} couldn't be resolved: ${join(", ", data.mongodbatlas_database_user_effective_privileges.this.unresolved_roles)}"
+  }
+}
+
+output "privileges" {
+  value = [for p in data.mongodbatlas_database_user_effective_privileges.this.privileges : p.cluster ? "${p.action} on cluster" : "${p.action} on ${p.database_name == "" ? "*" : p.database_name}.${p.collection_name == "" ? "*" : p.collection_name}"]
+}
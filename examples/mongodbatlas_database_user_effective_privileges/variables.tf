@@ -0,0 +1,20 @@
+variable "public_key" {
+  description = "Public API key to authenticate to Atlas"
+  type        = string
+}
+variable "private_key" {
+  description = "Private API key to authenticate to Atlas"
+  type        = string
+}
+variable "project_id" {
+  description = "Atlas Project ID"
+  type        = string
+}
+variable "username" {
+  description = "Username of the database user to review"
+  type        = string
+}
+variable "cluster_name" {
+  description = "Name of the cluster where the privileges are checked"
+  type        = string
+}
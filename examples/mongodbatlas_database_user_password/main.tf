@@ -0,0 +1,23 @@
+ephemeral "mongodbatlas_database_user_password" "app" {
+  length = 32
+}
+
+resource "mongodbatlas_database_user" "app" {
+  project_id          = var.project_id
+  username            = var.username
+  auth_database_name  = "admin"
+  password_wo         = ephemeral.mongodbatlas_database_user_password.app.password
+  password_wo_version = 1
+  rotation_days       = var.rotation_days
+
+  roles {
+    role_name     = "readWrite"
+    database_name = "app"
+  }
+}
+
+# The password is sent to Atlas only when the user is created, password_wo_version changes or rotation_days have elapsed since
+# password_last_rotated. Store it in the same run using write-only attributes of other providers, versioned with password_last_rotated.
+output "password_last_rotated" {
+  value = mongodbatlas_database_user.app.password_last_rotated
+}
@@ -0,0 +1,21 @@
+variable "public_key" {
+  description = "Public API key to authenticate to Atlas"
+  type        = string
+}
+variable "private_key" {
+  description = "Private API key to authenticate to Atlas"
+  type        = string
+}
+variable "project_id" {
+  description = "Atlas Project ID"
+  type        = string
+}
+variable "username" {
+  description = "Username of the database user"
+  type        = string
+}
+variable "rotation_days" {
+  description = "Number of days after which the password is rotated"
+  type        = number
+  default     = 30
+}
@@ -0,0 +1,20 @@
+resource "mongodbatlas_database_users_set" "services" {
+  project_id      = var.project_id
+  max_concurrency = 10
+
+  users = {
+    for service in var.services : var.aws_iam_role_arns[service] => {
+      auth_database_name = "$external"
+      aws_iam_type       = "ROLE"
+      roles = [
+        {
+          role_name     = "readWrite"
+          database_name = service
+        },
+      ]
+      labels = {
+        service = service
+      }
+    }
+  }
+}
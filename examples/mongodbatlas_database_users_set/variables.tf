@@ -0,0 +1,20 @@
+variable "public_key" {
+  description = "Public API key to authenticate to Atlas"
+  type        = string
+}
+variable "private_key" {
+  description = "Private API key to authenticate to Atlas"
+  type        = string
+}
+variable "project_id" {
+  description = "Atlas Project ID"
+  type        = string
+}
+variable "services" {
+  description = "Name of the services that need a database user, each user can read and write the database with the same name"
+  type        = set(string)
+}
+variable "aws_iam_role_arns" {
+  description = "ARN of the AWS IAM role used by each service, keyed by service name"
+  type        = map(string)
+}
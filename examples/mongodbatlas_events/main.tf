@@ -0,0 +1,20 @@
+data "mongodbatlas_events" "cluster_changes" {
+  project_id  = var.project_id
+  event_types = ["CLUSTER_CREATED", "CLUSTER_UPDATE_COMPLETED"]
+  min_date    = var.min_date
+}
+
+check "cluster_update_completed" {
+  assert {
+    condition     = contains([for event in data.mongodbatlas_events.cluster_changes.results : event.event_type_name], "CLUSTER_UPDATE_COMPLETED")
+    error_message = "No cluster update completed since ${var.min_date}"
+  }
+}
+
+output "cluster_events" {
+  value = [for event in data.mongodbatlas_events.cluster_changes.results : {
+    event_type_name = event.event_type_name
+    created         = event.created
+    username        = event.username
+  }]
+}
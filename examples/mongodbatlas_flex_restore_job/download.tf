@@ -0,0 +1,10 @@
+ephemeral "mongodbatlas_flex_snapshot_download" "download" {
+  project_id  = var.project_id
+  name        = var.source_cluster_name
+  snapshot_id = data.mongodbatlas_flex_snapshots.snapshots.results[0].snapshot_id
+}
+
+# The download link is only available during the Terraform run, pass it to other ephemeral contexts such as provisioners or ephemeral resources of other providers.
+locals {
+  snapshot_download_url = ephemeral.mongodbatlas_flex_snapshot_download.download.snapshot_url
+}
@@ -0,0 +1,20 @@
+data "mongodbatlas_flex_snapshots" "snapshots" {
+  project_id = var.project_id
+  name       = var.source_cluster_name
+}
+
+resource "mongodbatlas_flex_restore_job" "restore" {
+  project_id                  = var.project_id
+  name                        = var.source_cluster_name
+  snapshot_id                 = data.mongodbatlas_flex_snapshots.snapshots.results[0].snapshot_id
+  target_deployment_item_name = var.target_cluster_name
+  target_project_id           = var.target_project_id
+
+  timeouts = {
+    create = "30m"
+  }
+}
+
+output "restore_job_status" {
+  value = mongodbatlas_flex_restore_job.restore.status
+}
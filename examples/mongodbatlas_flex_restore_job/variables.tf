@@ -0,0 +1,24 @@
+variable "public_key" {
+  description = "Public API key to authenticate to Atlas"
+  type        = string
+}
+variable "private_key" {
+  description = "Private API key to authenticate to Atlas"
+  type        = string
+}
+variable "project_id" {
+  description = "Atlas Project ID of the source flex cluster"
+  type        = string
+}
+variable "source_cluster_name" {
+  description = "Name of the flex cluster whose snapshot is restored"
+  type        = string
+}
+variable "target_cluster_name" {
+  description = "Name of the flex or dedicated cluster where the snapshot is restored"
+  type        = string
+}
+variable "target_project_id" {
+  description = "Atlas Project ID of the target cluster"
+  type        = string
+}
@@ -0,0 +1,19 @@
+data "mongodbatlas_network_health" "this" {
+  project_id = var.project_id
+}
+
+check "network_health" {
+  assert {
+    condition     = data.mongodbatlas_network_health.this.healthy
+    error_message = "Network of project ${var.project_id} is not healthy: ${jsonencode([for peer in data.mongodbatlas_network_health.this.network_peering : "${peer.peering_id} is ${peer.status} ${peer.error_state}" if !peer.healthy])}"
+  }
+
+  assert {
+    condition     = alltrue([for cluster in data.mongodbatlas_network_health.this.clusters : cluster.reachable if contains(var.private_clusters, cluster.cluster_name)])
+    error_message = "Some clusters can't be reached through a private network path."
+  }
+}
+
+output "cluster_private_paths" {
+  value = { for cluster in data.mongodbatlas_network_health.this.clusters : cluster.cluster_name => [for path in cluster.private_paths : "${path.type}:${path.id}" if path.healthy] }
+}
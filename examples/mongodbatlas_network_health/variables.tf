@@ -0,0 +1,17 @@
+variable "public_key" {
+  description = "Public API key to authenticate to Atlas"
+  type        = string
+}
+variable "private_key" {
+  description = "Private API key to authenticate to Atlas"
+  type        = string
+}
+variable "project_id" {
+  description = "Atlas Project ID"
+  type        = string
+}
+variable "private_clusters" {
+  description = "Names of the clusters that must be reachable through network peering or a private endpoint"
+  type        = list(string)
+  default     = []
+}
@@ -0,0 +1,9 @@
+resource "mongodbatlas_project_ip_access_list_set" "this" {
+  project_id = var.project_id
+  exclusive  = true
+
+  entries = concat(
+    [for cidr in var.office_cidr_blocks : { cidr_block = cidr, comment = "Office network" }],
+    [{ ip_address = var.ci_runner_ip, comment = "CI runner" }],
+  )
+}
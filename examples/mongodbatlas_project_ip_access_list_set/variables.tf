@@ -0,0 +1,20 @@
+variable "public_key" {
+  description = "Public API key to authenticate to Atlas"
+  type        = string
+}
+variable "private_key" {
+  description = "Private API key to authenticate to Atlas"
+  type        = string
+}
+variable "project_id" {
+  description = "Atlas Project ID"
+  type        = string
+}
+variable "office_cidr_blocks" {
+  description = "CIDR blocks of the office networks"
+  type        = list(string)
+}
+variable "ci_runner_ip" {
+  description = "IP address of the CI runner"
+  type        = string
+}
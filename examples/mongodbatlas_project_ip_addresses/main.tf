@@ -5,3 +5,12 @@ data "mongodbatlas_project_ip_addresses" "test" {
 output "project_services" {
   value = data.mongodbatlas_project_ip_addresses.test.services
 }
+
+data "mongodbatlas_project_ip_addresses" "outbound" {
+  project_id = var.project_id
+  direction  = "outbound"
+}
+
+output "project_outbound_firewall_cidr_blocks" {
+  value = data.mongodbatlas_project_ip_addresses.outbound.aggregated_cidr_blocks
+}
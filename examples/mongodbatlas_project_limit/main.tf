@@ -0,0 +1,13 @@
+resource "mongodbatlas_project_limit" "clusters" {
+  project_id = var.project_id
+  limit_name = "atlas.project.deployment.clusters"
+  value      = var.max_clusters
+}
+
+data "mongodbatlas_project_limits" "this" {
+  project_id = mongodbatlas_project_limit.clusters.project_id
+}
+
+output "limits_usage" {
+  value = { for limit in data.mongodbatlas_project_limits.this.results : limit.name => "${coalesce(limit.current_usage, 0)}/${limit.value}" }
+}
@@ -0,0 +1,17 @@
+variable "public_key" {
+  description = "Public API key to authenticate to Atlas"
+  type        = string
+}
+variable "private_key" {
+  description = "Private API key to authenticate to Atlas"
+  type        = string
+}
+variable "project_id" {
+  description = "Atlas Project ID"
+  type        = string
+}
+variable "max_clusters" {
+  description = "Maximum number of clusters in the project"
+  type        = number
+  default     = 30
+}
@@ -0,0 +1,13 @@
+resource "mongodbatlas_project" "this" {
+  org_id             = var.org_id
+  name               = var.project_name
+  managed_separately = ["settings"]
+}
+
+resource "mongodbatlas_project_settings" "this" {
+  project_id                             = mongodbatlas_project.this.id
+  is_data_explorer_enabled               = false
+  is_performance_advisor_enabled         = true
+  is_schema_advisor_enabled              = true
+  is_slow_operation_thresholding_enabled = true
+}
@@ -0,0 +1,16 @@
+variable "public_key" {
+  description = "Public API key to authenticate to Atlas"
+  type        = string
+}
+variable "private_key" {
+  description = "Private API key to authenticate to Atlas"
+  type        = string
+}
+variable "org_id" {
+  description = "Atlas Organization ID"
+  type        = string
+}
+variable "project_name" {
+  description = "Atlas Project name"
+  type        = string
+}
@@ -85,6 +85,27 @@ resource "mongodbatlas_stream_processor" "stream-processor-kafka-to-cluster-exam
   }
 }
 
+resource "mongodbatlas_stream_processor" "stream-processor-stages-example" {
+  project_id     = var.project_id
+  instance_name  = mongodbatlas_stream_instance.example.instance_name
+  processor_name = "stagesProcessorName"
+  stages = [
+    {
+      name       = "$source"
+      definition = jsonencode({ "connectionName" = resource.mongodbatlas_stream_connection.example-sample.connection_name })
+    },
+    {
+      name       = "$match"
+      definition = jsonencode({ "obs.watts" = { "$gt" = 10 } })
+    },
+    {
+      name       = "$emit"
+      definition = jsonencode({ "connectionName" = resource.mongodbatlas_stream_connection.example-cluster.connection_name, "db" = "sample", "coll" = "solar_filtered" })
+    }
+  ]
+  state = "CREATED"
+}
+
 data "mongodbatlas_stream_processors" "example-stream-processors" {
   project_id    = var.project_id
   instance_name = mongodbatlas_stream_instance.example.instance_name
@@ -0,0 +1,11 @@
+resource "mongodbatlas_project" "this" {
+  org_id             = var.org_id
+  name               = var.project_name
+  managed_separately = ["teams"]
+}
+
+resource "mongodbatlas_team_project_assignment" "this" {
+  project_id = mongodbatlas_project.this.id
+  team_id    = var.team_id
+  role_names = ["GROUP_READ_ONLY", "GROUP_DATA_ACCESS_READ_ONLY"]
+}
@@ -0,0 +1,83 @@
+package conversion
+
+import (
+	"fmt"
+	"net/netip"
+	"slices"
+	"strings"
+)
+
+// NormalizeCIDRs converts IP addresses to single address CIDR blocks and returns the CIDR blocks deduplicated and sorted by address.
+// Host bits are cleared, e.g. 10.0.0.1/24 is returned as 10.0.0.0/24.
+func NormalizeCIDRs(values []string) ([]string, error) {
+	prefixes, err := parsePrefixes(values)
+	if err != nil {
+		return nil, err
+	}
+	return prefixStrings(prefixes), nil
+}
+
+// AggregateCIDRs returns the minimal list of CIDR blocks that covers exactly the same addresses as values. Blocks contained in other
+// blocks are removed and adjacent blocks are merged, e.g. 10.0.0.0/25 and 10.0.0.128/25 are returned as 10.0.0.0/24.
+func AggregateCIDRs(values []string) ([]string, error) {
+	prefixes, err := parsePrefixes(values)
+	if err != nil {
+		return nil, err
+	}
+	var result []netip.Prefix
+	for _, p := range prefixes {
+		if n := len(result); n > 0 && result[n-1].Bits() <= p.Bits() && result[n-1].Contains(p.Addr()) {
+			continue
+		}
+		result = append(result, p)
+		for n := len(result); n >= 2; n = len(result) {
+			first, second := result[n-2], result[n-1]
+			if first.Bits() != second.Bits() || first.Bits() == 0 {
+				break
+			}
+			parent := netip.PrefixFrom(first.Addr(), first.Bits()-1).Masked()
+			if parent.Addr() != first.Addr() || !parent.Contains(second.Addr()) {
+				break
+			}
+			result = append(result[:n-2], parent)
+		}
+	}
+	return prefixStrings(result), nil
+}
+
+// parsePrefixes returns the prefixes deduplicated and sorted by address, and by size for the same address.
+func parsePrefixes(values []string) ([]netip.Prefix, error) {
+	prefixes := make([]netip.Prefix, 0, len(values))
+	for _, value := range values {
+		var prefix netip.Prefix
+		if strings.Contains(value, "/") {
+			p, err := netip.ParsePrefix(value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid CIDR block %q: %w", value, err)
+			}
+			prefix = p.Masked()
+		} else {
+			addr, err := netip.ParseAddr(value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid IP address %q: %w", value, err)
+			}
+			prefix = netip.PrefixFrom(addr, addr.BitLen())
+		}
+		prefixes = append(prefixes, prefix)
+	}
+	slices.SortFunc(prefixes, func(a, b netip.Prefix) int {
+		if c := a.Addr().Compare(b.Addr()); c != 0 {
+			return c
+		}
+		return a.Bits() - b.Bits()
+	})
+	return slices.Compact(prefixes), nil
+}
+
+func prefixStrings(prefixes []netip.Prefix) []string {
+	result := make([]string, len(prefixes))
+	for i, p := range prefixes {
+		result[i] = p.String()
+	}
+	return result
+}
@@ -0,0 +1,63 @@
+package conversion_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/conversion"
+)
+
+func TestNormalizeCIDRs(t *testing.T) {
+	testCases := map[string]struct {
+		values   []string
+		expected []string
+	}{
+		"empty":                    {values: nil, expected: []string{}},
+		"ip addresses":             {values: []string{"10.0.0.2", "10.0.0.1"}, expected: []string{"10.0.0.1/32", "10.0.0.2/32"}},
+		"duplicates":               {values: []string{"10.0.0.1/32", "10.0.0.1", "10.0.0.1/32"}, expected: []string{"10.0.0.1/32"}},
+		"host bits cleared":        {values: []string{"10.0.0.1/24"}, expected: []string{"10.0.0.0/24"}},
+		"sorted by address":        {values: []string{"192.168.0.0/16", "10.0.0.0/8", "10.0.0.0/16"}, expected: []string{"10.0.0.0/8", "10.0.0.0/16", "192.168.0.0/16"}},
+		"ipv6 after ipv4":          {values: []string{"2001:db8::1", "10.0.0.1"}, expected: []string{"10.0.0.1/32", "2001:db8::1/128"}},
+		"numeric not lexical sort": {values: []string{"10.0.0.10", "10.0.0.9"}, expected: []string{"10.0.0.9/32", "10.0.0.10/32"}},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			result, err := conversion.NormalizeCIDRs(tc.values)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, result)
+		})
+	}
+}
+
+func TestAggregateCIDRs(t *testing.T) {
+	testCases := map[string]struct {
+		values   []string
+		expected []string
+	}{
+		"single address":      {values: []string{"10.0.0.1"}, expected: []string{"10.0.0.1/32"}},
+		"adjacent addresses":  {values: []string{"10.0.0.0", "10.0.0.1"}, expected: []string{"10.0.0.0/31"}},
+		"not aligned":         {values: []string{"10.0.0.1", "10.0.0.2"}, expected: []string{"10.0.0.1/32", "10.0.0.2/32"}},
+		"cascading merge":     {values: []string{"10.0.0.3", "10.0.0.0", "10.0.0.2", "10.0.0.1"}, expected: []string{"10.0.0.0/30"}},
+		"contained block":     {values: []string{"10.0.0.0/16", "10.0.1.5", "10.0.200.0/24"}, expected: []string{"10.0.0.0/16"}},
+		"merge halves":        {values: []string{"10.0.0.128/25", "10.0.0.0/25", "10.0.1.0/24"}, expected: []string{"10.0.0.0/23"}},
+		"different sizes":     {values: []string{"10.0.0.0/25", "10.0.0.128/26", "10.0.0.192/26"}, expected: []string{"10.0.0.0/24"}},
+		"families not merged": {values: []string{"0.0.0.0/1", "128.0.0.0/1", "::/1", "8000::/1"}, expected: []string{"0.0.0.0/0", "::/0"}},
+		"disjoint blocks":     {values: []string{"3.92.113.229/32", "3.208.110.31/32"}, expected: []string{"3.92.113.229/32", "3.208.110.31/32"}},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			result, err := conversion.AggregateCIDRs(tc.values)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, result)
+		})
+	}
+}
+
+func TestAggregateCIDRsInvalid(t *testing.T) {
+	_, err := conversion.AggregateCIDRs([]string{"10.0.0.0/33"})
+	require.ErrorContains(t, err, "invalid CIDR block")
+	_, err = conversion.NormalizeCIDRs([]string{"not-an-ip"})
+	require.ErrorContains(t, err, "invalid IP address")
+}
@@ -10,6 +10,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 )
 
+func ImportSplit2(importRaw string) (ok bool, part1, part2 string) {
+	parts := strings.Split(importRaw, "/")
+	if len(parts) != 2 {
+		return false, "", ""
+	}
+	return true, parts[0], parts[1]
+}
+
 func ImportSplit3(importRaw string) (ok bool, part1, part2, part3 string) {
 	parts := strings.Split(importRaw, "/")
 	if len(parts) != 3 {
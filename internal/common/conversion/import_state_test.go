@@ -24,6 +24,39 @@ func TestValidateClusterName(t *testing.T) {
 	assert.Contains(t, err.Error(), "cluster_name must be a string with length between 1 and 64, starting and ending with an alphanumeric character, and containing only alphanumeric characters and hyphens")
 }
 
+func TestImportSplit2(t *testing.T) {
+	tests := map[string]struct {
+		importRaw string
+		part1     string
+		part2     string
+		expected  bool
+	}{
+		"valid input": {
+			importRaw: "part1/part2",
+			expected:  true,
+			part1:     "part1",
+			part2:     "part2",
+		},
+		"invalid input with more parts": {
+			importRaw: "part1/part2/part3",
+			expected:  false,
+		},
+		"invalid input with one part": {
+			importRaw: "part1",
+			expected:  false,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ok, part1, part2 := conversion.ImportSplit2(tc.importRaw)
+			assert.Equal(t, tc.expected, ok)
+			assert.Equal(t, tc.part1, part1)
+			assert.Equal(t, tc.part2, part2)
+		})
+	}
+}
+
 func TestImportSplit3(t *testing.T) {
 	tests := map[string]struct {
 		importRaw string
@@ -6,6 +6,7 @@ import (
 	"slices"
 
 	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	ephemeralschema "github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 )
 
@@ -64,7 +65,7 @@ func PluralDataSourceSchemaFromResource(rs schema.Schema, req *PluralDataSourceS
 	return ds
 }
 
-func UpdateSchemaDescription[T schema.Schema | dsschema.Schema](s *T) {
+func UpdateSchemaDescription[T schema.Schema | dsschema.Schema | ephemeralschema.Schema](s *T) {
 	UpdateAttr(s)
 }
 
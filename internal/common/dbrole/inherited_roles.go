@@ -0,0 +1,107 @@
+package dbrole
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"go.mongodb.org/atlas-sdk/v20250312003/admin"
+)
+
+// customRoleDB is the database of inherited roles that can reference custom roles. Roles inherited from other databases are always
+// built-in roles so they can't be part of a cycle.
+const customRoleDB = "admin"
+
+// InheritedCustomRoles returns the names of the inherited roles that can reference custom roles, ignoring unknown (empty) names.
+func InheritedCustomRoles(roles []admin.DatabaseInheritedRole) []string {
+	var names []string
+	for _, r := range roles {
+		if r.Db == customRoleDB && r.Role != "" && !slices.Contains(names, r.Role) {
+			names = append(names, r.Role)
+		}
+	}
+	return names
+}
+
+// InheritanceGraph returns the custom roles inherited by every role, by role name.
+func InheritanceGraph(roles []admin.UserCustomDBRole) map[string][]string {
+	graph := make(map[string][]string, len(roles))
+	for i := range roles {
+		graph[roles[i].RoleName] = InheritedCustomRoles(roles[i].GetInheritedRoles())
+	}
+	return graph
+}
+
+// FindInheritanceCycle returns the path of a cycle that starts and ends in roleName, or nil if roleName is not part of a cycle.
+// The graph is walked in name order so the same cycle is always reported.
+func FindInheritanceCycle(graph map[string][]string, roleName string) []string {
+	visited := make(map[string]bool)
+	var walk func(path []string) []string
+	walk = func(path []string) []string {
+		inherited := slices.Clone(graph[path[len(path)-1]])
+		sort.Strings(inherited)
+		for _, next := range inherited {
+			if next == roleName {
+				return append(slices.Clone(path), next)
+			}
+			if visited[next] {
+				continue
+			}
+			visited[next] = true
+			if cycle := walk(append(path, next)); cycle != nil {
+				return cycle
+			}
+		}
+		return nil
+	}
+	return walk([]string{roleName})
+}
+
+// FindInheritanceCycles returns every cycle in graph once, starting from its role that comes first in name order.
+func FindInheritanceCycles(graph map[string][]string) [][]string {
+	names := make([]string, 0, len(graph))
+	for name := range graph {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var cycles [][]string
+	found := make(map[string]bool)
+	for _, name := range names {
+		cycle := FindInheritanceCycle(graph, name)
+		if cycle == nil {
+			continue
+		}
+		members := slices.Clone(cycle[:len(cycle)-1])
+		sort.Strings(members)
+		key := strings.Join(members, ",")
+		if !found[key] {
+			found[key] = true
+			cycles = append(cycles, cycle)
+		}
+	}
+	return cycles
+}
+
+// DescribeInheritanceCycle returns the error message for a cycle returned by FindInheritanceCycle.
+func DescribeInheritanceCycle(cycle []string) string {
+	if len(cycle) == 2 {
+		return fmt.Sprintf("custom role %s can't inherit itself", cycle[0])
+	}
+	return fmt.Sprintf("inherited_roles form a cycle between custom roles: %s", strings.Join(cycle, " -> "))
+}
+
+// CheckInheritanceCycle returns an error if roleName inherits itself, directly or through other roles in graph. inherited replaces
+// the roles inherited by roleName in graph, which is not modified.
+func CheckInheritanceCycle(graph map[string][]string, roleName string, inherited []string) error {
+	withRole := make(map[string][]string, len(graph)+1)
+	for name, roles := range graph {
+		withRole[name] = roles
+	}
+	withRole[roleName] = inherited
+	if cycle := FindInheritanceCycle(withRole, roleName); cycle != nil {
+		return errors.New(DescribeInheritanceCycle(cycle))
+	}
+	return nil
+}
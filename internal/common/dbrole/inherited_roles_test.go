@@ -0,0 +1,153 @@
+package dbrole_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/atlas-sdk/v20250312003/admin"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/dbrole"
+)
+
+func TestInheritedCustomRoles(t *testing.T) {
+	roles := []admin.DatabaseInheritedRole{
+		{Db: "admin", Role: "roleA"},
+		{Db: "sales", Role: "read"},
+		{Db: "admin", Role: "roleA"},
+		{Db: "admin", Role: ""},
+		{Db: "admin", Role: "roleB"},
+	}
+	assert.Equal(t, []string{"roleA", "roleB"}, dbrole.InheritedCustomRoles(roles))
+}
+
+func TestFindInheritanceCycle(t *testing.T) {
+	testCases := map[string]struct {
+		graph    map[string][]string
+		roleName string
+		expected []string
+	}{
+		"no inherited roles": {
+			graph:    map[string][]string{"a": nil},
+			roleName: "a",
+		},
+		"chain without cycle": {
+			graph:    map[string][]string{"a": {"b"}, "b": {"c"}, "c": nil},
+			roleName: "a",
+		},
+		"direct cycle": {
+			graph:    map[string][]string{"a": {"b"}, "b": {"a"}},
+			roleName: "a",
+			expected: []string{"a", "b", "a"},
+		},
+		"indirect cycle": {
+			graph:    map[string][]string{"a": {"x", "b"}, "b": {"c"}, "c": {"a"}, "x": nil},
+			roleName: "a",
+			expected: []string{"a", "b", "c", "a"},
+		},
+		"cycle not including role": {
+			graph:    map[string][]string{"a": {"b"}, "b": {"c"}, "c": {"b"}},
+			roleName: "a",
+		},
+		"unknown inherited role": {
+			graph:    map[string][]string{"a": {"readAnyDatabase"}},
+			roleName: "a",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, dbrole.FindInheritanceCycle(tc.graph, tc.roleName))
+		})
+	}
+}
+
+func TestFindInheritanceCycles(t *testing.T) {
+	graph := map[string][]string{
+		"a": {"b"}, "b": {"c"}, "c": {"a"},
+		"d": {"d"},
+		"e": {"a"},
+		"f": nil,
+	}
+	assert.Equal(t, [][]string{{"a", "b", "c", "a"}, {"d", "d"}}, dbrole.FindInheritanceCycles(graph))
+	assert.Empty(t, dbrole.FindInheritanceCycles(map[string][]string{"a": {"b"}, "b": nil}))
+}
+
+func TestCheckInheritanceCycle(t *testing.T) {
+	graph := map[string][]string{"a": {"b"}, "b": nil}
+	require.NoError(t, dbrole.CheckInheritanceCycle(graph, "c", []string{"a"}))
+	require.ErrorContains(t, dbrole.CheckInheritanceCycle(graph, "b", []string{"a"}), "b -> a -> b")
+	require.ErrorContains(t, dbrole.CheckInheritanceCycle(graph, "c", []string{"c"}), "can't inherit itself")
+	require.NoError(t, dbrole.CheckInheritanceCycle(graph, "a", nil), "planned inheritance replaces the existing one")
+	assert.Equal(t, []string{"b"}, graph["a"], "graph must not be modified")
+}
+
+func TestInheritedRolesValidator(t *testing.T) {
+	ctx := context.Background()
+	projectID := "64b7a3c5a3c4b81f2e6f1a01"
+	roleA := inheritedRolesRequest(t, projectID, "roleA", "roleB")
+	roleB := inheritedRolesRequest(t, projectID, "roleB", "roleA")
+	self := inheritedRolesRequest(t, projectID, "roleC", "roleC")
+
+	v := dbrole.InheritedRolesValidator()
+	resp := &validator.SetResponse{}
+	v.ValidateSet(ctx, roleA, resp)
+	require.False(t, resp.Diagnostics.HasError())
+
+	resp = &validator.SetResponse{}
+	v.ValidateSet(ctx, roleB, resp)
+	require.False(t, resp.Diagnostics.HasError(), "other resources must not be part of the validation")
+
+	resp = &validator.SetResponse{}
+	v.ValidateSet(ctx, self, resp)
+	require.True(t, resp.Diagnostics.HasError())
+	assert.Contains(t, resp.Diagnostics.Errors()[0].Detail(), "can't inherit itself")
+}
+
+func inheritedRolesRequest(t *testing.T, projectID, roleName, inheritedRole string) validator.SetRequest {
+	t.Helper()
+	roleType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{"db": tftypes.String, "role": tftypes.String}}
+	schemaType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"group_id":        tftypes.String,
+		"role_name":       tftypes.String,
+		"inherited_roles": tftypes.Set{ElementType: roleType},
+	}}
+	config := tfsdk.Config{
+		Schema: schema.Schema{Attributes: map[string]schema.Attribute{
+			"group_id":  schema.StringAttribute{Required: true},
+			"role_name": schema.StringAttribute{Required: true},
+			"inherited_roles": schema.SetNestedAttribute{
+				Optional: true,
+				NestedObject: schema.NestedAttributeObject{Attributes: map[string]schema.Attribute{
+					"db":   schema.StringAttribute{Required: true},
+					"role": schema.StringAttribute{Required: true},
+				}},
+			},
+		}},
+		Raw: tftypes.NewValue(schemaType, map[string]tftypes.Value{
+			"group_id":  tftypes.NewValue(tftypes.String, projectID),
+			"role_name": tftypes.NewValue(tftypes.String, roleName),
+			"inherited_roles": tftypes.NewValue(tftypes.Set{ElementType: roleType}, []tftypes.Value{
+				tftypes.NewValue(roleType, map[string]tftypes.Value{
+					"db":   tftypes.NewValue(tftypes.String, "admin"),
+					"role": tftypes.NewValue(tftypes.String, inheritedRole),
+				}),
+			}),
+		}),
+	}
+	objType := types.ObjectType{AttrTypes: map[string]attr.Type{"db": types.StringType, "role": types.StringType}}
+	set := types.SetValueMust(objType, []attr.Value{
+		types.ObjectValueMust(objType.AttrTypes, map[string]attr.Value{
+			"db":   types.StringValue("admin"),
+			"role": types.StringValue(inheritedRole),
+		}),
+	})
+	return validator.SetRequest{Path: path.Root("inherited_roles"), Config: config, ConfigValue: set}
+}
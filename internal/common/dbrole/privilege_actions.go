@@ -0,0 +1,126 @@
+package dbrole
+
+import (
+	_ "embed" // used to embed privilege_actions.json
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/validate"
+)
+
+// privilege_actions.json lists the privilege actions supported by Atlas custom roles and the resource scopes each one can be granted on.
+// Atlas adds actions faster than provider releases, so actions missing from the catalog only raise warnings. Actions that differ from
+// a catalog entry only by case and actions granted on a scope they don't support are errors.
+//
+//go:embed privilege_actions.json
+var privilegeActionsJSON []byte
+
+var privilegeActions = mustLoadPrivilegeActions()
+
+const (
+	ScopeCluster    = "CLUSTER"
+	ScopeDatabase   = "DATABASE"
+	ScopeCollection = "COLLECTION"
+)
+
+type privilegeActionsCatalog struct {
+	Scopes []struct {
+		Scopes  []string `json:"scopes"`
+		Actions []string `json:"actions"`
+	} `json:"scopes"`
+}
+
+// ActionResource is a resource on which a privilege action is granted.
+type ActionResource struct {
+	Database   string
+	Collection string
+	Cluster    bool
+}
+
+// Scope returns CLUSTER for cluster resources, COLLECTION when a collection is set and DATABASE otherwise. An empty database means
+// all databases, and it's still a DATABASE or COLLECTION scope.
+func (r ActionResource) Scope() string {
+	switch {
+	case r.Cluster:
+		return ScopeCluster
+	case r.Collection != "":
+		return ScopeCollection
+	default:
+		return ScopeDatabase
+	}
+}
+
+func (r ActionResource) String() string {
+	switch r.Scope() {
+	case ScopeCluster:
+		return "cluster"
+	case ScopeCollection:
+		return fmt.Sprintf("collection %q in database %q", r.Collection, r.Database)
+	default:
+		return fmt.Sprintf("database %q", r.Database)
+	}
+}
+
+func mustLoadPrivilegeActions() map[string][]string {
+	var c privilegeActionsCatalog
+	if err := json.Unmarshal(privilegeActionsJSON, &c); err != nil {
+		panic(fmt.Sprintf("invalid privilege actions catalog: %s", err))
+	}
+	actions := make(map[string][]string)
+	for _, group := range c.Scopes {
+		for _, action := range group.Actions {
+			if _, found := actions[action]; found {
+				panic(fmt.Sprintf("invalid privilege actions catalog: duplicated action %s", action))
+			}
+			actions[action] = group.Scopes
+		}
+	}
+	return actions
+}
+
+// PrivilegeActions returns the privilege actions known by the catalog, sorted.
+func PrivilegeActions() []string {
+	names := make([]string, 0, len(privilegeActions))
+	for name := range privilegeActions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// CheckActionName checks a privilege action against the catalog. It returns an error detail for names that only differ from a known
+// action by case, and a warning detail for unknown names.
+func CheckActionName(action string) (errDetail, warningDetail string) {
+	if _, found := privilegeActions[action]; found {
+		return "", ""
+	}
+	suggestion := validate.ClosestMatch(action, PrivilegeActions())
+	if strings.EqualFold(suggestion, action) {
+		return fmt.Sprintf("%s is not a valid privilege action, actions are case sensitive. Did you mean %s?", action, suggestion), ""
+	}
+	warningDetail = fmt.Sprintf("%s is not a known privilege action, Atlas may reject it.", action)
+	if suggestion != "" {
+		warningDetail += fmt.Sprintf(" Did you mean %s?", suggestion)
+	}
+	return "", warningDetail
+}
+
+// CheckActionScopes returns an error detail for every resource whose scope is not supported by the action. Actions missing from the
+// catalog are not checked.
+func CheckActionScopes(action string, resources []ActionResource) []string {
+	scopes, found := privilegeActions[action]
+	if !found {
+		return nil
+	}
+	var details []string
+	for _, r := range resources {
+		if !slices.Contains(scopes, r.Scope()) {
+			details = append(details, fmt.Sprintf("%s can't be granted on %s, it only supports %s resources.",
+				action, r, strings.Join(scopes, ", ")))
+		}
+	}
+	return details
+}
@@ -0,0 +1,72 @@
+package dbrole_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/dbrole"
+)
+
+func TestCheckActionName(t *testing.T) {
+	testCases := map[string]struct {
+		action        string
+		errorContains string
+		warning       bool
+	}{
+		"known action":           {action: "FIND"},
+		"wrong case is an error": {action: "find", errorContains: "Did you mean FIND?"},
+		"typo warns with hint":   {action: "SERVER_STATUSS", warning: true},
+		"unrelated name warns":   {action: "SOME_FUTURE_ACTION", warning: true},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			errDetail, warningDetail := dbrole.CheckActionName(tc.action)
+			if tc.errorContains == "" {
+				assert.Empty(t, errDetail)
+			} else {
+				assert.Contains(t, errDetail, tc.errorContains)
+			}
+			assert.Equal(t, tc.warning, warningDetail != "")
+		})
+	}
+}
+
+func TestCheckActionScopes(t *testing.T) {
+	var (
+		cluster    = dbrole.ActionResource{Cluster: true}
+		allDBs     = dbrole.ActionResource{}
+		database   = dbrole.ActionResource{Database: "sales"}
+		collection = dbrole.ActionResource{Database: "sales", Collection: "orders"}
+	)
+	testCases := map[string]struct {
+		action    string
+		resources []dbrole.ActionResource
+		errors    int
+	}{
+		"collection action on collection":   {action: "FIND", resources: []dbrole.ActionResource{collection}},
+		"collection action on all dbs":      {action: "FIND", resources: []dbrole.ActionResource{allDBs, database}},
+		"collection action on cluster":      {action: "INSERT", resources: []dbrole.ActionResource{cluster}, errors: 1},
+		"database action on collection":     {action: "DROP_DATABASE", resources: []dbrole.ActionResource{collection}, errors: 1},
+		"cluster action on cluster":         {action: "SERVER_STATUS", resources: []dbrole.ActionResource{cluster}},
+		"cluster action on namespaces":      {action: "KILL_OP", resources: []dbrole.ActionResource{database, collection}, errors: 2},
+		"action supporting every scope":     {action: "ENABLE_SHARDING", resources: []dbrole.ActionResource{cluster, database, collection}},
+		"unknown action is not checked":     {action: "SOME_FUTURE_ACTION", resources: []dbrole.ActionResource{cluster, collection}},
+		"wrong case action is not checked":  {action: "server_status", resources: []dbrole.ActionResource{collection}},
+		"no resources":                      {action: "FIND"},
+		"mixed valid and invalid resources": {action: "LIST_COLLECTIONS", resources: []dbrole.ActionResource{database, cluster}, errors: 1},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			assert.Len(t, dbrole.CheckActionScopes(tc.action, tc.resources), tc.errors)
+		})
+	}
+}
+
+func TestPrivilegeActions(t *testing.T) {
+	actions := dbrole.PrivilegeActions()
+	require.NotEmpty(t, actions)
+	assert.IsNonDecreasing(t, actions)
+	assert.Contains(t, actions, "CONN_POOL_STATS")
+}
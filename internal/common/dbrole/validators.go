@@ -0,0 +1,128 @@
+package dbrole
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"go.mongodb.org/atlas-sdk/v20250312003/admin"
+)
+
+// ActionsValidator validates the actions of custom_db_role_api against the privilege actions catalog.
+func ActionsValidator() validator.List {
+	return actionsValidator{}
+}
+
+// InheritedRolesValidator validates that the inherited_roles of custom_db_role_api don't include the role itself. Validators don't have
+// access to Atlas or other resources, cycles through other roles are checked by mongodbatlas_custom_db_role_inheritance_check.
+func InheritedRolesValidator() validator.Set {
+	return inheritedRolesValidator{}
+}
+
+type actionsValidator struct{}
+
+func (v actionsValidator) Description(_ context.Context) string {
+	return "actions must be known privilege actions granted on resources they support"
+}
+
+func (v actionsValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v actionsValidator) ValidateList(ctx context.Context, req validator.ListRequest, resp *validator.ListResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	for i, elem := range req.ConfigValue.Elements() {
+		obj, ok := elem.(types.Object)
+		if !ok || obj.IsNull() || obj.IsUnknown() {
+			continue
+		}
+		actionPath := req.Path.AtListIndex(i)
+		action, ok := obj.Attributes()["action"].(types.String)
+		if !ok || action.IsNull() || action.IsUnknown() {
+			continue
+		}
+		errDetail, warningDetail := CheckActionName(action.ValueString())
+		switch {
+		case errDetail != "":
+			resp.Diagnostics.AddAttributeError(actionPath.AtName("action"), "Invalid privilege action", errDetail)
+		case warningDetail != "":
+			resp.Diagnostics.AddAttributeWarning(actionPath.AtName("action"), "Unknown privilege action", warningDetail)
+		}
+		resources, known := actionResourcesFromList(obj.Attributes()["resources"])
+		if !known {
+			continue
+		}
+		for _, detail := range CheckActionScopes(action.ValueString(), resources) {
+			resp.Diagnostics.AddAttributeError(actionPath.AtName("resources"), "Invalid privilege action resource", detail)
+		}
+	}
+}
+
+// actionResourcesFromList returns false if any of the resources is not known yet.
+func actionResourcesFromList(value any) ([]ActionResource, bool) {
+	list, ok := value.(types.List)
+	if !ok || list.IsUnknown() {
+		return nil, false
+	}
+	resources := make([]ActionResource, 0, len(list.Elements()))
+	for _, elem := range list.Elements() {
+		obj, ok := elem.(types.Object)
+		if !ok || obj.IsUnknown() {
+			return nil, false
+		}
+		attrs := obj.Attributes()
+		cluster, _ := attrs["cluster"].(types.Bool)
+		collection, _ := attrs["collection"].(types.String)
+		db, _ := attrs["db"].(types.String)
+		if cluster.IsUnknown() || collection.IsUnknown() || db.IsUnknown() {
+			return nil, false
+		}
+		resources = append(resources, ActionResource{
+			Database:   db.ValueString(),
+			Collection: collection.ValueString(),
+			Cluster:    cluster.ValueBool(),
+		})
+	}
+	return resources, true
+}
+
+type inheritedRolesValidator struct{}
+
+func (v inheritedRolesValidator) Description(_ context.Context) string {
+	return "inherited_roles must not include the role itself"
+}
+
+func (v inheritedRolesValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v inheritedRolesValidator) ValidateSet(ctx context.Context, req validator.SetRequest, resp *validator.SetResponse) {
+	if req.ConfigValue.IsUnknown() {
+		return
+	}
+	var roleName types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("role_name"), &roleName)...)
+	if resp.Diagnostics.HasError() || roleName.IsNull() || roleName.IsUnknown() {
+		return
+	}
+	var inherited []admin.DatabaseInheritedRole
+	for _, elem := range req.ConfigValue.Elements() {
+		obj, ok := elem.(types.Object)
+		if !ok || obj.IsUnknown() {
+			return
+		}
+		db, _ := obj.Attributes()["db"].(types.String)
+		role, _ := obj.Attributes()["role"].(types.String)
+		if db.IsUnknown() || role.IsUnknown() {
+			return
+		}
+		inherited = append(inherited, admin.DatabaseInheritedRole{Db: db.ValueString(), Role: role.ValueString()})
+	}
+	if err := CheckInheritanceCycle(nil, roleName.ValueString(), InheritedCustomRoles(inherited)); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid inherited roles", fmt.Sprintf("%s.", err))
+	}
+}
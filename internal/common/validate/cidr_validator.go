@@ -2,12 +2,35 @@ package validate
 
 import (
 	"context"
-	"net"
+	"fmt"
+	"net/netip"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 )
 
+// ReservedCIDRs are ranges that can't be used for Atlas network containers or peering routes.
+var ReservedCIDRs = []string{
+	"0.0.0.0/8",      // "this" network
+	"127.0.0.0/8",    // loopback
+	"169.254.0.0/16", // link-local
+	"224.0.0.0/4",    // multicast
+	"240.0.0.0/4",    // reserved for future use and broadcast
+}
+
+// PrivateCIDRs are the RFC 1918 ranges where the Atlas CIDR block of a network container must be defined.
+var PrivateCIDRs = []string{
+	"10.0.0.0/8",
+	"172.16.0.0/12",
+	"192.168.0.0/16",
+}
+
+// CIDROverlap describes two CIDR blocks that share at least one address.
+type CIDROverlap struct {
+	CIDR      string
+	OtherCIDR string
+}
+
 type CIDRValidator struct{}
 
 func (v CIDRValidator) Description(_ context.Context) string {
@@ -24,18 +47,7 @@ func (v CIDRValidator) ValidateString(ctx context.Context, req validator.StringR
 		return
 	}
 
-	value := req.ConfigValue.ValueString()
-	_, ipnet, err := net.ParseCIDR(value)
-	if err != nil {
-		response.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
-			req.Path,
-			v.Description(ctx),
-			req.ConfigValue.ValueString(),
-		))
-		return
-	}
-
-	if ipnet == nil || ipnet.String() != value {
+	if _, err := ParseCIDR(req.ConfigValue.ValueString()); err != nil {
 		response.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
 			req.Path,
 			v.Description(ctx),
@@ -48,3 +60,97 @@ func (v CIDRValidator) ValidateString(ctx context.Context, req validator.StringR
 func ValidCIDR() validator.String {
 	return CIDRValidator{}
 }
+
+// ParseCIDR parses an IPv4 or IPv6 CIDR block, the address must be the first one of the block, e.g. 10.0.0.0/16 instead of 10.0.1.0/16.
+func ParseCIDR(value string) (netip.Prefix, error) {
+	prefix, err := netip.ParsePrefix(value)
+	if err != nil {
+		return netip.Prefix{}, fmt.Errorf("invalid CIDR block %q: %w", value, err)
+	}
+	if prefix.Masked() != prefix {
+		return netip.Prefix{}, fmt.Errorf("invalid CIDR block %q: host bits must be zero, did you mean %s?", value, prefix.Masked())
+	}
+	return prefix, nil
+}
+
+// CIDRsOverlap returns true if both CIDR blocks share at least one address.
+func CIDRsOverlap(cidr, otherCIDR string) (bool, error) {
+	prefix, err := ParseCIDR(cidr)
+	if err != nil {
+		return false, err
+	}
+	otherPrefix, err := ParseCIDR(otherCIDR)
+	if err != nil {
+		return false, err
+	}
+	return prefix.Overlaps(otherPrefix), nil
+}
+
+// FindCIDROverlaps returns every pair of CIDR blocks that overlap, in input order. Duplicated blocks are reported as overlaps.
+func FindCIDROverlaps(cidrs []string) ([]CIDROverlap, error) {
+	prefixes := make([]netip.Prefix, len(cidrs))
+	for i, cidr := range cidrs {
+		prefix, err := ParseCIDR(cidr)
+		if err != nil {
+			return nil, err
+		}
+		prefixes[i] = prefix
+	}
+	var overlaps []CIDROverlap
+	for i := range prefixes {
+		for j := i + 1; j < len(prefixes); j++ {
+			if prefixes[i].Overlaps(prefixes[j]) {
+				overlaps = append(overlaps, CIDROverlap{CIDR: cidrs[i], OtherCIDR: cidrs[j]})
+			}
+		}
+	}
+	return overlaps, nil
+}
+
+// ReservedCIDROverlap returns the first range in ReservedCIDRs that overlaps cidr, or an empty string if there is none.
+func ReservedCIDROverlap(cidr string) (string, error) {
+	prefix, err := ParseCIDR(cidr)
+	if err != nil {
+		return "", err
+	}
+	for _, reserved := range ReservedCIDRs {
+		if prefix.Overlaps(netip.MustParsePrefix(reserved)) {
+			return reserved, nil
+		}
+	}
+	return "", nil
+}
+
+// IsPrivateCIDR returns true if cidr is fully contained in one of the PrivateCIDRs.
+func IsPrivateCIDR(cidr string) (bool, error) {
+	prefix, err := ParseCIDR(cidr)
+	if err != nil {
+		return false, err
+	}
+	for _, private := range PrivateCIDRs {
+		privatePrefix := netip.MustParsePrefix(private)
+		if privatePrefix.Contains(prefix.Addr()) && prefix.Bits() >= privatePrefix.Bits() {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// CheckAtlasCIDRBlock returns an error if cidr can't be used as the Atlas CIDR block of a network container.
+func CheckAtlasCIDRBlock(cidr string) error {
+	reserved, err := ReservedCIDROverlap(cidr)
+	if err != nil {
+		return err
+	}
+	if reserved != "" {
+		return fmt.Errorf("CIDR block %s overlaps the reserved range %s", cidr, reserved)
+	}
+	private, err := IsPrivateCIDR(cidr)
+	if err != nil {
+		return err
+	}
+	if !private {
+		return fmt.Errorf("CIDR block %s must be in one of the RFC 1918 private networks: %v", cidr, PrivateCIDRs)
+	}
+	return nil
+}
@@ -7,6 +7,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/validate"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestValidCIDR(t *testing.T) {
@@ -59,3 +61,96 @@ func TestValidCIDR(t *testing.T) {
 		})
 	}
 }
+
+func TestParseCIDR(t *testing.T) {
+	testCases := map[string]struct {
+		cidr    string
+		wantErr bool
+	}{
+		"ipv4":             {cidr: "10.8.0.0/21"},
+		"ipv6":             {cidr: "2001:db8::/32"},
+		"host bits set":    {cidr: "10.8.1.0/21", wantErr: true},
+		"missing prefix":   {cidr: "10.8.0.0", wantErr: true},
+		"invalid prefix":   {cidr: "10.8.0.0/33", wantErr: true},
+		"invalid address":  {cidr: "10.8.0/21", wantErr: true},
+		"empty":            {cidr: "", wantErr: true},
+		"single ip prefix": {cidr: "10.8.0.1/32"},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			_, err := validate.ParseCIDR(tc.cidr)
+			assert.Equal(t, tc.wantErr, err != nil, "unexpected error: %v", err)
+		})
+	}
+}
+
+func TestCIDRsOverlap(t *testing.T) {
+	testCases := map[string]struct {
+		cidr, otherCIDR string
+		expected        bool
+		wantErr         bool
+	}{
+		"same block":           {cidr: "10.8.0.0/21", otherCIDR: "10.8.0.0/21", expected: true},
+		"contained":            {cidr: "10.8.0.0/21", otherCIDR: "10.8.4.0/24", expected: true},
+		"containing":           {cidr: "10.0.0.0/8", otherCIDR: "10.8.0.0/21", expected: true},
+		"adjacent":             {cidr: "10.8.0.0/21", otherCIDR: "10.8.8.0/21"},
+		"different families":   {cidr: "10.8.0.0/21", otherCIDR: "2001:db8::/32"},
+		"invalid first block":  {cidr: "10.8.0.0", otherCIDR: "10.8.0.0/21", wantErr: true},
+		"invalid second block": {cidr: "10.8.0.0/21", otherCIDR: "10.8.1.0/21", wantErr: true},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			overlap, err := validate.CIDRsOverlap(tc.cidr, tc.otherCIDR)
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, overlap)
+		})
+	}
+}
+
+func TestFindCIDROverlaps(t *testing.T) {
+	overlaps, err := validate.FindCIDROverlaps([]string{"10.8.0.0/21", "192.168.0.0/24", "10.8.4.0/24", "10.8.0.0/21"})
+	require.NoError(t, err)
+	assert.Equal(t, []validate.CIDROverlap{
+		{CIDR: "10.8.0.0/21", OtherCIDR: "10.8.4.0/24"},
+		{CIDR: "10.8.0.0/21", OtherCIDR: "10.8.0.0/21"},
+		{CIDR: "10.8.4.0/24", OtherCIDR: "10.8.0.0/21"},
+	}, overlaps)
+
+	overlaps, err = validate.FindCIDROverlaps([]string{"10.8.0.0/21", "10.8.8.0/21"})
+	require.NoError(t, err)
+	assert.Empty(t, overlaps)
+
+	_, err = validate.FindCIDROverlaps([]string{"10.8.0.0/21", "invalid"})
+	require.Error(t, err)
+}
+
+func TestCheckAtlasCIDRBlock(t *testing.T) {
+	testCases := map[string]struct {
+		cidr          string
+		expectedError string
+	}{
+		"private 10/8":          {cidr: "10.8.0.0/21"},
+		"private 172.16/12":     {cidr: "172.20.0.0/21"},
+		"private 192.168/16":    {cidr: "192.168.0.0/24"},
+		"public":                {cidr: "8.8.0.0/21", expectedError: "must be in one of the RFC 1918 private networks"},
+		"wider than private":    {cidr: "172.0.0.0/8", expectedError: "must be in one of the RFC 1918 private networks"},
+		"loopback":              {cidr: "127.0.0.0/21", expectedError: "overlaps the reserved range 127.0.0.0/8"},
+		"link-local":            {cidr: "169.254.0.0/24", expectedError: "overlaps the reserved range 169.254.0.0/16"},
+		"containing a reserved": {cidr: "0.0.0.0/0", expectedError: "overlaps the reserved range 0.0.0.0/8"},
+		"invalid":               {cidr: "10.8.1.0/21", expectedError: "host bits must be zero"},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := validate.CheckAtlasCIDRBlock(tc.cidr)
+			if tc.expectedError == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, tc.expectedError)
+		})
+	}
+}
@@ -0,0 +1,43 @@
+package validate
+
+import "strings"
+
+const (
+	minMatchDistance  = 2
+	charsPerMatchEdit = 5
+)
+
+// ClosestMatch returns the candidate closest to value by edit distance, ignoring case, or an empty string when no candidate
+// is close enough to be a likely typo. It is meant for "did you mean" hints in plan-time diagnostics.
+func ClosestMatch(value string, candidates []string) string {
+	target := strings.ToUpper(value)
+	maxDistance := max(minMatchDistance, len(target)/charsPerMatchEdit)
+	best, bestDistance := "", maxDistance+1
+	for _, candidate := range candidates {
+		if d := levenshtein(target, strings.ToUpper(candidate)); d < bestDistance {
+			best, bestDistance = candidate, d
+		}
+	}
+	return best
+}
+
+func levenshtein(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(rb)]
+}
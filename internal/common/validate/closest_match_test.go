@@ -0,0 +1,29 @@
+package validate_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/validate"
+)
+
+func TestClosestMatch(t *testing.T) {
+	candidates := []string{"NO_PRIMARY", "HOST_DOWN", "OUTSIDE_METRIC_THRESHOLD", "find", "insert"}
+	testCases := map[string]struct {
+		value    string
+		expected string
+	}{
+		"exact match":         {value: "HOST_DOWN", expected: "HOST_DOWN"},
+		"different case":      {value: "FIND", expected: "find"},
+		"single typo":         {value: "NO_PRIMRY", expected: "NO_PRIMARY"},
+		"long name two typos": {value: "OUTSIDE_METRC_THRESHOL", expected: "OUTSIDE_METRIC_THRESHOLD"},
+		"no close candidate":  {value: "CLUSTER_MONGOS_IS_MISSING", expected: ""},
+		"empty value":         {value: "", expected: ""},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, validate.ClosestMatch(tc.value, candidates))
+		})
+	}
+}
@@ -0,0 +1,39 @@
+package validate
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/conversion"
+)
+
+type TimestampValidator struct{}
+
+func (v TimestampValidator) Description(_ context.Context) string {
+	return "string value must be a valid RFC3339 timestamp, e.g. 2025-01-02T15:04:05Z"
+}
+
+func (v TimestampValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v TimestampValidator) ValidateString(ctx context.Context, req validator.StringRequest, response *validator.StringResponse) {
+	// If the value is unknown or null, there is nothing to validate.
+	if req.ConfigValue.IsUnknown() || req.ConfigValue.IsNull() {
+		return
+	}
+
+	if _, ok := conversion.StringToTime(req.ConfigValue.ValueString()); !ok {
+		response.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+			req.Path,
+			v.Description(ctx),
+			req.ConfigValue.ValueString(),
+		))
+	}
+}
+
+func StringIsTimestamp() validator.String {
+	return TimestampValidator{}
+}
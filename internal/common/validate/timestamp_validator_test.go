@@ -0,0 +1,33 @@
+package validate_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/validate"
+)
+
+func TestStringIsTimestamp(t *testing.T) {
+	tests := map[string]struct {
+		value   string
+		wantErr bool
+	}{
+		"UTC":                {value: "2025-01-02T15:04:05Z"},
+		"fractional seconds": {value: "2025-01-02T15:04:05.123Z"},
+		"offset":             {value: "2025-01-02T15:04:05+02:00"},
+		"date only":          {value: "2025-01-02", wantErr: true},
+		"missing zone":       {value: "2025-01-02T15:04:05", wantErr: true},
+		"empty":              {value: "", wantErr: true},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			req := validator.StringRequest{ConfigValue: types.StringValue(tc.value)}
+			resp := validator.StringResponse{}
+			validate.StringIsTimestamp().ValidateString(t.Context(), req, &resp)
+			assert.Equal(t, tc.wantErr, resp.Diagnostics.HasError())
+		})
+	}
+}
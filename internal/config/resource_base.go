@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 )
 
@@ -59,6 +60,29 @@ func (d *DSCommon) Configure(ctx context.Context, req datasource.ConfigureReques
 	d.Client = client
 }
 
+// EphemeralCommon is used as an embedded struct for all framework ephemeral resources. Implements the following plugin-framework defined functions:
+// - Metadata
+// - Configure
+// Client is left empty and populated by the framework when envoking Configure method.
+// EphemeralResourceName must be defined when creating an instance of an ephemeral resource.
+type EphemeralCommon struct {
+	Client                *MongoDBClient
+	EphemeralResourceName string
+}
+
+func (e *EphemeralCommon) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_%s", req.ProviderTypeName, e.EphemeralResourceName)
+}
+
+func (e *EphemeralCommon) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	client, err := configureClient(req.ProviderData)
+	if err != nil {
+		resp.Diagnostics.AddError(errorConfigureSummary, err.Error())
+		return
+	}
+	e.Client = client
+}
+
 func configureClient(providerData any) (*MongoDBClient, error) {
 	if providerData == nil {
 		return nil, nil
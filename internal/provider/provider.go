@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
@@ -26,19 +27,31 @@ import (
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/validate"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/config"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/advancedclustertpf"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/alert"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/alertconfiguration"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/atlasuser"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/cidrcheck"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/cloudprovideraccess"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/controlplaneipaddresses"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/customdbroleinheritancecheck"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/databaseuser"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/databaseusereffectiveprivileges"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/encryptionatrest"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/encryptionatrestprivateendpoint"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/event"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/flexcluster"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/flexrestorejob"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/flexsnapshot"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/mongodbemployeeaccessgrant"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/networkhealth"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/privatelinkendpointserverless"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/privatelinkendpointservicedatafederationonlinearchive"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/privatelinkendpointserviceserverless"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/project"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/projectipaccesslist"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/projectipaddresses"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/projectlimit"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/projectsettings"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/pushbasedlogexport"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/resourcepolicy"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/searchdeployment"
@@ -47,6 +60,8 @@ import (
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/streaminstance"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/streamprivatelinkendpoint"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/streamprocessor"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/teamprojectassignment"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/thirdpartyintegration"
 	"github.com/mongodb/terraform-provider-mongodbatlas/version"
 )
 
@@ -58,6 +73,8 @@ const (
 	MissingAuthAttrError  = "either Atlas Programmatic API Keys or AWS Secrets Manager attributes must be set"
 )
 
+var _ provider.ProviderWithEphemeralResources = &MongodbtlasProvider{}
+
 type MongodbtlasProvider struct {
 }
 
@@ -278,6 +295,7 @@ func (p *MongodbtlasProvider) Configure(ctx context.Context, req provider.Config
 
 	resp.DataSourceData = client
 	resp.ResourceData = client
+	resp.EphemeralResourceData = client
 }
 
 // parseTfModel extracts the values from tfAssumeRoleModel creating a new instance of our internal model AssumeRole used in Config
@@ -456,6 +474,13 @@ func (p *MongodbtlasProvider) DataSources(context.Context) []func() datasource.D
 		flexrestorejob.PluralDataSource,
 		resourcepolicy.DataSource,
 		resourcepolicy.PluralDataSource,
+		alert.PluralDataSource,
+		event.PluralDataSource,
+		cidrcheck.DataSource,
+		customdbroleinheritancecheck.DataSource,
+		projectlimit.PluralDataSource,
+		networkhealth.DataSource,
+		databaseusereffectiveprivileges.DataSource,
 	}
 	if config.PreviewProviderV2AdvancedCluster() {
 		dataSources = append(dataSources, advancedclustertpf.DataSource, advancedclustertpf.PluralDataSource)
@@ -468,8 +493,10 @@ func (p *MongodbtlasProvider) Resources(context.Context) []func() resource.Resou
 		project.Resource,
 		encryptionatrest.Resource,
 		databaseuser.Resource,
+		databaseuser.SetResource,
 		alertconfiguration.Resource,
 		projectipaccesslist.Resource,
+		projectipaccesslist.SetResource,
 		searchdeployment.Resource,
 		pushbasedlogexport.Resource,
 		streaminstance.Resource,
@@ -480,6 +507,15 @@ func (p *MongodbtlasProvider) Resources(context.Context) []func() resource.Resou
 		streamprivatelinkendpoint.Resource,
 		flexcluster.Resource,
 		resourcepolicy.Resource,
+		thirdpartyintegration.Resource,
+		flexrestorejob.Resource,
+		cloudprovideraccess.RoleResource,
+		projectlimit.Resource,
+		projectsettings.Resource,
+		teamprojectassignment.Resource,
+		privatelinkendpointserverless.Resource,
+		privatelinkendpointserviceserverless.Resource,
+		privatelinkendpointservicedatafederationonlinearchive.Resource,
 	}
 	if config.PreviewProviderV2AdvancedCluster() {
 		resources = append(resources, advancedclustertpf.Resource)
@@ -487,6 +523,13 @@ func (p *MongodbtlasProvider) Resources(context.Context) []func() resource.Resou
 	return resources
 }
 
+func (p *MongodbtlasProvider) EphemeralResources(context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		flexsnapshot.EphemeralResource,
+		databaseuser.PasswordEphemeralResource,
+	}
+}
+
 func NewFrameworkProvider() provider.Provider {
 	return &MongodbtlasProvider{}
 }
@@ -45,7 +45,6 @@ import (
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/orginvitation"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/privateendpointregionalmode"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/privatelinkendpoint"
-	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/privatelinkendpointserverless"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/privatelinkendpointservice"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/privatelinkendpointservicedatafederationonlinearchive"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/privatelinkendpointserviceserverless"
@@ -222,51 +221,47 @@ func getDataSourcesMap() map[string]*schema.Resource {
 
 func getResourcesMap() map[string]*schema.Resource {
 	resourcesMap := map[string]*schema.Resource{
-		"mongodbatlas_api_key":                           apikey.Resource(),
-		"mongodbatlas_access_list_api_key":               accesslistapikey.Resource(),
-		"mongodbatlas_project_api_key":                   projectapikey.Resource(),
-		"mongodbatlas_custom_db_role":                    customdbrole.Resource(),
-		"mongodbatlas_cluster":                           cluster.Resource(),
-		"mongodbatlas_network_container":                 networkcontainer.Resource(),
-		"mongodbatlas_network_peering":                   networkpeering.Resource(),
-		"mongodbatlas_maintenance_window":                maintenancewindow.Resource(),
-		"mongodbatlas_auditing":                          auditing.Resource(),
-		"mongodbatlas_team":                              team.Resource(),
-		"mongodbatlas_teams":                             team.LegacyTeamsResource(),
-		"mongodbatlas_global_cluster_config":             globalclusterconfig.Resource(),
-		"mongodbatlas_x509_authentication_database_user": x509authenticationdatabaseuser.Resource(),
-		"mongodbatlas_private_endpoint_regional_mode":    privateendpointregionalmode.Resource(),
-		"mongodbatlas_privatelink_endpoint_service_data_federation_online_archive": privatelinkendpointservicedatafederationonlinearchive.Resource(),
-		"mongodbatlas_privatelink_endpoint":                                        privatelinkendpoint.Resource(),
-		"mongodbatlas_privatelink_endpoint_serverless":                             privatelinkendpointserverless.Resource(),
-		"mongodbatlas_privatelink_endpoint_service":                                privatelinkendpointservice.Resource(),
-		"mongodbatlas_privatelink_endpoint_service_serverless":                     privatelinkendpointserviceserverless.Resource(),
-		"mongodbatlas_third_party_integration":                                     thirdpartyintegration.Resource(),
-		"mongodbatlas_online_archive":                                              onlinearchive.Resource(),
-		"mongodbatlas_custom_dns_configuration_cluster_aws":                        customdnsconfigurationclusteraws.Resource(),
-		"mongodbatlas_ldap_configuration":                                          ldapconfiguration.Resource(),
-		"mongodbatlas_ldap_verify":                                                 ldapverify.Resource(),
-		"mongodbatlas_cloud_provider_access_setup":                                 cloudprovideraccess.ResourceSetup(),
-		"mongodbatlas_cloud_provider_access_authorization":                         cloudprovideraccess.ResourceAuthorization(),
-		"mongodbatlas_search_index":                                                searchindex.Resource(),
-		"mongodbatlas_data_lake_pipeline":                                          datalakepipeline.Resource(),
-		"mongodbatlas_event_trigger":                                               eventtrigger.Resource(),
-		"mongodbatlas_project_invitation":                                          projectinvitation.Resource(),
-		"mongodbatlas_org_invitation":                                              orginvitation.Resource(),
-		"mongodbatlas_organization":                                                organization.Resource(),
-		"mongodbatlas_backup_compliance_policy":                                    backupcompliancepolicy.Resource(),
-		"mongodbatlas_cloud_backup_schedule":                                       cloudbackupschedule.Resource(),
-		"mongodbatlas_cloud_backup_snapshot":                                       cloudbackupsnapshot.Resource(),
-		"mongodbatlas_cloud_backup_snapshot_export_bucket":                         cloudbackupsnapshotexportbucket.Resource(),
-		"mongodbatlas_cloud_backup_snapshot_export_job":                            cloudbackupsnapshotexportjob.Resource(),
-		"mongodbatlas_cloud_backup_snapshot_restore_job":                           cloudbackupsnapshotrestorejob.Resource(),
-		"mongodbatlas_federated_settings_org_config":                               federatedsettingsorgconfig.Resource(),
-		"mongodbatlas_federated_settings_org_role_mapping":                         federatedsettingsorgrolemapping.Resource(),
-		"mongodbatlas_federated_settings_identity_provider":                        federatedsettingsidentityprovider.Resource(),
-		"mongodbatlas_federated_database_instance":                                 federateddatabaseinstance.Resource(),
-		"mongodbatlas_federated_query_limit":                                       federatedquerylimit.Resource(),
-		"mongodbatlas_serverless_instance":                                         serverlessinstance.Resource(),
-		"mongodbatlas_cluster_outage_simulation":                                   clusteroutagesimulation.Resource(),
+		"mongodbatlas_api_key":                              apikey.Resource(),
+		"mongodbatlas_access_list_api_key":                  accesslistapikey.Resource(),
+		"mongodbatlas_project_api_key":                      projectapikey.Resource(),
+		"mongodbatlas_custom_db_role":                       customdbrole.Resource(),
+		"mongodbatlas_cluster":                              cluster.Resource(),
+		"mongodbatlas_network_container":                    networkcontainer.Resource(),
+		"mongodbatlas_network_peering":                      networkpeering.Resource(),
+		"mongodbatlas_maintenance_window":                   maintenancewindow.Resource(),
+		"mongodbatlas_auditing":                             auditing.Resource(),
+		"mongodbatlas_team":                                 team.Resource(),
+		"mongodbatlas_teams":                                team.LegacyTeamsResource(),
+		"mongodbatlas_global_cluster_config":                globalclusterconfig.Resource(),
+		"mongodbatlas_x509_authentication_database_user":    x509authenticationdatabaseuser.Resource(),
+		"mongodbatlas_private_endpoint_regional_mode":       privateendpointregionalmode.Resource(),
+		"mongodbatlas_privatelink_endpoint":                 privatelinkendpoint.Resource(),
+		"mongodbatlas_privatelink_endpoint_service":         privatelinkendpointservice.Resource(),
+		"mongodbatlas_online_archive":                       onlinearchive.Resource(),
+		"mongodbatlas_custom_dns_configuration_cluster_aws": customdnsconfigurationclusteraws.Resource(),
+		"mongodbatlas_ldap_configuration":                   ldapconfiguration.Resource(),
+		"mongodbatlas_ldap_verify":                          ldapverify.Resource(),
+		"mongodbatlas_cloud_provider_access_setup":          cloudprovideraccess.ResourceSetup(),
+		"mongodbatlas_cloud_provider_access_authorization":  cloudprovideraccess.ResourceAuthorization(),
+		"mongodbatlas_search_index":                         searchindex.Resource(),
+		"mongodbatlas_data_lake_pipeline":                   datalakepipeline.Resource(),
+		"mongodbatlas_event_trigger":                        eventtrigger.Resource(),
+		"mongodbatlas_project_invitation":                   projectinvitation.Resource(),
+		"mongodbatlas_org_invitation":                       orginvitation.Resource(),
+		"mongodbatlas_organization":                         organization.Resource(),
+		"mongodbatlas_backup_compliance_policy":             backupcompliancepolicy.Resource(),
+		"mongodbatlas_cloud_backup_schedule":                cloudbackupschedule.Resource(),
+		"mongodbatlas_cloud_backup_snapshot":                cloudbackupsnapshot.Resource(),
+		"mongodbatlas_cloud_backup_snapshot_export_bucket":  cloudbackupsnapshotexportbucket.Resource(),
+		"mongodbatlas_cloud_backup_snapshot_export_job":     cloudbackupsnapshotexportjob.Resource(),
+		"mongodbatlas_cloud_backup_snapshot_restore_job":    cloudbackupsnapshotrestorejob.Resource(),
+		"mongodbatlas_federated_settings_org_config":        federatedsettingsorgconfig.Resource(),
+		"mongodbatlas_federated_settings_org_role_mapping":  federatedsettingsorgrolemapping.Resource(),
+		"mongodbatlas_federated_settings_identity_provider": federatedsettingsidentityprovider.Resource(),
+		"mongodbatlas_federated_database_instance":          federateddatabaseinstance.Resource(),
+		"mongodbatlas_federated_query_limit":                federatedquerylimit.Resource(),
+		"mongodbatlas_serverless_instance":                  serverlessinstance.Resource(),
+		"mongodbatlas_cluster_outage_simulation":            clusteroutagesimulation.Resource(),
 	}
 	if !config.PreviewProviderV2AdvancedCluster() {
 		resourcesMap["mongodbatlas_advanced_cluster"] = advancedcluster.Resource()
@@ -7,6 +7,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	providerfw "github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -99,6 +100,41 @@ func TestDataSourceSchemas(t *testing.T) {
 	}
 }
 
+func TestEphemeralResourceSchemas(t *testing.T) {
+	t.Parallel()
+	prov, ok := provider.NewFrameworkProvider().(providerfw.ProviderWithEphemeralResources)
+	if !ok {
+		t.Fatal("provider doesn't implement ephemeral resources")
+	}
+	var provReq providerfw.MetadataRequest
+	var provRes providerfw.MetadataResponse
+	prov.Metadata(t.Context(), provReq, &provRes)
+	for _, fn := range prov.EphemeralResources(t.Context()) {
+		res := fn()
+		metadataReq := ephemeral.MetadataRequest{
+			ProviderTypeName: provRes.TypeName,
+		}
+		var metadataRes ephemeral.MetadataResponse
+		res.Metadata(t.Context(), metadataReq, &metadataRes)
+
+		t.Run(metadataRes.TypeName, func(t *testing.T) {
+			schemaRequest := ephemeral.SchemaRequest{}
+			schemaResponse := &ephemeral.SchemaResponse{}
+			res.Schema(t.Context(), schemaRequest, schemaResponse)
+			checkDescriptor(metadataRes.TypeName, schemaResponse.Schema, &schemaResponse.Diagnostics)
+			validateAttributes(metadataRes.TypeName, schemaResponse.Schema.GetAttributes(), &schemaResponse.Diagnostics)
+
+			if schemaResponse.Diagnostics.HasError() {
+				t.Fatalf("Schema method diagnostics: %+v", schemaResponse.Diagnostics)
+			}
+
+			if diagnostics := schemaResponse.Schema.ValidateImplementation(t.Context()); diagnostics.HasError() {
+				t.Fatalf("Schema validation diagnostics: %+v", diagnostics)
+			}
+		})
+	}
+}
+
 func validateDocumentation(name string, resp *resource.SchemaResponse) {
 	checkDescriptor(name, resp.Schema, &resp.Diagnostics)
 	validateAttributes(name, resp.Schema.GetAttributes(), &resp.Diagnostics)
@@ -1241,3 +1241,29 @@ func flattenAdvancedReplicationSpecOldShardingConfig(ctx context.Context, apiObj
 
 	return tfMap, nil
 }
+
+func expandApplyGuard(tfList []any) *advancedclustertpf.ApplyGuard {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+	tfMap := tfList[0].(map[string]any)
+	guard := &advancedclustertpf.ApplyGuard{
+		Mode: tfMap["mode"].(string),
+	}
+	if guard.Mode == "" {
+		guard.Mode = advancedclustertpf.ApplyGuardModeBlock
+	}
+	windows, _ := tfMap["allowed_windows"].([]any)
+	for _, w := range windows {
+		windowMap, ok := w.(map[string]any)
+		if !ok {
+			continue
+		}
+		guard.Windows = append(guard.Windows, advancedclustertpf.ApplyWindow{
+			DayOfWeek:      windowMap["day_of_week"].(int),
+			StartHourOfDay: windowMap["start_hour_of_day"].(int),
+			EndHourOfDay:   windowMap["end_hour_of_day"].(int),
+		})
+	}
+	return guard
+}
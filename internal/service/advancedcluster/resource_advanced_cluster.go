@@ -58,6 +58,7 @@ func Resource() *schema.Resource {
 		ReadWithoutTimeout:   resourceRead,
 		UpdateWithoutTimeout: resourceUpdateOrUpgrade,
 		DeleteWithoutTimeout: resourceDelete,
+		CustomizeDiff:        resourceCustomizeDiff,
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceImport,
 		},
@@ -383,6 +384,7 @@ func Resource() *schema.Resource {
 					},
 				},
 			},
+			"apply_guard": schemaApplyGuard(),
 		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(3 * time.Hour),
@@ -392,6 +394,48 @@ func Resource() *schema.Resource {
 	}
 }
 
+func schemaApplyGuard() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"mode": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      advancedclustertpf.ApplyGuardModeBlock,
+					ValidateFunc: validation.StringInSlice(advancedclustertpf.ApplyGuardModes, false),
+				},
+				"allowed_windows": {
+					Type:     schema.TypeList,
+					Required: true,
+					MinItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"day_of_week": {
+								Type:         schema.TypeInt,
+								Required:     true,
+								ValidateFunc: validation.IntBetween(1, 7),
+							},
+							"start_hour_of_day": {
+								Type:         schema.TypeInt,
+								Required:     true,
+								ValidateFunc: validation.IntBetween(0, 23),
+							},
+							"end_hour_of_day": {
+								Type:         schema.TypeInt,
+								Required:     true,
+								ValidateFunc: validation.IntBetween(1, 24),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schemaSpecs() *schema.Schema {
 	return &schema.Schema{
 		Type:     schema.TypeList,
@@ -869,6 +913,16 @@ func isUsingOldShardingConfiguration(d *schema.ResourceData) bool {
 }
 
 func resourceUpdateOrUpgrade(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	if d.HasChangesExcept("apply_guard", "delete_on_create_timeout", "retain_backups_enabled") {
+		timeout, err := advancedclustertpf.EnforceApplyGuard(ctx, expandApplyGuard(d.Get("apply_guard").([]any)), advancedclustertpf.SystemClock, "update", d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		// the update waits use the update timeout, the context deadline keeps the time spent in the apply_guard wait within it
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
 	replicationSpecs := expandAdvancedReplicationSpecs(d.Get("replication_specs").([]any), nil)
 
 	if advancedclustertpf.IsFlex(replicationSpecs) {
@@ -900,6 +954,23 @@ func GetUpgradeToFlexClusterRequest(d *schema.ResourceData) *admin.LegacyAtlasTe
 	}
 }
 
+func resourceCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	guard := expandApplyGuard(d.Get("apply_guard").([]any))
+	if guard == nil {
+		return nil
+	}
+	for i := range guard.Windows {
+		windowKey := fmt.Sprintf("apply_guard.0.allowed_windows.%d", i)
+		if !d.NewValueKnown(windowKey+".start_hour_of_day") || !d.NewValueKnown(windowKey+".end_hour_of_day") {
+			continue // validated during apply once the values are known
+		}
+		if err := guard.Windows[i].Validate(); err != nil {
+			return fmt.Errorf("apply_guard.allowed_windows[%d]: %w", i, err)
+		}
+	}
+	return nil
+}
+
 func resourceUpgrade(ctx context.Context, upgradeRequest *admin.LegacyAtlasTenantClusterUpgradeRequest, flexUpgradeRequest *admin.AtlasTenantClusterUpgradeRequest20240805, d *schema.ResourceData, meta any) diag.Diagnostics {
 	connV2 := meta.(*config.MongoDBClient).AtlasV2
 	ids := conversion.DecodeStateID(d.Id())
@@ -1324,6 +1395,13 @@ func resourceDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.
 	if v, ok := d.GetOkExists("retain_backups_enabled"); ok {
 		params.RetainBackups = conversion.Pointer(v.(bool))
 	}
+	timeout, err := advancedclustertpf.EnforceApplyGuard(ctx, expandApplyGuard(d.Get("apply_guard").([]any)), advancedclustertpf.SystemClock, "delete", d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	// the delete wait uses the delete timeout, the context deadline keeps the time spent in the apply_guard wait within it
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
 
 	replicationSpecs := expandAdvancedReplicationSpecs(d.Get("replication_specs").([]any), nil)
 
@@ -1334,7 +1412,7 @@ func resourceDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.
 		}
 		return nil
 	}
-	_, err := connV2.ClustersApi.DeleteClusterWithParams(ctx, params).Execute()
+	_, err = connV2.ClustersApi.DeleteClusterWithParams(ctx, params).Execute()
 	if err != nil {
 		return diag.FromErr(fmt.Errorf(errorDelete, clusterName, err))
 	}
@@ -0,0 +1,154 @@
+package advancedclustertpf
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+const (
+	ApplyGuardModeBlock = "BLOCK"
+	ApplyGuardModeWait  = "WAIT"
+
+	daysInWeek  = 7
+	hoursInDay  = 24
+	hoursInWeek = daysInWeek * hoursInDay
+)
+
+var ApplyGuardModes = []string{ApplyGuardModeBlock, ApplyGuardModeWait}
+
+// ApplyWindow is a period of a week when cluster changes are allowed. It uses the same semantics as mongodbatlas_maintenance_window:
+// DayOfWeek is 1-based starting on Sunday (Su=1, M=2, ..., Sa=7) and hours use the 24-hour clock in UTC.
+// StartHourOfDay is inclusive and EndHourOfDay is exclusive, use 24 for a window that lasts until midnight.
+type ApplyWindow struct {
+	DayOfWeek      int
+	StartHourOfDay int
+	EndHourOfDay   int
+}
+
+// ApplyGuard restricts when cluster updates and deletions can be applied. In BLOCK mode the operation fails outside the allowed
+// windows, in WAIT mode the operation waits until the next window opens if it does so before the operation timeout.
+type ApplyGuard struct {
+	Mode    string
+	Windows []ApplyWindow
+}
+
+// Clock allows tests to control the time used by the apply guard.
+type Clock interface {
+	Now() time.Time
+	After(d time.Duration) <-chan time.Time
+}
+
+type systemClock struct{}
+
+func (systemClock) Now() time.Time                         { return time.Now() }
+func (systemClock) After(d time.Duration) <-chan time.Time { return time.After(d) }
+
+var SystemClock Clock = systemClock{}
+
+func (w *ApplyWindow) Validate() error {
+	if w.DayOfWeek < 1 || w.DayOfWeek > daysInWeek {
+		return fmt.Errorf("day_of_week must be between 1 (Sunday) and 7 (Saturday), got %d", w.DayOfWeek)
+	}
+	if w.StartHourOfDay < 0 || w.StartHourOfDay >= hoursInDay {
+		return fmt.Errorf("start_hour_of_day must be between 0 and 23, got %d", w.StartHourOfDay)
+	}
+	if w.EndHourOfDay <= w.StartHourOfDay || w.EndHourOfDay > hoursInDay {
+		return fmt.Errorf("end_hour_of_day must be greater than start_hour_of_day (%d) and at most 24, got %d", w.StartHourOfDay, w.EndHourOfDay)
+	}
+	return nil
+}
+
+func (w *ApplyWindow) contains(t time.Time) bool {
+	t = t.UTC()
+	return int(t.Weekday())+1 == w.DayOfWeek && t.Hour() >= w.StartHourOfDay && t.Hour() < w.EndHourOfDay
+}
+
+func (w *ApplyWindow) String() string {
+	return fmt.Sprintf("%s %02d:00-%02d:00 UTC", time.Weekday(w.DayOfWeek-1), w.StartHourOfDay, w.EndHourOfDay)
+}
+
+func (g *ApplyGuard) Validate() error {
+	if g.Mode != ApplyGuardModeBlock && g.Mode != ApplyGuardModeWait {
+		return fmt.Errorf("mode must be %s or %s, got %s", ApplyGuardModeBlock, ApplyGuardModeWait, g.Mode)
+	}
+	if len(g.Windows) == 0 {
+		return errors.New("at least one allowed window must be defined")
+	}
+	for i := range g.Windows {
+		if err := g.Windows[i].Validate(); err != nil {
+			return fmt.Errorf("allowed_windows[%d]: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// IsAllowed returns true if t is inside any of the allowed windows.
+func (g *ApplyGuard) IsAllowed(t time.Time) bool {
+	for i := range g.Windows {
+		if g.Windows[i].contains(t) {
+			return true
+		}
+	}
+	return false
+}
+
+// NextWindowStart returns the first time after t when an allowed window opens. Windows start on the hour so it's enough to check
+// the hours of the next week.
+func (g *ApplyGuard) NextWindowStart(t time.Time) (time.Time, bool) {
+	start := t.UTC().Truncate(time.Hour)
+	for h := 1; h <= hoursInWeek; h++ {
+		candidate := start.Add(time.Duration(h) * time.Hour)
+		if g.IsAllowed(candidate) {
+			return candidate, true
+		}
+	}
+	return time.Time{}, false
+}
+
+func (g *ApplyGuard) describeWindows() string {
+	desc := ""
+	for i := range g.Windows {
+		if i > 0 {
+			desc += ", "
+		}
+		desc += g.Windows[i].String()
+	}
+	return desc
+}
+
+// EnforceApplyGuard returns the part of timeout left for operation if it can run now. Outside the allowed windows it returns an error in
+// BLOCK mode, and in WAIT mode it waits until the next window opens, returning an error if that happens after timeout. The time spent
+// waiting is subtracted from the returned timeout so the whole operation fits in timeout. A nil guard allows any operation.
+func EnforceApplyGuard(ctx context.Context, guard *ApplyGuard, clock Clock, operation string, timeout time.Duration) (time.Duration, error) {
+	if guard == nil {
+		return timeout, nil
+	}
+	if err := guard.Validate(); err != nil {
+		return 0, fmt.Errorf("invalid apply_guard: %w", err)
+	}
+	now := clock.Now().UTC()
+	if guard.IsAllowed(now) {
+		return timeout, nil
+	}
+	next, found := guard.NextWindowStart(now)
+	if !found {
+		return 0, fmt.Errorf("cluster %s blocked by apply_guard: no allowed window found", operation)
+	}
+	reason := fmt.Sprintf("%s is outside the allowed windows (%s), the next window opens at %s",
+		now.Format(time.RFC3339), guard.describeWindows(), next.Format(time.RFC3339))
+	if guard.Mode != ApplyGuardModeWait {
+		return 0, fmt.Errorf("cluster %s blocked by apply_guard: %s. Apply again during an allowed window, set apply_guard.mode to %s to wait for it, or remove apply_guard", operation, reason, ApplyGuardModeWait)
+	}
+	wait := next.Sub(now)
+	if wait > timeout {
+		return 0, fmt.Errorf("cluster %s blocked by apply_guard: %s, which is after the %s timeout of %s", operation, reason, operation, timeout)
+	}
+	select {
+	case <-ctx.Done():
+		return 0, fmt.Errorf("cluster %s cancelled while waiting for apply_guard window: %w", operation, ctx.Err())
+	case <-clock.After(wait):
+		return timeout - wait, nil
+	}
+}
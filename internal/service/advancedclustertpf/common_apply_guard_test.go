@@ -0,0 +1,138 @@
+package advancedclustertpf_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/advancedclustertpf"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeClock struct {
+	now    time.Time
+	waited time.Duration
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+func (c *fakeClock) After(d time.Duration) <-chan time.Time {
+	c.waited = d
+	ch := make(chan time.Time, 1)
+	ch <- c.now.Add(d)
+	return ch
+}
+
+var (
+	mondayMorning = time.Date(2025, time.June, 2, 9, 30, 0, 0, time.UTC) // Monday
+	mondayWindow  = advancedclustertpf.ApplyWindow{DayOfWeek: 2, StartHourOfDay: 10, EndHourOfDay: 12}
+)
+
+func TestEnforceApplyGuard(t *testing.T) {
+	testCases := map[string]struct {
+		guard           *advancedclustertpf.ApplyGuard
+		now             time.Time
+		timeout         time.Duration
+		expectedErr     string
+		expectedWait    time.Duration
+		expectedTimeout time.Duration
+	}{
+		"nil guard allows any time": {
+			now:             mondayMorning,
+			timeout:         time.Hour,
+			expectedTimeout: time.Hour,
+		},
+		"inside window": {
+			guard:           &advancedclustertpf.ApplyGuard{Mode: advancedclustertpf.ApplyGuardModeBlock, Windows: []advancedclustertpf.ApplyWindow{mondayWindow}},
+			now:             mondayMorning.Add(time.Hour),
+			timeout:         time.Hour,
+			expectedTimeout: time.Hour,
+		},
+		"start hour is inclusive": {
+			guard: &advancedclustertpf.ApplyGuard{Mode: advancedclustertpf.ApplyGuardModeBlock, Windows: []advancedclustertpf.ApplyWindow{mondayWindow}},
+			now:   time.Date(2025, time.June, 2, 10, 0, 0, 0, time.UTC),
+		},
+		"end hour is exclusive": {
+			guard:       &advancedclustertpf.ApplyGuard{Mode: advancedclustertpf.ApplyGuardModeBlock, Windows: []advancedclustertpf.ApplyWindow{mondayWindow}},
+			now:         time.Date(2025, time.June, 2, 12, 0, 0, 0, time.UTC),
+			expectedErr: "cluster update blocked by apply_guard: 2025-06-02T12:00:00Z is outside the allowed windows (Monday 10:00-12:00 UTC), the next window opens at 2025-06-09T10:00:00Z",
+		},
+		"window until midnight": {
+			guard: &advancedclustertpf.ApplyGuard{Mode: advancedclustertpf.ApplyGuardModeBlock, Windows: []advancedclustertpf.ApplyWindow{{DayOfWeek: 7, StartHourOfDay: 20, EndHourOfDay: 24}}},
+			now:   time.Date(2025, time.June, 7, 23, 59, 0, 0, time.UTC), // Saturday
+		},
+		"non UTC time is converted": {
+			guard: &advancedclustertpf.ApplyGuard{Mode: advancedclustertpf.ApplyGuardModeBlock, Windows: []advancedclustertpf.ApplyWindow{mondayWindow}},
+			now:   time.Date(2025, time.June, 2, 12, 30, 0, 0, time.FixedZone("UTC+2", 2*60*60)),
+		},
+		"outside window in block mode": {
+			guard:       &advancedclustertpf.ApplyGuard{Mode: advancedclustertpf.ApplyGuardModeBlock, Windows: []advancedclustertpf.ApplyWindow{mondayWindow}},
+			now:         mondayMorning,
+			expectedErr: "the next window opens at 2025-06-02T10:00:00Z. Apply again during an allowed window",
+		},
+		"outside window in wait mode": {
+			guard:           &advancedclustertpf.ApplyGuard{Mode: advancedclustertpf.ApplyGuardModeWait, Windows: []advancedclustertpf.ApplyWindow{mondayWindow}},
+			now:             mondayMorning,
+			timeout:         time.Hour,
+			expectedWait:    30 * time.Minute,
+			expectedTimeout: 30 * time.Minute,
+		},
+		"wait mode uses closest window": {
+			guard: &advancedclustertpf.ApplyGuard{Mode: advancedclustertpf.ApplyGuardModeWait, Windows: []advancedclustertpf.ApplyWindow{
+				{DayOfWeek: 4, StartHourOfDay: 0, EndHourOfDay: 2},
+				{DayOfWeek: 3, StartHourOfDay: 1, EndHourOfDay: 2},
+			}},
+			now:             mondayMorning,
+			timeout:         24 * time.Hour,
+			expectedWait:    15*time.Hour + 30*time.Minute,
+			expectedTimeout: 8*time.Hour + 30*time.Minute,
+		},
+		"wait mode exceeding timeout": {
+			guard:       &advancedclustertpf.ApplyGuard{Mode: advancedclustertpf.ApplyGuardModeWait, Windows: []advancedclustertpf.ApplyWindow{mondayWindow}},
+			now:         mondayMorning,
+			timeout:     10 * time.Minute,
+			expectedErr: "which is after the update timeout of 10m0s",
+		},
+		"invalid window": {
+			guard:       &advancedclustertpf.ApplyGuard{Mode: advancedclustertpf.ApplyGuardModeBlock, Windows: []advancedclustertpf.ApplyWindow{{DayOfWeek: 2, StartHourOfDay: 12, EndHourOfDay: 10}}},
+			now:         mondayMorning,
+			expectedErr: "invalid apply_guard: allowed_windows[0]: end_hour_of_day must be greater than start_hour_of_day (12) and at most 24, got 10",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			clock := &fakeClock{now: tc.now}
+			timeout, err := advancedclustertpf.EnforceApplyGuard(t.Context(), tc.guard, clock, "update", tc.timeout)
+			if tc.expectedErr != "" {
+				require.ErrorContains(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedWait, clock.waited)
+			assert.Equal(t, tc.expectedTimeout, timeout)
+		})
+	}
+}
+
+func TestEnforceApplyGuardCancelled(t *testing.T) {
+	guard := &advancedclustertpf.ApplyGuard{Mode: advancedclustertpf.ApplyGuardModeWait, Windows: []advancedclustertpf.ApplyWindow{mondayWindow}}
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+	_, err := advancedclustertpf.EnforceApplyGuard(ctx, guard, &blockingClock{now: mondayMorning}, "delete", time.Hour)
+	require.ErrorContains(t, err, "cluster delete cancelled while waiting for apply_guard window")
+}
+
+type blockingClock struct {
+	now time.Time
+}
+
+func (c *blockingClock) Now() time.Time {
+	return c.now
+}
+
+func (c *blockingClock) After(time.Duration) <-chan time.Time {
+	return make(chan time.Time)
+}
@@ -65,6 +65,7 @@ func NewTFModel(ctx context.Context, input *admin.ClusterDescription20240805, di
 		TerminationProtectionEnabled:     types.BoolValue(conversion.SafeValue(input.TerminationProtectionEnabled)),
 		VersionReleaseSystem:             types.StringValue(conversion.SafeValue(input.VersionReleaseSystem)),
 		PinnedFCV:                        pinnedFCV,
+		ApplyGuard:                       types.ObjectNull(ApplyGuardObjType.AttrTypes),
 	}
 }
 
@@ -252,6 +252,19 @@ func (r *rs) Update(ctx context.Context, req resource.UpdateRequest, resp *resou
 	if diags.HasError() {
 		return
 	}
+	diff := findClusterDiff(ctx, &state, &plan, diags)
+	patchReqProcessArgs := update.PatchPayloadTpf(ctx, diags, &state.AdvancedConfiguration, &plan.AdvancedConfiguration, NewAtlasReqAdvancedConfiguration)
+	patchReqProcessArgsLegacy := update.PatchPayloadTpf(ctx, diags, &state.AdvancedConfiguration, &plan.AdvancedConfiguration, NewAtlasReqAdvancedConfigurationLegacy)
+	if diags.HasError() {
+		return
+	}
+	advConfigChanges := !update.IsZeroValues(patchReqProcessArgs) || !update.IsZeroValues(patchReqProcessArgsLegacy)
+	if hasGuardedChanges(&state, &plan, &diff, advConfigChanges) {
+		enforceApplyGuard(ctx, diags, plan.ApplyGuard, operationUpdate, waitParams)
+		if diags.HasError() {
+			return
+		}
+	}
 
 	// FCV update is intentionally handled before any other cluster updates, and will wait for cluster to reach IDLE state before continuing
 	clusterResp := r.applyPinnedFCVChanges(ctx, diags, &state, &plan, waitParams)
@@ -259,32 +272,26 @@ func (r *rs) Update(ctx context.Context, req resource.UpdateRequest, resp *resou
 		return
 	}
 
-	{
-		diff := findClusterDiff(ctx, &state, &plan, diags)
-		if diags.HasError() {
-			return
+	switch {
+	case diff.isUpgradeTenantToFlex:
+		if flexOut := handleFlexUpgrade(ctx, diags, r.Client, waitParams, &plan); flexOut != nil {
+			diags.Append(resp.State.Set(ctx, flexOut)...)
 		}
-		switch {
-		case diff.isUpgradeTenantToFlex:
-			if flexOut := handleFlexUpgrade(ctx, diags, r.Client, waitParams, &plan); flexOut != nil {
-				diags.Append(resp.State.Set(ctx, flexOut)...)
-			}
-			return
-		case diff.isUpdateOfFlex:
-			if flexOut := handleFlexUpdate(ctx, diags, r.Client, &plan); flexOut != nil {
-				diags.Append(resp.State.Set(ctx, flexOut)...)
-			}
-			return
-		case diff.isUpgradeFlexToDedicated():
-			clusterResp = UpgradeFlexToDedicated(ctx, diags, r.Client, waitParams, diff.upgradeFlexToDedicatedReq)
-		case diff.isUpgradeTenant():
-			clusterResp = UpgradeTenant(ctx, diags, r.Client, waitParams, diff.upgradeTenantReq)
-		case diff.isClusterPatchOnly():
-			clusterResp = r.applyClusterChanges(ctx, diags, &state, &plan, diff.clusterPatchOnlyReq, waitParams)
-		}
-		if diags.HasError() {
-			return
+		return
+	case diff.isUpdateOfFlex:
+		if flexOut := handleFlexUpdate(ctx, diags, r.Client, &plan); flexOut != nil {
+			diags.Append(resp.State.Set(ctx, flexOut)...)
 		}
+		return
+	case diff.isUpgradeFlexToDedicated():
+		clusterResp = UpgradeFlexToDedicated(ctx, diags, r.Client, waitParams, diff.upgradeFlexToDedicatedReq)
+	case diff.isUpgradeTenant():
+		clusterResp = UpgradeTenant(ctx, diags, r.Client, waitParams, diff.upgradeTenantReq)
+	case diff.isClusterPatchOnly():
+		clusterResp = r.applyClusterChanges(ctx, diags, &state, &plan, diff.clusterPatchOnlyReq, waitParams)
+	}
+	if diags.HasError() {
+		return
 	}
 	// clusterResp can be nil if there are no changes to the cluster, for example when `delete_on_create_timeout` is changed or only advanced configuration is changed
 	if clusterResp == nil {
@@ -303,11 +310,6 @@ func (r *rs) Update(ctx context.Context, req resource.UpdateRequest, resp *resou
 	if diags.HasError() {
 		return
 	}
-	patchReqProcessArgs := update.PatchPayloadTpf(ctx, diags, &state.AdvancedConfiguration, &plan.AdvancedConfiguration, NewAtlasReqAdvancedConfiguration)
-	patchReqProcessArgsLegacy := update.PatchPayloadTpf(ctx, diags, &state.AdvancedConfiguration, &plan.AdvancedConfiguration, NewAtlasReqAdvancedConfigurationLegacy)
-	if diags.HasError() {
-		return
-	}
 	p := &ProcessArgs{
 		ArgsLegacy:            patchReqProcessArgsLegacy,
 		ArgsDefault:           patchReqProcessArgs,
@@ -343,6 +345,10 @@ func (r *rs) Delete(ctx context.Context, req resource.DeleteRequest, resp *resou
 	if diags.HasError() {
 		return
 	}
+	enforceApplyGuard(ctx, diags, state.ApplyGuard, operationDelete, waitParams)
+	if diags.HasError() {
+		return
+	}
 	retainBackups := conversion.NilForUnknown(state.RetainBackupsEnabled, state.RetainBackupsEnabled.ValueBoolPointer())
 	DeleteCluster(ctx, diags, r.Client, waitParams, retainBackups)
 }
@@ -0,0 +1,102 @@
+package advancedclustertpf
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+const errorApplyGuard = "Cluster change not allowed by apply_guard"
+
+var _ resource.ResourceWithValidateConfig = &rs{}
+
+func (r *rs) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var guardObj types.Object
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("apply_guard"), &guardObj)...)
+	if resp.Diagnostics.HasError() || guardObj.IsNull() || guardObj.IsUnknown() {
+		return
+	}
+	var guardModel TFApplyGuardModel
+	if localDiags := guardObj.As(ctx, &guardModel, basetypes.ObjectAsOptions{}); localDiags.HasError() {
+		return // unknown nested values are validated during apply
+	}
+	if guardModel.AllowedWindows.IsUnknown() {
+		return
+	}
+	var windows []TFApplyWindowModel
+	if localDiags := guardModel.AllowedWindows.ElementsAs(ctx, &windows, false); localDiags.HasError() {
+		return
+	}
+	for i := range windows {
+		window := NewApplyWindow(&windows[i])
+		if window == nil {
+			continue
+		}
+		if err := window.Validate(); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("apply_guard").AtName("allowed_windows").AtListIndex(i), "Invalid apply_guard window", err.Error())
+		}
+	}
+}
+
+// NewApplyGuard returns nil if the guard is not configured.
+func NewApplyGuard(ctx context.Context, guardObj types.Object, diags *diag.Diagnostics) *ApplyGuard {
+	if guardObj.IsNull() || guardObj.IsUnknown() {
+		return nil
+	}
+	var guardModel TFApplyGuardModel
+	if localDiags := guardObj.As(ctx, &guardModel, basetypes.ObjectAsOptions{}); len(localDiags) > 0 {
+		diags.Append(localDiags...)
+		return nil
+	}
+	var windows []TFApplyWindowModel
+	if localDiags := guardModel.AllowedWindows.ElementsAs(ctx, &windows, false); len(localDiags) > 0 {
+		diags.Append(localDiags...)
+		return nil
+	}
+	guard := &ApplyGuard{Mode: guardModel.Mode.ValueString()}
+	if guard.Mode == "" {
+		guard.Mode = ApplyGuardModeBlock
+	}
+	for i := range windows {
+		if window := NewApplyWindow(&windows[i]); window != nil {
+			guard.Windows = append(guard.Windows, *window)
+		}
+	}
+	return guard
+}
+
+// NewApplyWindow returns nil if any of the values is not known yet.
+func NewApplyWindow(window *TFApplyWindowModel) *ApplyWindow {
+	if window.DayOfWeek.IsUnknown() || window.StartHourOfDay.IsUnknown() || window.EndHourOfDay.IsUnknown() {
+		return nil
+	}
+	return &ApplyWindow{
+		DayOfWeek:      int(window.DayOfWeek.ValueInt64()),
+		StartHourOfDay: int(window.StartHourOfDay.ValueInt64()),
+		EndHourOfDay:   int(window.EndHourOfDay.ValueInt64()),
+	}
+}
+
+// hasGuardedChanges returns false when Update doesn't send any change to Atlas: the cluster patch is empty and neither pinned_fcv nor
+// advanced_configuration change, e.g. when the plan only changes apply_guard, timeouts, delete_on_create_timeout or retain_backups_enabled.
+func hasGuardedChanges(state, plan *TFModel, diff *clusterDiff, advConfigChanges bool) bool {
+	return advConfigChanges || !state.PinnedFCV.Equal(plan.PinnedFCV) || diff.isAnyUpgrade() || diff.isUpdateOfFlex || diff.isClusterPatchOnly()
+}
+
+// enforceApplyGuard reduces waitParams.Timeout by the time spent waiting for an allowed window.
+func enforceApplyGuard(ctx context.Context, diags *diag.Diagnostics, guardObj types.Object, operation string, waitParams *ClusterWaitParams) {
+	guard := NewApplyGuard(ctx, guardObj, diags)
+	if diags.HasError() {
+		return
+	}
+	timeout, err := EnforceApplyGuard(ctx, guard, SystemClock, operation, waitParams.Timeout)
+	if err != nil {
+		diags.AddError(errorApplyGuard, err.Error())
+		return
+	}
+	waitParams.Timeout = timeout
+}
@@ -26,6 +26,9 @@ func overrideAttributesWithPrevStateValue(modelIn, modelOut *TFModel) {
 	if modelIn.DeleteOnCreateTimeout.ValueBoolPointer() != nil {
 		modelOut.DeleteOnCreateTimeout = modelIn.DeleteOnCreateTimeout
 	}
+	if !modelIn.ApplyGuard.IsNull() && !modelIn.ApplyGuard.IsUnknown() {
+		modelOut.ApplyGuard = modelIn.ApplyGuard
+	}
 	overrideMapStringWithPrevStateValue(&modelIn.Labels, &modelOut.Labels)
 	overrideMapStringWithPrevStateValue(&modelIn.Tags, &modelOut.Tags)
 }
@@ -6,12 +6,14 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -323,6 +325,7 @@ func resourceSchema(ctx context.Context) schema.Schema {
 				Optional:            true,
 				MarkdownDescription: "Map that contains key-value pairs between 1 to 255 characters in length for tagging and categorizing the cluster.",
 			},
+			"apply_guard": ApplyGuardSchema(),
 			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
 				Create: true,
 				Update: true,
@@ -354,6 +357,7 @@ func dataSourceOverridenFields() map[string]dsschema.Attribute {
 		"use_replication_spec_per_shard":                   useReplicationSpecPerShardSchema(),
 		"accept_data_risks_and_force_replica_set_reconfig": nil,
 		"delete_on_create_timeout":                         nil,
+		"apply_guard":                                      nil,
 	}
 }
 
@@ -364,6 +368,56 @@ func useReplicationSpecPerShardSchema() dsschema.BoolAttribute {
 	}
 }
 
+func ApplyGuardSchema() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Optional:            true,
+		MarkdownDescription: "Restricts when updates and deletions of the cluster can be applied. Changes that only modify `apply_guard`, `timeouts`, `delete_on_create_timeout` or `retain_backups_enabled` are always allowed. Cluster creation is not restricted.",
+		Attributes: map[string]schema.Attribute{
+			"mode": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(ApplyGuardModeBlock),
+				MarkdownDescription: "Behavior outside the allowed windows. `BLOCK` fails the apply with an error, `WAIT` waits until the next window opens, failing if it opens after the update or delete timeout. Defaults to `BLOCK`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(ApplyGuardModes...),
+				},
+			},
+			"allowed_windows": schema.ListNestedAttribute{
+				Required:            true,
+				MarkdownDescription: "Periods of the week when cluster changes are allowed. Days and hours use the same semantics as `mongodbatlas_maintenance_window`.",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"day_of_week": schema.Int64Attribute{
+							Required:            true,
+							MarkdownDescription: "Day of the week of the window as a 1-based integer: Su=1, M=2, T=3, W=4, T=5, F=6, Sa=7.",
+							Validators: []validator.Int64{
+								int64validator.Between(1, daysInWeek),
+							},
+						},
+						"start_hour_of_day": schema.Int64Attribute{
+							Required:            true,
+							MarkdownDescription: "Hour of the day when the window starts, inclusive. Uses the 24-hour clock in UTC.",
+							Validators: []validator.Int64{
+								int64validator.Between(0, hoursInDay-1),
+							},
+						},
+						"end_hour_of_day": schema.Int64Attribute{
+							Required:            true,
+							MarkdownDescription: "Hour of the day when the window ends, exclusive. Uses the 24-hour clock in UTC, use 24 for a window that lasts until midnight. Must be greater than `start_hour_of_day`.",
+							Validators: []validator.Int64{
+								int64validator.Between(1, hoursInDay),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func AutoScalingSchema() schema.SingleNestedAttribute {
 	return schema.SingleNestedAttribute{
 		Computed:            true,
@@ -562,6 +616,7 @@ type TFModel struct {
 	RootCertType                              types.String   `tfsdk:"root_cert_type"`
 	AdvancedConfiguration                     types.Object   `tfsdk:"advanced_configuration"`
 	PinnedFCV                                 types.Object   `tfsdk:"pinned_fcv"`
+	ApplyGuard                                types.Object   `tfsdk:"apply_guard"`
 	TerminationProtectionEnabled              types.Bool     `tfsdk:"termination_protection_enabled"`
 	Paused                                    types.Bool     `tfsdk:"paused"`
 	RetainBackupsEnabled                      types.Bool     `tfsdk:"retain_backups_enabled"`
@@ -787,3 +842,25 @@ var PinnedFCVObjType = types.ObjectType{AttrTypes: map[string]attr.Type{
 	"version":         types.StringType,
 	"expiration_date": types.StringType,
 }}
+
+type TFApplyGuardModel struct {
+	Mode           types.String `tfsdk:"mode"`
+	AllowedWindows types.List   `tfsdk:"allowed_windows"`
+}
+
+type TFApplyWindowModel struct {
+	DayOfWeek      types.Int64 `tfsdk:"day_of_week"`
+	StartHourOfDay types.Int64 `tfsdk:"start_hour_of_day"`
+	EndHourOfDay   types.Int64 `tfsdk:"end_hour_of_day"`
+}
+
+var ApplyWindowObjType = types.ObjectType{AttrTypes: map[string]attr.Type{
+	"day_of_week":       types.Int64Type,
+	"start_hour_of_day": types.Int64Type,
+	"end_hour_of_day":   types.Int64Type,
+}}
+
+var ApplyGuardObjType = types.ObjectType{AttrTypes: map[string]attr.Type{
+	"mode":            types.StringType,
+	"allowed_windows": types.ListType{ElemType: ApplyWindowObjType},
+}}
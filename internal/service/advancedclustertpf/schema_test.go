@@ -34,6 +34,10 @@ func TestAccAdvancedCluster_ValidationErrors(t *testing.T) {
 				Config:      configBasic(projectID, clusterName, "advanced_configuration = {oplog_size_mb = -1}"),
 				ExpectError: regexp.MustCompile("Invalid Attribute Value"),
 			},
+			{
+				Config:      configBasic(projectID, clusterName, "apply_guard = {allowed_windows = [{day_of_week = 1, start_hour_of_day = 10, end_hour_of_day = 5}]}"),
+				ExpectError: regexp.MustCompile("end_hour_of_day must be greater than start_hour_of_day"),
+			},
 		},
 	})
 }
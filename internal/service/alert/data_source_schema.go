@@ -0,0 +1,191 @@
+package alert
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func PluralDataSourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Unique 24-hexadecimal digit string that identifies your project.",
+			},
+			"status": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Status of the alerts to return. Valid values are `OPEN`, `TRACKING` and `CLOSED`. Omit to return alerts in any status.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("OPEN", "TRACKING", "CLOSED"),
+				},
+			},
+			"acknowledged": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Flag that filters alerts by acknowledgement. `true` returns only acknowledged alerts and `false` only alerts that aren't acknowledged. Omit to return both.",
+			},
+			"event_types": schema.SetAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "Event type names of the alerts to return, for example `OUTSIDE_METRIC_THRESHOLD`. Omit to return alerts of any event type.",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"results": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "List of alerts matching the filters.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"alert_id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Unique 24-hexadecimal digit string that identifies the alert.",
+						},
+						"alert_config_id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Unique 24-hexadecimal digit string that identifies the alert configuration that sets this alert.",
+						},
+						"event_type_name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Event type that triggered the alert.",
+						},
+						"status": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "State of this alert at the time you requested its details.",
+						},
+						"acknowledged": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Flag that indicates whether the alert is acknowledged at the time of the read.",
+						},
+						"acknowledged_until": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Date and time until which this alert has been acknowledged. This parameter expresses its value in the ISO 8601 timestamp format in UTC.",
+						},
+						"acknowledgement_comment": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Comment that a MongoDB Cloud user submitted when acknowledging the alert.",
+						},
+						"acknowledging_username": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "MongoDB Cloud username of the person who acknowledged the alert.",
+						},
+						"created": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Date and time when MongoDB Cloud created this alert. This parameter expresses its value in the ISO 8601 timestamp format in UTC.",
+						},
+						"updated": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Date and time when someone last updated this alert. This parameter expresses its value in the ISO 8601 timestamp format in UTC.",
+						},
+						"resolved": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Date and time when the alert closed. This parameter expresses its value in the ISO 8601 timestamp format in UTC.",
+						},
+						"last_notified": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Date and time that any notifications were last sent for this alert. This parameter expresses its value in the ISO 8601 timestamp format in UTC.",
+						},
+						"cluster_name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Human-readable label that identifies the cluster to which this alert applies.",
+						},
+						"hostname_and_port": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Hostname and port of the host to which this alert applies.",
+						},
+						"replica_set_name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Name of the replica set to which this alert applies.",
+						},
+						"metric_name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Name of the metric against which Atlas checks the configured threshold.",
+						},
+						"current_value": schema.SingleNestedAttribute{
+							Computed:            true,
+							MarkdownDescription: "Value of the metric that triggered the alert.",
+							Attributes: map[string]schema.Attribute{
+								"number": schema.Float64Attribute{
+									Computed:            true,
+									MarkdownDescription: "Amount of the metric.",
+								},
+								"units": schema.StringAttribute{
+									Computed:            true,
+									MarkdownDescription: "Element used to express the quantity in **currentValue.number**.",
+								},
+							},
+						},
+						"non_running_host_ids": schema.ListAttribute{
+							ElementType:         types.StringType,
+							Computed:            true,
+							MarkdownDescription: "List of unique 24-hexadecimal character strings that identify the replica set members that are not in PRIMARY nor SECONDARY state.",
+						},
+						"parent_cluster_id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Unique 24-hexadecimal character string that identifies the parent cluster to which this alert applies.",
+						},
+						"instance_name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the Stream Processing Instance to which this alert applies.",
+						},
+						"processor_name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the Stream Processor to which this alert applies.",
+						},
+						"processor_state": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The state of the Stream Processor.",
+						},
+						"processor_error_msg": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The error message associated with the Stream Processor to which this alert applies.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type TFAlertsDSModel struct {
+	ProjectID    types.String   `tfsdk:"project_id"`
+	Status       types.String   `tfsdk:"status"`
+	EventTypes   types.Set      `tfsdk:"event_types"`
+	Acknowledged types.Bool     `tfsdk:"acknowledged"`
+	Results      []TFAlertModel `tfsdk:"results"`
+}
+
+type TFAlertModel struct {
+	AlertID                types.String         `tfsdk:"alert_id"`
+	AlertConfigID          types.String         `tfsdk:"alert_config_id"`
+	EventTypeName          types.String         `tfsdk:"event_type_name"`
+	Status                 types.String         `tfsdk:"status"`
+	AcknowledgedUntil      types.String         `tfsdk:"acknowledged_until"`
+	AcknowledgementComment types.String         `tfsdk:"acknowledgement_comment"`
+	AcknowledgingUsername  types.String         `tfsdk:"acknowledging_username"`
+	Created                types.String         `tfsdk:"created"`
+	Updated                types.String         `tfsdk:"updated"`
+	Resolved               types.String         `tfsdk:"resolved"`
+	LastNotified           types.String         `tfsdk:"last_notified"`
+	ClusterName            types.String         `tfsdk:"cluster_name"`
+	HostnameAndPort        types.String         `tfsdk:"hostname_and_port"`
+	ReplicaSetName         types.String         `tfsdk:"replica_set_name"`
+	MetricName             types.String         `tfsdk:"metric_name"`
+	CurrentValue           *TFCurrentValueModel `tfsdk:"current_value"`
+	NonRunningHostIDs      types.List           `tfsdk:"non_running_host_ids"`
+	ParentClusterID        types.String         `tfsdk:"parent_cluster_id"`
+	InstanceName           types.String         `tfsdk:"instance_name"`
+	ProcessorName          types.String         `tfsdk:"processor_name"`
+	ProcessorState         types.String         `tfsdk:"processor_state"`
+	ProcessorErrorMsg      types.String         `tfsdk:"processor_error_msg"`
+	Acknowledged           types.Bool           `tfsdk:"acknowledged"`
+}
+
+type TFCurrentValueModel struct {
+	Number types.Float64 `tfsdk:"number"`
+	Units  types.String  `tfsdk:"units"`
+}
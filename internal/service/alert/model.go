@@ -0,0 +1,92 @@
+package alert
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"go.mongodb.org/atlas-sdk/v20250312003/admin"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/conversion"
+)
+
+// NewTFAlertsDSModel applies the event_types and acknowledged filters, which the Atlas API doesn't support, to the alerts returned
+// for the project. now is used to decide whether an alert is still acknowledged.
+func NewTFAlertsDSModel(ctx context.Context, cfg *TFAlertsDSModel, alerts []admin.AlertViewForNdsGroup, now time.Time) (*TFAlertsDSModel, diag.Diagnostics) {
+	var eventTypes []string
+	diags := cfg.EventTypes.ElementsAs(ctx, &eventTypes, false)
+	if diags.HasError() {
+		return nil, diags
+	}
+	results := make([]TFAlertModel, 0, len(alerts))
+	for i := range alerts {
+		alert := &alerts[i]
+		if len(eventTypes) > 0 && !slices.Contains(eventTypes, alert.GetEventTypeName()) {
+			continue
+		}
+		acknowledged := IsAcknowledged(alert, now)
+		if !cfg.Acknowledged.IsNull() && cfg.Acknowledged.ValueBool() != acknowledged {
+			continue
+		}
+		tfAlert, localDiags := NewTFAlertModel(ctx, alert, acknowledged)
+		diags.Append(localDiags...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		results = append(results, *tfAlert)
+	}
+	return &TFAlertsDSModel{
+		ProjectID:    cfg.ProjectID,
+		Status:       cfg.Status,
+		EventTypes:   cfg.EventTypes,
+		Acknowledged: cfg.Acknowledged,
+		Results:      results,
+	}, diags
+}
+
+func NewTFAlertModel(ctx context.Context, alert *admin.AlertViewForNdsGroup, acknowledged bool) (*TFAlertModel, diag.Diagnostics) {
+	nonRunningHostIDs, diags := types.ListValueFrom(ctx, types.StringType, alert.NonRunningHostIds)
+	if diags.HasError() {
+		return nil, diags
+	}
+	var currentValue *TFCurrentValueModel
+	if alert.CurrentValue != nil {
+		currentValue = &TFCurrentValueModel{
+			Number: types.Float64PointerValue(alert.CurrentValue.Number),
+			Units:  types.StringPointerValue(alert.CurrentValue.Units),
+		}
+	}
+	return &TFAlertModel{
+		AlertID:                types.StringPointerValue(alert.Id),
+		AlertConfigID:          types.StringPointerValue(alert.AlertConfigId),
+		EventTypeName:          types.StringPointerValue(alert.EventTypeName),
+		Status:                 types.StringPointerValue(alert.Status),
+		Acknowledged:           types.BoolValue(acknowledged),
+		AcknowledgedUntil:      types.StringPointerValue(conversion.TimePtrToStringPtr(alert.AcknowledgedUntil)),
+		AcknowledgementComment: types.StringPointerValue(alert.AcknowledgementComment),
+		AcknowledgingUsername:  types.StringPointerValue(alert.AcknowledgingUsername),
+		Created:                types.StringPointerValue(conversion.TimePtrToStringPtr(alert.Created)),
+		Updated:                types.StringPointerValue(conversion.TimePtrToStringPtr(alert.Updated)),
+		Resolved:               types.StringPointerValue(conversion.TimePtrToStringPtr(alert.Resolved)),
+		LastNotified:           types.StringPointerValue(conversion.TimePtrToStringPtr(alert.LastNotified)),
+		ClusterName:            types.StringPointerValue(alert.ClusterName),
+		HostnameAndPort:        types.StringPointerValue(alert.HostnameAndPort),
+		ReplicaSetName:         types.StringPointerValue(alert.ReplicaSetName),
+		MetricName:             types.StringPointerValue(alert.MetricName),
+		CurrentValue:           currentValue,
+		NonRunningHostIDs:      nonRunningHostIDs,
+		ParentClusterID:        types.StringPointerValue(alert.ParentClusterId),
+		InstanceName:           types.StringPointerValue(alert.InstanceName),
+		ProcessorName:          types.StringPointerValue(alert.ProcessorName),
+		ProcessorState:         types.StringPointerValue(alert.ProcessorState),
+		ProcessorErrorMsg:      types.StringPointerValue(alert.ProcessorErrorMsg),
+	}, nil
+}
+
+// IsAcknowledged returns true if the alert has been acknowledged and the acknowledgement hasn't expired.
+func IsAcknowledged(alert *admin.AlertViewForNdsGroup, now time.Time) bool {
+	return alert.AcknowledgedUntil != nil && alert.AcknowledgedUntil.After(now)
+}
@@ -0,0 +1,138 @@
+package alert_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go.mongodb.org/atlas-sdk/v20250312003/admin"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/conversion"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/alert"
+)
+
+var (
+	now        = time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	future     = now.Add(time.Hour)
+	past       = now.Add(-time.Hour)
+	openAlert  = admin.AlertViewForNdsGroup{Id: admin.PtrString("open"), EventTypeName: admin.PtrString("HOST_DOWN"), Status: admin.PtrString("OPEN")}
+	ackedAlert = admin.AlertViewForNdsGroup{Id: admin.PtrString("acked"), EventTypeName: admin.PtrString("HOST_DOWN"), Status: admin.PtrString("OPEN"), AcknowledgedUntil: &future}
+	expiredAck = admin.AlertViewForNdsGroup{Id: admin.PtrString("expired"), EventTypeName: admin.PtrString("OUTSIDE_METRIC_THRESHOLD"), Status: admin.PtrString("OPEN"), AcknowledgedUntil: &past}
+	alerts     = []admin.AlertViewForNdsGroup{openAlert, ackedAlert, expiredAck}
+)
+
+func TestNewTFAlertsDSModelFilters(t *testing.T) {
+	testCases := map[string]struct {
+		acknowledged types.Bool
+		eventTypes   []string
+		expectedIDs  []string
+	}{
+		"no filters": {
+			acknowledged: types.BoolNull(),
+			expectedIDs:  []string{"open", "acked", "expired"},
+		},
+		"acknowledged": {
+			acknowledged: types.BoolValue(true),
+			expectedIDs:  []string{"acked"},
+		},
+		"not acknowledged includes expired acknowledgements": {
+			acknowledged: types.BoolValue(false),
+			expectedIDs:  []string{"open", "expired"},
+		},
+		"event types": {
+			acknowledged: types.BoolNull(),
+			eventTypes:   []string{"OUTSIDE_METRIC_THRESHOLD"},
+			expectedIDs:  []string{"expired"},
+		},
+		"event types and acknowledged": {
+			acknowledged: types.BoolValue(false),
+			eventTypes:   []string{"HOST_DOWN"},
+			expectedIDs:  []string{"open"},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			eventTypes := types.SetNull(types.StringType)
+			if tc.eventTypes != nil {
+				eventTypes, _ = types.SetValueFrom(ctx, types.StringType, tc.eventTypes)
+			}
+			cfg := &alert.TFAlertsDSModel{
+				ProjectID:    types.StringValue("projectID"),
+				Status:       types.StringNull(),
+				EventTypes:   eventTypes,
+				Acknowledged: tc.acknowledged,
+			}
+			model, diags := alert.NewTFAlertsDSModel(ctx, cfg, alerts, now)
+			require.False(t, diags.HasError())
+			ids := make([]string, 0, len(model.Results))
+			for _, result := range model.Results {
+				ids = append(ids, result.AlertID.ValueString())
+			}
+			assert.Equal(t, tc.expectedIDs, ids)
+			assert.Equal(t, cfg.EventTypes, model.EventTypes)
+			assert.Equal(t, cfg.Acknowledged, model.Acknowledged)
+		})
+	}
+}
+
+func TestNewTFAlertModel(t *testing.T) {
+	ctx := context.Background()
+	apiAlert := &admin.AlertViewForNdsGroup{
+		Id:                     admin.PtrString("alertID"),
+		AlertConfigId:          admin.PtrString("alertConfigID"),
+		EventTypeName:          admin.PtrString("OUTSIDE_METRIC_THRESHOLD"),
+		Status:                 admin.PtrString("OPEN"),
+		AcknowledgedUntil:      &future,
+		AcknowledgementComment: admin.PtrString("on it"),
+		AcknowledgingUsername:  admin.PtrString("user@example.com"),
+		Created:                &past,
+		Updated:                &now,
+		ClusterName:            admin.PtrString("cluster"),
+		HostnameAndPort:        admin.PtrString("host:27017"),
+		ReplicaSetName:         admin.PtrString("rs0"),
+		MetricName:             admin.PtrString("ASSERT_REGULAR"),
+		CurrentValue:           &admin.NumberMetricValue{Number: admin.PtrFloat64(1.5), Units: admin.PtrString("RAW")},
+		NonRunningHostIds:      &[]string{"host1"},
+	}
+	model, diags := alert.NewTFAlertModel(ctx, apiAlert, true)
+	require.False(t, diags.HasError())
+	expectedHostIDs, _ := types.ListValueFrom(ctx, types.StringType, []string{"host1"})
+	assert.Equal(t, &alert.TFAlertModel{
+		AlertID:                types.StringValue("alertID"),
+		AlertConfigID:          types.StringValue("alertConfigID"),
+		EventTypeName:          types.StringValue("OUTSIDE_METRIC_THRESHOLD"),
+		Status:                 types.StringValue("OPEN"),
+		Acknowledged:           types.BoolValue(true),
+		AcknowledgedUntil:      types.StringValue(conversion.TimeToString(future)),
+		AcknowledgementComment: types.StringValue("on it"),
+		AcknowledgingUsername:  types.StringValue("user@example.com"),
+		Created:                types.StringValue(conversion.TimeToString(past)),
+		Updated:                types.StringValue(conversion.TimeToString(now)),
+		Resolved:               types.StringNull(),
+		LastNotified:           types.StringNull(),
+		ClusterName:            types.StringValue("cluster"),
+		HostnameAndPort:        types.StringValue("host:27017"),
+		ReplicaSetName:         types.StringValue("rs0"),
+		MetricName:             types.StringValue("ASSERT_REGULAR"),
+		CurrentValue:           &alert.TFCurrentValueModel{Number: types.Float64Value(1.5), Units: types.StringValue("RAW")},
+		NonRunningHostIDs:      expectedHostIDs,
+		ParentClusterID:        types.StringNull(),
+		InstanceName:           types.StringNull(),
+		ProcessorName:          types.StringNull(),
+		ProcessorState:         types.StringNull(),
+		ProcessorErrorMsg:      types.StringNull(),
+	}, model)
+}
+
+func TestNewTFAlertModelEmpty(t *testing.T) {
+	model, diags := alert.NewTFAlertModel(context.Background(), &admin.AlertViewForNdsGroup{}, false)
+	require.False(t, diags.HasError())
+	assert.Nil(t, model.CurrentValue)
+	assert.True(t, model.NonRunningHostIDs.IsNull())
+	assert.True(t, model.AlertID.IsNull())
+}
@@ -0,0 +1,73 @@
+package alert
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"go.mongodb.org/atlas-sdk/v20250312003/admin"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/conversion"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/dsschema"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/config"
+)
+
+const (
+	resourceName = "alert"
+	errorRead    = "error getting alerts for project %s"
+)
+
+var _ datasource.DataSource = &pluralDS{}
+var _ datasource.DataSourceWithConfigure = &pluralDS{}
+
+func PluralDataSource() datasource.DataSource {
+	return &pluralDS{
+		DSCommon: config.DSCommon{
+			DataSourceName: fmt.Sprintf("%ss", resourceName),
+		},
+	}
+}
+
+type pluralDS struct {
+	config.DSCommon
+}
+
+func (d *pluralDS) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = PluralDataSourceSchema(ctx)
+	conversion.UpdateSchemaDescription(&resp.Schema)
+}
+
+func (d *pluralDS) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var tfModel TFAlertsDSModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &tfModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	projectID := tfModel.ProjectID.ValueString()
+	alerts, err := ListAlerts(ctx, projectID, tfModel.Status.ValueStringPointer(), d.Client.AtlasV2.AlertsApi)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf(errorRead, projectID), err.Error())
+		return
+	}
+	newTFModel, diags := NewTFAlertsDSModel(ctx, &tfModel, alerts, time.Now())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, newTFModel)...)
+}
+
+func ListAlerts(ctx context.Context, projectID string, status *string, client admin.AlertsApi) ([]admin.AlertViewForNdsGroup, error) {
+	params := admin.ListAlertsApiParams{
+		GroupId: projectID,
+		Status:  status,
+	}
+	return dsschema.AllPages(ctx, func(ctx context.Context, pageNum int) (dsschema.PaginateResponse[admin.AlertViewForNdsGroup], *http.Response, error) {
+		request := client.ListAlertsWithParams(ctx, &params)
+		request = request.PageNum(pageNum)
+		return request.Execute()
+	})
+}
@@ -0,0 +1,60 @@
+package alert_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/testutil/acc"
+)
+
+const dataSourceName = "data.mongodbatlas_alerts.test"
+
+func TestAccAlertsDS_basic(t *testing.T) {
+	var (
+		projectID = acc.ProjectIDExecution(t)
+	)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acc.PreCheckBasic(t) },
+		ProtoV6ProviderFactories: acc.TestAccProviderV6Factories,
+		Steps: []resource.TestStep{
+			{
+				Config: configBasic(projectID, "OPEN"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "project_id", projectID),
+					resource.TestCheckResourceAttr(dataSourceName, "status", "OPEN"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "results.#"),
+				),
+			},
+			{
+				Config: configWithFilters(projectID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "project_id", projectID),
+					resource.TestCheckResourceAttr(dataSourceName, "acknowledged", "false"),
+					resource.TestCheckResourceAttr(dataSourceName, "event_types.#", "2"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "results.#"),
+				),
+			},
+		},
+	})
+}
+
+func configBasic(projectID, status string) string {
+	return fmt.Sprintf(`
+		data "mongodbatlas_alerts" "test" {
+			project_id = %[1]q
+			status     = %[2]q
+		}
+	`, projectID, status)
+}
+
+func configWithFilters(projectID string) string {
+	return fmt.Sprintf(`
+		data "mongodbatlas_alerts" "test" {
+			project_id   = %[1]q
+			acknowledged = false
+			event_types  = ["OUTSIDE_METRIC_THRESHOLD", "HOST_DOWN"]
+		}
+	`, projectID)
+}
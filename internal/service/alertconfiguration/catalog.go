@@ -0,0 +1,192 @@
+package alertconfiguration
+
+import (
+	_ "embed" // used to embed catalog.json
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/validate"
+)
+
+// catalog.json lists the event types, metric names (with their unit family) and per notifier type fields known to the provider.
+// The Atlas API grows faster than provider releases, so names missing from the catalog only raise warnings. Names that differ
+// from a catalog entry only by case, units that don't belong to a metric's family and notifier fields are errors, except interval_min
+// for notifier types that manage the interval in the external service, which is only a warning as Atlas ignores it.
+//
+//go:embed catalog.json
+var catalogJSON []byte
+
+var catalog = mustLoadCatalog()
+
+type alertCatalog struct {
+	UnitFamilies     map[string][]string     `json:"unitFamilies"`
+	Metrics          map[string]string       `json:"metrics"`
+	Notifiers        map[string]notifierSpec `json:"notifiers"`
+	MetricEventTypes []string                `json:"metricEventTypes"`
+	EventTypes       []string                `json:"eventTypes"`
+}
+
+// notifierSpec describes the notification fields for a type_name. Credentials are required unless the notification references an
+// existing integration or notifier through integration_id or notifier_id. Fields not listed are forbidden.
+type notifierSpec struct {
+	Required    []string `json:"required"`
+	Credentials []string `json:"credentials"`
+	Optional    []string `json:"optional"`
+	NoInterval  bool     `json:"noInterval"`
+}
+
+func (s *notifierSpec) allows(field string) bool {
+	return slices.Contains(s.Required, field) || slices.Contains(s.Credentials, field) || slices.Contains(s.Optional, field)
+}
+
+// notificationCommonFields are valid for every notifier type. interval_min is handled separately because some types don't support it.
+var notificationCommonFields = []string{"type_name", "delay_min", "interval_min", "notifier_id", "integration_id", "team_name"}
+
+func mustLoadCatalog() *alertCatalog {
+	var c alertCatalog
+	if err := json.Unmarshal(catalogJSON, &c); err != nil {
+		panic(fmt.Sprintf("invalid alert configuration catalog: %s", err))
+	}
+	return &c
+}
+
+// NotifierTypes returns the notifier type names known by the catalog, sorted.
+func NotifierTypes() []string {
+	names := make([]string, 0, len(catalog.Notifiers))
+	for name := range catalog.Notifiers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// IsMetricEventType returns true if the event type requires a metric_threshold_config block.
+func IsMetricEventType(eventType string) bool {
+	return slices.Contains(catalog.MetricEventTypes, eventType)
+}
+
+// ValidateEventType checks an event type against the catalog.
+func ValidateEventType(p path.Path, eventType types.String) diag.Diagnostics {
+	if eventType.IsNull() || eventType.IsUnknown() {
+		return nil
+	}
+	return validateCatalogName(p, "event type", eventType.ValueString(), catalog.EventTypes)
+}
+
+// ValidateMetricThreshold checks the metric name and units of a metric_threshold_config block against the catalog.
+func ValidateMetricThreshold(p path.Path, metric *TfMetricThresholdConfigModel) diag.Diagnostics {
+	if metric.MetricName.IsNull() || metric.MetricName.IsUnknown() {
+		return nil
+	}
+	metricName := metric.MetricName.ValueString()
+	metricNames := make([]string, 0, len(catalog.Metrics))
+	for name := range catalog.Metrics {
+		metricNames = append(metricNames, name)
+	}
+	sort.Strings(metricNames)
+	diags := validateCatalogName(p.AtName("metric_name"), "metric name", metricName, metricNames)
+
+	family, found := catalog.Metrics[metricName]
+	if !found || metric.Units.IsNull() || metric.Units.IsUnknown() {
+		return diags
+	}
+	allowedUnits := catalog.UnitFamilies[family]
+	if !slices.Contains(allowedUnits, metric.Units.ValueString()) {
+		diags.AddAttributeError(p.AtName("units"), "Invalid metric units",
+			fmt.Sprintf("Metric %s is measured in %s units, units must be one of: %s.", metricName, family, strings.Join(allowedUnits, ", ")))
+	}
+	return diags
+}
+
+// ValidateNotification checks that a notification block sets the fields required by its type_name and no fields that belong to other
+// notifier types. attrs are the notification attributes as read from the configuration.
+func ValidateNotification(p path.Path, attrs map[string]attr.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+	typeName, ok := attrs["type_name"].(types.String)
+	if !ok || typeName.IsNull() || typeName.IsUnknown() {
+		return diags
+	}
+	spec, found := catalog.Notifiers[typeName.ValueString()]
+	if !found {
+		return diags // invalid type names are reported by the type_name validator
+	}
+
+	for _, field := range spec.Required {
+		if !isNotificationFieldSet(attrs[field]) {
+			diags.AddAttributeError(p.AtName(field), "Missing notification field",
+				fmt.Sprintf("%s is required when type_name is %s.", field, typeName.ValueString()))
+		}
+	}
+
+	if !isNotificationFieldSet(attrs["integration_id"]) && !isNotificationFieldSet(attrs["notifier_id"]) {
+		for _, field := range spec.Credentials {
+			if !isNotificationFieldSet(attrs[field]) {
+				diags.AddAttributeError(p.AtName(field), "Missing notification field",
+					fmt.Sprintf("%s is required when type_name is %s, unless integration_id or notifier_id is set.", field, typeName.ValueString()))
+			}
+		}
+	}
+
+	fields := make([]string, 0, len(attrs))
+	for field := range attrs {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+	for _, field := range fields {
+		if !isNotificationFieldSet(attrs[field]) {
+			continue
+		}
+		if field == "interval_min" && spec.NoInterval {
+			diags.AddAttributeWarning(p.AtName(field), "Ignored notification field",
+				fmt.Sprintf("%s is ignored when type_name is %s, the interval is managed in the external service.", field, typeName.ValueString()))
+			continue
+		}
+		if slices.Contains(notificationCommonFields, field) || spec.allows(field) {
+			continue
+		}
+		diags.AddAttributeError(p.AtName(field), "Invalid notification field",
+			fmt.Sprintf("%s must not be set when type_name is %s.", field, typeName.ValueString()))
+	}
+	return diags
+}
+
+// isNotificationFieldSet considers unknown values as set, and false booleans as unset as they are equivalent to omitting the flag.
+func isNotificationFieldSet(value attr.Value) bool {
+	if value == nil || value.IsNull() {
+		return false
+	}
+	if b, ok := value.(types.Bool); ok && !b.IsUnknown() {
+		return b.ValueBool()
+	}
+	if l, ok := value.(types.List); ok && !l.IsUnknown() {
+		return len(l.Elements()) > 0
+	}
+	return true
+}
+
+func validateCatalogName(p path.Path, kind, value string, known []string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if slices.Contains(known, value) {
+		return diags
+	}
+	suggestion := validate.ClosestMatch(value, known)
+	if strings.EqualFold(suggestion, value) {
+		diags.AddAttributeError(p, fmt.Sprintf("Invalid %s", kind),
+			fmt.Sprintf("%s is not a valid %s, names are case sensitive. Did you mean %s?", value, kind, suggestion))
+		return diags
+	}
+	detail := fmt.Sprintf("%s is not a known %s, Atlas may reject it.", value, kind)
+	if suggestion != "" {
+		detail += fmt.Sprintf(" Did you mean %s?", suggestion)
+	}
+	diags.AddAttributeWarning(p, fmt.Sprintf("Unknown %s", kind), detail)
+	return diags
+}
@@ -0,0 +1,185 @@
+package alertconfiguration_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/alertconfiguration"
+)
+
+func TestValidateEventType(t *testing.T) {
+	testCases := map[string]struct {
+		eventType     types.String
+		errorContains string
+		warning       bool
+	}{
+		"known event type":       {eventType: types.StringValue("NO_PRIMARY")},
+		"unknown value":          {eventType: types.StringUnknown()},
+		"wrong case is an error": {eventType: types.StringValue("no_primary"), errorContains: "Did you mean NO_PRIMARY?"},
+		"typo warns with hint":   {eventType: types.StringValue("HOST_DOWNN"), warning: true},
+		"unrelated name warns":   {eventType: types.StringValue("SOME_FUTURE_EVENT"), warning: true},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			diags := alertconfiguration.ValidateEventType(path.Root("event_type"), tc.eventType)
+			assertDiags(t, diags, tc.errorContains, tc.warning)
+		})
+	}
+}
+
+func TestValidateMetricThreshold(t *testing.T) {
+	testCases := map[string]struct {
+		metric        alertconfiguration.TfMetricThresholdConfigModel
+		errorContains string
+		warning       bool
+	}{
+		"raw metric": {
+			metric: alertconfiguration.TfMetricThresholdConfigModel{MetricName: types.StringValue("ASSERT_REGULAR"), Units: types.StringValue("RAW")},
+		},
+		"data metric": {
+			metric: alertconfiguration.TfMetricThresholdConfigModel{MetricName: types.StringValue("MEMORY_RESIDENT"), Units: types.StringValue("GIGABYTES")},
+		},
+		"time metric without units": {
+			metric: alertconfiguration.TfMetricThresholdConfigModel{MetricName: types.StringValue("OPLOG_MASTER_TIME"), Units: types.StringNull()},
+		},
+		"units not matching metric": {
+			metric:        alertconfiguration.TfMetricThresholdConfigModel{MetricName: types.StringValue("ASSERT_REGULAR"), Units: types.StringValue("HOURS")},
+			errorContains: "units must be one of: RAW",
+		},
+		"typo in metric name": {
+			metric:  alertconfiguration.TfMetricThresholdConfigModel{MetricName: types.StringValue("ASSERT_REGULER"), Units: types.StringValue("RAW")},
+			warning: true,
+		},
+		"wrong case metric name": {
+			metric:        alertconfiguration.TfMetricThresholdConfigModel{MetricName: types.StringValue("Assert_Regular")},
+			errorContains: "Did you mean ASSERT_REGULAR?",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			diags := alertconfiguration.ValidateMetricThreshold(path.Root("metric_threshold_config").AtListIndex(0), &tc.metric)
+			assertDiags(t, diags, tc.errorContains, tc.warning)
+		})
+	}
+}
+
+func TestValidateNotification(t *testing.T) {
+	testCases := map[string]struct {
+		attrs           map[string]attr.Value
+		errorContains   string
+		warningContains string
+	}{
+		"DATADOG with key":                 {attrs: notification("DATADOG", "datadog_api_key", "datadog_region", "interval_min")},
+		"DATADOG without key":              {attrs: notification("DATADOG"), errorContains: "datadog_api_key is required"},
+		"DATADOG with email address":       {attrs: notification("DATADOG", "datadog_api_key", "email_address"), errorContains: "email_address must not be set"},
+		"EMAIL with address":               {attrs: notification("EMAIL", "email_address", "interval_min", "delay_min")},
+		"EMAIL without address":            {attrs: notification("EMAIL"), errorContains: "email_address is required"},
+		"EMAIL with datadog key":           {attrs: notification("EMAIL", "email_address", "datadog_api_key"), errorContains: "datadog_api_key must not be set"},
+		"GROUP with roles":                 {attrs: notification("GROUP", "roles", "email_enabled", "sms_enabled")},
+		"GROUP with mobile number":         {attrs: notification("GROUP", "mobile_number"), errorContains: "mobile_number must not be set"},
+		"MICROSOFT_TEAMS with url":         {attrs: notification("MICROSOFT_TEAMS", "microsoft_teams_webhook_url")},
+		"MICROSOFT_TEAMS without url":      {attrs: notification("MICROSOFT_TEAMS"), errorContains: "microsoft_teams_webhook_url is required"},
+		"OPS_GENIE with key":               {attrs: notification("OPS_GENIE", "ops_genie_api_key", "ops_genie_region")},
+		"OPS_GENIE with interval":          {attrs: notification("OPS_GENIE", "ops_genie_api_key", "interval_min"), warningContains: "interval_min is ignored when type_name is OPS_GENIE"},
+		"ORG with roles":                   {attrs: notification("ORG", "roles", "email_enabled")},
+		"ORG with webhook":                 {attrs: notification("ORG", "webhook_url"), errorContains: "webhook_url must not be set"},
+		"PAGER_DUTY with key":              {attrs: notification("PAGER_DUTY", "service_key")},
+		"PAGER_DUTY with integration id":   {attrs: notification("PAGER_DUTY", "integration_id")},
+		"PAGER_DUTY with notifier id":      {attrs: notification("PAGER_DUTY", "notifier_id")},
+		"PAGER_DUTY without key":           {attrs: notification("PAGER_DUTY"), errorContains: "service_key is required"},
+		"SLACK with token and channel":     {attrs: notification("SLACK", "api_token", "channel_name")},
+		"SLACK without channel":            {attrs: notification("SLACK", "api_token"), errorContains: "channel_name is required"},
+		"SMS with mobile number":           {attrs: notification("SMS", "mobile_number")},
+		"SMS with roles":                   {attrs: notification("SMS", "mobile_number", "roles"), errorContains: "roles must not be set"},
+		"TEAM with team id":                {attrs: notification("TEAM", "team_id", "email_enabled")},
+		"TEAM without team id":             {attrs: notification("TEAM"), errorContains: "team_id is required"},
+		"USER with username":               {attrs: notification("USER", "username", "sms_enabled")},
+		"USER with roles":                  {attrs: notification("USER", "username", "roles"), errorContains: "roles must not be set"},
+		"USER without username":            {attrs: notification("USER", "email_enabled"), errorContains: "username is required"},
+		"VICTOR_OPS with keys":             {attrs: notification("VICTOR_OPS", "victor_ops_api_key", "victor_ops_routing_key")},
+		"VICTOR_OPS with slack token":      {attrs: notification("VICTOR_OPS", "victor_ops_api_key", "api_token"), errorContains: "api_token must not be set"},
+		"WEBHOOK with url and secret":      {attrs: notification("WEBHOOK", "webhook_url", "webhook_secret")},
+		"WEBHOOK without url":              {attrs: notification("WEBHOOK", "webhook_secret"), errorContains: "webhook_url is required"},
+		"false flags are ignored":          {attrs: withValues(notification("EMAIL", "email_address"), map[string]attr.Value{"sms_enabled": types.BoolValue(false), "email_enabled": types.BoolValue(false)})},
+		"unknown credential is accepted":   {attrs: withValues(notification("DATADOG"), map[string]attr.Value{"datadog_api_key": types.StringUnknown()})},
+		"unknown type name is skipped":     {attrs: withValues(notification("EMAIL", "datadog_api_key"), map[string]attr.Value{"type_name": types.StringUnknown()})},
+		"empty roles list is ignored":      {attrs: withValues(notification("SMS", "mobile_number"), map[string]attr.Value{"roles": types.ListValueMust(types.StringType, nil)})},
+		"type name not in catalog ignored": {attrs: notification("CARRIER_PIGEON", "email_address")},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			diags := alertconfiguration.ValidateNotification(path.Root("notification").AtListIndex(0), tc.attrs)
+			assertDiags(t, diags, tc.errorContains, tc.warningContains != "")
+			if tc.warningContains != "" {
+				assert.Contains(t, diags.Warnings()[0].Detail(), tc.warningContains)
+			}
+		})
+	}
+}
+
+func TestValidateNotificationCoversEveryNotifierType(t *testing.T) {
+	for _, typeName := range alertconfiguration.NotifierTypes() {
+		t.Run(typeName, func(t *testing.T) {
+			diags := alertconfiguration.ValidateNotification(path.Root("notification").AtListIndex(0), notification(typeName, "email_address", "mobile_number", "username", "team_id", "integration_id"))
+			assert.True(t, diags.HasError(), "mixing fields of several notifier types must fail for %s", typeName)
+		})
+	}
+}
+
+var stringNotificationFields = []string{
+	"api_token", "channel_name", "datadog_api_key", "datadog_region", "email_address", "integration_id", "microsoft_teams_webhook_url",
+	"mobile_number", "notifier_id", "ops_genie_api_key", "ops_genie_region", "service_key", "team_id", "team_name", "username",
+	"victor_ops_api_key", "victor_ops_routing_key", "webhook_secret", "webhook_url",
+}
+
+// notification returns the attributes of a notification block with type_name and the given fields set, and every other field null.
+func notification(typeName string, setFields ...string) map[string]attr.Value {
+	attrs := map[string]attr.Value{
+		"type_name":     types.StringValue(typeName),
+		"interval_min":  types.Int64Null(),
+		"delay_min":     types.Int64Null(),
+		"email_enabled": types.BoolNull(),
+		"sms_enabled":   types.BoolNull(),
+		"roles":         types.ListNull(types.StringType),
+	}
+	for _, field := range stringNotificationFields {
+		attrs[field] = types.StringNull()
+	}
+	for _, field := range setFields {
+		switch field {
+		case "interval_min", "delay_min":
+			attrs[field] = types.Int64Value(5)
+		case "email_enabled", "sms_enabled":
+			attrs[field] = types.BoolValue(true)
+		case "roles":
+			attrs[field] = types.ListValueMust(types.StringType, []attr.Value{types.StringValue("GROUP_OWNER")})
+		default:
+			attrs[field] = types.StringValue("value")
+		}
+	}
+	return attrs
+}
+
+func withValues(attrs, values map[string]attr.Value) map[string]attr.Value {
+	for k, v := range values {
+		attrs[k] = v
+	}
+	return attrs
+}
+
+func assertDiags(t *testing.T, diags diag.Diagnostics, errorContains string, warning bool) {
+	t.Helper()
+	if errorContains == "" {
+		assert.False(t, diags.HasError(), "unexpected errors: %v", diags.Errors())
+	} else {
+		require.True(t, diags.HasError())
+		assert.Contains(t, diags.Errors()[0].Detail(), errorContains)
+	}
+	assert.Equal(t, warning, diags.WarningsCount() > 0)
+}
@@ -2,6 +2,7 @@ package alertconfiguration
 
 import (
 	"context"
+	"fmt"
 	"reflect"
 	"strings"
 
@@ -19,6 +20,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/conversion"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/validate"
@@ -39,6 +41,7 @@ const (
 
 var _ resource.ResourceWithConfigure = &alertConfigurationRS{}
 var _ resource.ResourceWithImportState = &alertConfigurationRS{}
+var _ resource.ResourceWithValidateConfig = &alertConfigurationRS{}
 
 func Resource() resource.Resource {
 	return &alertConfigurationRS{
@@ -367,6 +370,42 @@ func (r *alertConfigurationRS) Schema(ctx context.Context, req resource.SchemaRe
 	}
 }
 
+func (r *alertConfigurationRS) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var eventType types.String
+	var metricThresholdConfig, notifications types.List
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("event_type"), &eventType)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("metric_threshold_config"), &metricThresholdConfig)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("notification"), &notifications)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(ValidateEventType(path.Root("event_type"), eventType)...)
+	// lists built by dynamic blocks or module inputs can be unknown until apply
+	if !metricThresholdConfig.IsUnknown() {
+		if !eventType.IsUnknown() && IsMetricEventType(eventType.ValueString()) && len(metricThresholdConfig.Elements()) == 0 {
+			resp.Diagnostics.AddAttributeError(path.Root("metric_threshold_config"), "Missing metric_threshold_config",
+				fmt.Sprintf("metric_threshold_config must be configured when event_type is %s.", eventType.ValueString()))
+		}
+		for i, elem := range metricThresholdConfig.Elements() {
+			obj, ok := elem.(types.Object)
+			if !ok || obj.IsNull() || obj.IsUnknown() {
+				continue
+			}
+			var metric TfMetricThresholdConfigModel
+			if diags := obj.As(ctx, &metric, basetypes.ObjectAsOptions{}); diags.HasError() {
+				continue
+			}
+			resp.Diagnostics.Append(ValidateMetricThreshold(path.Root("metric_threshold_config").AtListIndex(i), &metric)...)
+		}
+	}
+	for i, elem := range notifications.Elements() {
+		if obj, ok := elem.(types.Object); ok && !obj.IsNull() && !obj.IsUnknown() {
+			resp.Diagnostics.Append(ValidateNotification(path.Root("notification").AtListIndex(i), obj.Attributes())...)
+		}
+	}
+}
+
 func (r *alertConfigurationRS) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	connV2 := r.Client.AtlasV2
 
@@ -0,0 +1,51 @@
+package cidrcheck
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/conversion"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/config"
+)
+
+const dataSourceName = "cidr_check"
+
+var _ datasource.DataSource = &cidrCheckDS{}
+var _ datasource.DataSourceWithConfigure = &cidrCheckDS{}
+
+func DataSource() datasource.DataSource {
+	return &cidrCheckDS{
+		DSCommon: config.DSCommon{
+			DataSourceName: dataSourceName,
+		},
+	}
+}
+
+type cidrCheckDS struct {
+	config.DSCommon
+}
+
+func (d *cidrCheckDS) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = DataSourceSchema(ctx)
+	conversion.UpdateSchemaDescription(&resp.Schema)
+}
+
+// Read doesn't call the Atlas API, so the data source can be used before the project exists.
+func (d *cidrCheckDS) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var tfModel TFCIDRCheckModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &tfModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var cidrBlocks []string
+	resp.Diagnostics.Append(tfModel.CIDRBlocks.ElementsAs(ctx, &cidrBlocks, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := CheckCIDRBlocks(&tfModel, tfModel.AtlasCIDRBlock.ValueString(), cidrBlocks); err != nil {
+		resp.Diagnostics.AddError("error checking CIDR blocks", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &tfModel)...)
+}
@@ -0,0 +1,96 @@
+package cidrcheck
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/validate"
+)
+
+func DataSourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Checks CIDR blocks for overlaps and Atlas-reserved ranges without calling the Atlas API.",
+		Attributes: map[string]schema.Attribute{
+			"atlas_cidr_block": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "CIDR block to use as the `atlas_cidr_block` of a `mongodbatlas_network_container`. It must be in one of the RFC 1918 private networks and must not overlap any of `cidr_blocks`.",
+				Validators: []validator.String{
+					validate.ValidCIDR(),
+				},
+			},
+			"cidr_blocks": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Required:            true,
+				MarkdownDescription: "CIDR blocks to check, for example the Atlas CIDR blocks of other containers or the `route_table_cidr_block` of network peerings.",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.ValueStringsAre(validate.ValidCIDR()),
+				},
+			},
+			"overlaps": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Pairs of CIDR blocks that share at least one address. `atlas_cidr_block` is checked first if set, followed by `cidr_blocks` in order.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"cidr_block": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "First CIDR block of the pair.",
+						},
+						"other_cidr_block": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Second CIDR block of the pair.",
+						},
+					},
+				},
+			},
+			"reserved_overlaps": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "CIDR blocks that overlap a reserved range that can't be used for network containers or peering routes.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"cidr_block": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "CIDR block that overlaps the reserved range.",
+						},
+						"reserved_cidr_block": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Reserved range.",
+						},
+					},
+				},
+			},
+			"errors": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Computed:            true,
+				MarkdownDescription: "Human-readable description of every problem found.",
+			},
+			"valid": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Flag that indicates whether no problems were found.",
+			},
+		},
+	}
+}
+
+type TFCIDRCheckModel struct {
+	AtlasCIDRBlock   types.String             `tfsdk:"atlas_cidr_block"`
+	CIDRBlocks       types.List               `tfsdk:"cidr_blocks"`
+	Overlaps         []TFOverlapModel         `tfsdk:"overlaps"`
+	ReservedOverlaps []TFReservedOverlapModel `tfsdk:"reserved_overlaps"`
+	Errors           []string                 `tfsdk:"errors"`
+	Valid            types.Bool               `tfsdk:"valid"`
+}
+
+type TFOverlapModel struct {
+	CIDRBlock      types.String `tfsdk:"cidr_block"`
+	OtherCIDRBlock types.String `tfsdk:"other_cidr_block"`
+}
+
+type TFReservedOverlapModel struct {
+	CIDRBlock         types.String `tfsdk:"cidr_block"`
+	ReservedCIDRBlock types.String `tfsdk:"reserved_cidr_block"`
+}
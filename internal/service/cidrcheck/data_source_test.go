@@ -0,0 +1,56 @@
+package cidrcheck_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/testutil/acc"
+)
+
+const dataSourceName = "data.mongodbatlas_cidr_check.test"
+
+func TestAccCIDRCheckDS_basic(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acc.PreCheckBasic(t) },
+		ProtoV6ProviderFactories: acc.TestAccProviderV6Factories,
+		Steps: []resource.TestStep{
+			{
+				Config: configBasic("10.8.0.0/21", `"10.8.8.0/21", "172.31.0.0/16"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "valid", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "overlaps.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "reserved_overlaps.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "errors.#", "0"),
+				),
+			},
+			{
+				Config: configBasic("192.168.208.0/21", `"192.168.0.0/16", "127.0.0.0/8"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "valid", "false"),
+					resource.TestCheckResourceAttr(dataSourceName, "overlaps.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "overlaps.0.cidr_block", "192.168.208.0/21"),
+					resource.TestCheckResourceAttr(dataSourceName, "overlaps.0.other_cidr_block", "192.168.0.0/16"),
+					resource.TestCheckResourceAttr(dataSourceName, "reserved_overlaps.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "reserved_overlaps.0.reserved_cidr_block", "127.0.0.0/8"),
+					resource.TestCheckResourceAttr(dataSourceName, "errors.#", "2"),
+				),
+			},
+			{
+				Config:      configBasic("10.8.1.0/21", `"10.8.8.0/21"`),
+				ExpectError: regexp.MustCompile("string value must be defined as a valid cidr"),
+			},
+		},
+	})
+}
+
+func configBasic(atlasCIDRBlock, cidrBlocks string) string {
+	return fmt.Sprintf(`
+		data "mongodbatlas_cidr_check" "test" {
+			atlas_cidr_block = %[1]q
+			cidr_blocks      = [%[2]s]
+		}
+	`, atlasCIDRBlock, cidrBlocks)
+}
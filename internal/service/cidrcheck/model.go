@@ -0,0 +1,57 @@
+package cidrcheck
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/validate"
+)
+
+// CheckCIDRBlocks fills the computed attributes of tfModel. atlasCIDRBlock is optional and cidrBlocks must be valid CIDR blocks,
+// which is enforced by the schema validators.
+func CheckCIDRBlocks(tfModel *TFCIDRCheckModel, atlasCIDRBlock string, cidrBlocks []string) error {
+	allBlocks := cidrBlocks
+	if atlasCIDRBlock != "" {
+		allBlocks = append([]string{atlasCIDRBlock}, cidrBlocks...)
+	}
+	overlaps, err := validate.FindCIDROverlaps(allBlocks)
+	if err != nil {
+		return err
+	}
+	tfModel.Overlaps = make([]TFOverlapModel, 0, len(overlaps))
+	tfModel.ReservedOverlaps = []TFReservedOverlapModel{}
+	tfModel.Errors = []string{}
+	if atlasCIDRBlock != "" {
+		private, err := validate.IsPrivateCIDR(atlasCIDRBlock)
+		if err != nil {
+			return err
+		}
+		if !private {
+			tfModel.Errors = append(tfModel.Errors, fmt.Sprintf("atlas_cidr_block %s must be in one of the RFC 1918 private networks: %v", atlasCIDRBlock, validate.PrivateCIDRs))
+		}
+	}
+	for _, overlap := range overlaps {
+		tfModel.Overlaps = append(tfModel.Overlaps, TFOverlapModel{
+			CIDRBlock:      types.StringValue(overlap.CIDR),
+			OtherCIDRBlock: types.StringValue(overlap.OtherCIDR),
+		})
+		tfModel.Errors = append(tfModel.Errors, fmt.Sprintf("%s overlaps %s", overlap.CIDR, overlap.OtherCIDR))
+	}
+	for _, cidr := range allBlocks {
+		reserved, err := validate.ReservedCIDROverlap(cidr)
+		if err != nil {
+			return err
+		}
+		if reserved == "" {
+			continue
+		}
+		tfModel.ReservedOverlaps = append(tfModel.ReservedOverlaps, TFReservedOverlapModel{
+			CIDRBlock:         types.StringValue(cidr),
+			ReservedCIDRBlock: types.StringValue(reserved),
+		})
+		tfModel.Errors = append(tfModel.Errors, fmt.Sprintf("%s overlaps the reserved range %s", cidr, reserved))
+	}
+	tfModel.Valid = types.BoolValue(len(tfModel.Errors) == 0)
+	return nil
+}
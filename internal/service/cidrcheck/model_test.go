@@ -0,0 +1,77 @@
+package cidrcheck_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/cidrcheck"
+)
+
+func TestCheckCIDRBlocks(t *testing.T) {
+	testCases := map[string]struct {
+		atlasCIDRBlock string
+		cidrBlocks     []string
+		expected       cidrcheck.TFCIDRCheckModel
+	}{
+		"valid": {
+			atlasCIDRBlock: "10.8.0.0/21",
+			cidrBlocks:     []string{"10.8.8.0/21", "172.31.0.0/16"},
+			expected: cidrcheck.TFCIDRCheckModel{
+				Overlaps:         []cidrcheck.TFOverlapModel{},
+				ReservedOverlaps: []cidrcheck.TFReservedOverlapModel{},
+				Errors:           []string{},
+				Valid:            types.BoolValue(true),
+			},
+		},
+		"atlas block overlapping a peering route": {
+			atlasCIDRBlock: "192.168.208.0/21",
+			cidrBlocks:     []string{"192.168.0.0/16"},
+			expected: cidrcheck.TFCIDRCheckModel{
+				Overlaps: []cidrcheck.TFOverlapModel{
+					{CIDRBlock: types.StringValue("192.168.208.0/21"), OtherCIDRBlock: types.StringValue("192.168.0.0/16")},
+				},
+				ReservedOverlaps: []cidrcheck.TFReservedOverlapModel{},
+				Errors:           []string{"192.168.208.0/21 overlaps 192.168.0.0/16"},
+				Valid:            types.BoolValue(false),
+			},
+		},
+		"public atlas block": {
+			atlasCIDRBlock: "8.8.0.0/21",
+			cidrBlocks:     []string{"10.8.0.0/21"},
+			expected: cidrcheck.TFCIDRCheckModel{
+				Overlaps:         []cidrcheck.TFOverlapModel{},
+				ReservedOverlaps: []cidrcheck.TFReservedOverlapModel{},
+				Errors:           []string{"atlas_cidr_block 8.8.0.0/21 must be in one of the RFC 1918 private networks: [10.0.0.0/8 172.16.0.0/12 192.168.0.0/16]"},
+				Valid:            types.BoolValue(false),
+			},
+		},
+		"reserved and overlapping blocks without atlas block": {
+			cidrBlocks: []string{"10.8.0.0/21", "169.254.0.0/24", "10.8.4.0/24"},
+			expected: cidrcheck.TFCIDRCheckModel{
+				Overlaps: []cidrcheck.TFOverlapModel{
+					{CIDRBlock: types.StringValue("10.8.0.0/21"), OtherCIDRBlock: types.StringValue("10.8.4.0/24")},
+				},
+				ReservedOverlaps: []cidrcheck.TFReservedOverlapModel{
+					{CIDRBlock: types.StringValue("169.254.0.0/24"), ReservedCIDRBlock: types.StringValue("169.254.0.0/16")},
+				},
+				Errors: []string{"10.8.0.0/21 overlaps 10.8.4.0/24", "169.254.0.0/24 overlaps the reserved range 169.254.0.0/16"},
+				Valid:  types.BoolValue(false),
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var tfModel cidrcheck.TFCIDRCheckModel
+			require.NoError(t, cidrcheck.CheckCIDRBlocks(&tfModel, tc.atlasCIDRBlock, tc.cidrBlocks))
+			assert.Equal(t, tc.expected, tfModel)
+		})
+	}
+}
+
+func TestCheckCIDRBlocksInvalid(t *testing.T) {
+	var tfModel cidrcheck.TFCIDRCheckModel
+	require.Error(t, cidrcheck.CheckCIDRBlocks(&tfModel, "", []string{"10.8.1.0/21"}))
+}
@@ -0,0 +1,192 @@
+package cloudprovideraccess
+
+import (
+	"context"
+	"fmt"
+
+	"go.mongodb.org/atlas-sdk/v20250312003/admin"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/constant"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/conversion"
+)
+
+// GetRoleID returns the identifier used in the API paths, Azure roles are identified by _id and AWS and GCP roles by roleId.
+func GetRoleID(role *admin.CloudProviderAccessRole) string {
+	if role.ProviderName == constant.AZURE || role.GetRoleId() == "" {
+		return role.GetId()
+	}
+	return role.GetRoleId()
+}
+
+// GetAuthorizationStatus returns AUTHORIZED for AWS and Azure roles with an authorized date and for GCP roles once the service account
+// has been provisioned, as GCP roles don't need an authorization step.
+func GetAuthorizationStatus(role *admin.CloudProviderAccessRole) string {
+	if role.ProviderName == constant.GCP {
+		if role.GetGcpServiceAccountForAtlas() != "" {
+			return AuthorizationStatusAuthorized
+		}
+		return AuthorizationStatusPending
+	}
+	if role.AuthorizedDate != nil {
+		return AuthorizationStatusAuthorized
+	}
+	return AuthorizationStatusPending
+}
+
+// ValidateProviderSettings checks that only the settings of the role provider are defined.
+func ValidateProviderSettings(providerName string, hasAWS, hasAzure, deferAuthorization bool) []error {
+	var errs []error
+	if hasAWS && providerName != constant.AWS {
+		errs = append(errs, fmt.Errorf("aws can only be set when provider_name is %s", constant.AWS))
+	}
+	if hasAzure && providerName != constant.AZURE {
+		errs = append(errs, fmt.Errorf("azure can only be set when provider_name is %s", constant.AZURE))
+	}
+	if !hasAzure && providerName == constant.AZURE {
+		errs = append(errs, fmt.Errorf("azure must be set when provider_name is %s", constant.AZURE))
+	}
+	if deferAuthorization && providerName != constant.AWS {
+		errs = append(errs, fmt.Errorf("defer_authorization can only be true when provider_name is %s", constant.AWS))
+	}
+	return errs
+}
+
+func NewCreateRoleReq(ctx context.Context, plan *TFRoleModel) (*admin.CloudProviderAccessRoleRequest, diag.Diagnostics) {
+	req := &admin.CloudProviderAccessRoleRequest{
+		ProviderName: plan.ProviderName.ValueString(),
+	}
+	azure, diags := newTFAzureModel(ctx, plan.Azure)
+	if diags.HasError() || azure == nil {
+		return req, diags
+	}
+	req.AtlasAzureAppId = azure.AtlasAzureAppID.ValueStringPointer()
+	req.ServicePrincipalId = azure.ServicePrincipalID.ValueStringPointer()
+	req.TenantId = azure.TenantID.ValueStringPointer()
+	return req, diags
+}
+
+// NewAuthorizeRoleReq returns nil if the role can't be authorized yet, e.g. because the IAM role ARN is not defined. GCP roles are never
+// authorized.
+func NewAuthorizeRoleReq(role *admin.CloudProviderAccessRole, iamAssumedRoleARN string) *admin.CloudProviderAccessRoleRequestUpdate {
+	req := &admin.CloudProviderAccessRoleRequestUpdate{
+		ProviderName: role.ProviderName,
+	}
+	switch role.ProviderName {
+	case constant.AWS:
+		if iamAssumedRoleARN == "" {
+			return nil
+		}
+		req.IamAssumedRoleArn = &iamAssumedRoleARN
+	case constant.AZURE:
+		req.AtlasAzureAppId = role.AtlasAzureAppId
+		req.ServicePrincipalId = role.ServicePrincipalId
+		req.TenantId = role.TenantId
+	default:
+		return nil
+	}
+	return req
+}
+
+// NewTFRoleModel uses prev to keep the values that are not returned by Atlas. While an AWS role is pending authorization, the IAM role
+// ARN from prev is kept so the plan doesn't show changes and authorization_status reflects that it's still pending.
+func NewTFRoleModel(ctx context.Context, role *admin.CloudProviderAccessRole, prev *TFRoleModel) (*TFRoleModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	model := &TFRoleModel{
+		ProjectID:           prev.ProjectID,
+		ProviderName:        types.StringValue(role.ProviderName),
+		RoleID:              types.StringValue(GetRoleID(role)),
+		DeferAuthorization:  prev.DeferAuthorization,
+		AuthorizationStatus: types.StringValue(GetAuthorizationStatus(role)),
+		AuthorizedDate:      types.StringPointerValue(conversion.TimePtrToStringPtr(role.AuthorizedDate)),
+		CreatedDate:         types.StringPointerValue(conversion.TimePtrToStringPtr(role.CreatedDate)),
+		LastUpdatedDate:     types.StringPointerValue(conversion.TimePtrToStringPtr(role.LastUpdatedDate)),
+		AWS:                 types.ObjectNull(AWSObjType.AttrTypes),
+		Azure:               types.ObjectNull(AzureObjType.AttrTypes),
+		GCP:                 types.ObjectNull(GCPObjType.AttrTypes),
+		Timeouts:            prev.Timeouts,
+	}
+	if model.DeferAuthorization.IsNull() || model.DeferAuthorization.IsUnknown() {
+		model.DeferAuthorization = types.BoolValue(false)
+	}
+
+	var localDiags diag.Diagnostics
+	switch role.ProviderName {
+	case constant.AWS:
+		iamAssumedRoleARN := types.StringPointerValue(role.IamAssumedRoleArn)
+		if role.GetIamAssumedRoleArn() == "" {
+			prevAWS, prevDiags := newTFAWSModel(ctx, prev.AWS)
+			diags.Append(prevDiags...)
+			if prevAWS != nil && !prevAWS.IAMAssumedRoleARN.IsUnknown() {
+				iamAssumedRoleARN = prevAWS.IAMAssumedRoleARN
+			} else {
+				iamAssumedRoleARN = types.StringNull()
+			}
+		}
+		model.AWS, localDiags = types.ObjectValueFrom(ctx, AWSObjType.AttrTypes, TFAWSModel{
+			IAMAssumedRoleARN:          iamAssumedRoleARN,
+			AtlasAWSAccountARN:         types.StringPointerValue(role.AtlasAWSAccountArn),
+			AtlasAssumedRoleExternalID: types.StringPointerValue(role.AtlasAssumedRoleExternalId),
+		})
+		diags.Append(localDiags...)
+	case constant.AZURE:
+		model.Azure, localDiags = types.ObjectValueFrom(ctx, AzureObjType.AttrTypes, TFAzureModel{
+			AtlasAzureAppID:    types.StringPointerValue(role.AtlasAzureAppId),
+			ServicePrincipalID: types.StringPointerValue(role.ServicePrincipalId),
+			TenantID:           types.StringPointerValue(role.TenantId),
+		})
+		diags.Append(localDiags...)
+	case constant.GCP:
+		model.GCP, localDiags = types.ObjectValueFrom(ctx, GCPObjType.AttrTypes, TFGCPModel{
+			ServiceAccountForAtlas: types.StringPointerValue(role.GcpServiceAccountForAtlas),
+		})
+		diags.Append(localDiags...)
+	}
+
+	featureUsages := make([]TFFeatureUsageModel, 0, len(role.GetFeatureUsages()))
+	for _, featureUsage := range role.GetFeatureUsages() {
+		featureID := featureUsage.GetFeatureId()
+		featureIDObj, localDiags := types.ObjectValueFrom(ctx, FeatureIDObjType.AttrTypes, TFFeatureIDModel{
+			ProjectID:  types.StringPointerValue(featureID.GroupId),
+			BucketName: types.StringPointerValue(featureID.BucketName),
+		})
+		diags.Append(localDiags...)
+		featureUsages = append(featureUsages, TFFeatureUsageModel{
+			FeatureType: types.StringPointerValue(featureUsage.FeatureType),
+			FeatureID:   featureIDObj,
+		})
+	}
+	model.FeatureUsages, localDiags = types.ListValueFrom(ctx, FeatureUsageObjType, featureUsages)
+	diags.Append(localDiags...)
+	return model, diags
+}
+
+// GetIAMAssumedRoleARN returns an empty string if the ARN is not defined or not known yet.
+func GetIAMAssumedRoleARN(ctx context.Context, awsObj types.Object) (string, diag.Diagnostics) {
+	aws, diags := newTFAWSModel(ctx, awsObj)
+	if aws == nil {
+		return "", diags
+	}
+	return aws.IAMAssumedRoleARN.ValueString(), diags
+}
+
+func newTFAWSModel(ctx context.Context, awsObj types.Object) (*TFAWSModel, diag.Diagnostics) {
+	if awsObj.IsNull() || awsObj.IsUnknown() {
+		return nil, nil
+	}
+	var aws TFAWSModel
+	diags := awsObj.As(ctx, &aws, basetypes.ObjectAsOptions{UnhandledUnknownAsEmpty: true})
+	return &aws, diags
+}
+
+func newTFAzureModel(ctx context.Context, azureObj types.Object) (*TFAzureModel, diag.Diagnostics) {
+	if azureObj.IsNull() || azureObj.IsUnknown() {
+		return nil, nil
+	}
+	var azure TFAzureModel
+	diags := azureObj.As(ctx, &azure, basetypes.ObjectAsOptions{})
+	return &azure, diags
+}
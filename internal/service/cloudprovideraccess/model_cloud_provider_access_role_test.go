@@ -0,0 +1,262 @@
+package cloudprovideraccess_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/atlas-sdk/v20250312003/admin"
+	"go.mongodb.org/atlas-sdk/v20250312003/mockadmin"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/conversion"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/cloudprovideraccess"
+)
+
+const (
+	roleProjectID  = "111111111111111111111111"
+	roleID         = "222222222222222222222222"
+	roleInternalID = "333333333333333333333333"
+	iamRoleARN     = "arn:aws:iam::123456789012:role/atlas-role"
+	externalID     = "external-id"
+	serviceAccount = "atlas-123@p-abc.iam.gserviceaccount.com"
+)
+
+var authorizedDate = time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+
+func TestGetRoleID(t *testing.T) {
+	testCases := map[string]struct {
+		role     admin.CloudProviderAccessRole
+		expected string
+	}{
+		"AWS uses roleId": {
+			role:     admin.CloudProviderAccessRole{ProviderName: "AWS", RoleId: conversion.StringPtr(roleID), Id: conversion.StringPtr(roleInternalID)},
+			expected: roleID,
+		},
+		"GCP uses roleId": {
+			role:     admin.CloudProviderAccessRole{ProviderName: "GCP", RoleId: conversion.StringPtr(roleID)},
+			expected: roleID,
+		},
+		"Azure uses _id": {
+			role:     admin.CloudProviderAccessRole{ProviderName: "AZURE", RoleId: conversion.StringPtr(roleID), Id: conversion.StringPtr(roleInternalID)},
+			expected: roleInternalID,
+		},
+		"falls back to _id": {
+			role:     admin.CloudProviderAccessRole{ProviderName: "AWS", Id: conversion.StringPtr(roleInternalID)},
+			expected: roleInternalID,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, cloudprovideraccess.GetRoleID(&tc.role))
+		})
+	}
+}
+
+func TestGetAuthorizationStatus(t *testing.T) {
+	testCases := map[string]struct {
+		role     admin.CloudProviderAccessRole
+		expected string
+	}{
+		"AWS pending":      {role: admin.CloudProviderAccessRole{ProviderName: "AWS"}, expected: cloudprovideraccess.AuthorizationStatusPending},
+		"AWS authorized":   {role: admin.CloudProviderAccessRole{ProviderName: "AWS", AuthorizedDate: &authorizedDate}, expected: cloudprovideraccess.AuthorizationStatusAuthorized},
+		"Azure authorized": {role: admin.CloudProviderAccessRole{ProviderName: "AZURE", AuthorizedDate: &authorizedDate}, expected: cloudprovideraccess.AuthorizationStatusAuthorized},
+		"GCP provisioning": {role: admin.CloudProviderAccessRole{ProviderName: "GCP"}, expected: cloudprovideraccess.AuthorizationStatusPending},
+		"GCP provisioned":  {role: admin.CloudProviderAccessRole{ProviderName: "GCP", GcpServiceAccountForAtlas: conversion.StringPtr(serviceAccount)}, expected: cloudprovideraccess.AuthorizationStatusAuthorized},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, cloudprovideraccess.GetAuthorizationStatus(&tc.role))
+		})
+	}
+}
+
+func TestValidateProviderSettings(t *testing.T) {
+	testCases := map[string]struct {
+		providerName string
+		hasAWS       bool
+		hasAzure     bool
+		deferAuth    bool
+		expectedErrs int
+	}{
+		"AWS with aws and defer":  {providerName: "AWS", hasAWS: true, deferAuth: true},
+		"AWS without aws":         {providerName: "AWS"},
+		"AWS with azure":          {providerName: "AWS", hasAzure: true, expectedErrs: 1},
+		"Azure with azure":        {providerName: "AZURE", hasAzure: true},
+		"Azure without azure":     {providerName: "AZURE", expectedErrs: 1},
+		"Azure with aws":          {providerName: "AZURE", hasAWS: true, hasAzure: true, expectedErrs: 1},
+		"GCP":                     {providerName: "GCP"},
+		"GCP with defer":          {providerName: "GCP", deferAuth: true, expectedErrs: 1},
+		"GCP with aws and azure":  {providerName: "GCP", hasAWS: true, hasAzure: true, expectedErrs: 2},
+		"Azure defer without cfg": {providerName: "AZURE", deferAuth: true, expectedErrs: 2},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			errs := cloudprovideraccess.ValidateProviderSettings(tc.providerName, tc.hasAWS, tc.hasAzure, tc.deferAuth)
+			assert.Len(t, errs, tc.expectedErrs)
+		})
+	}
+}
+
+func TestNewTFRoleModel(t *testing.T) {
+	ctx := t.Context()
+	prevAWS, diags := types.ObjectValueFrom(ctx, cloudprovideraccess.AWSObjType.AttrTypes, cloudprovideraccess.TFAWSModel{
+		IAMAssumedRoleARN:          types.StringValue(iamRoleARN),
+		AtlasAWSAccountARN:         types.StringUnknown(),
+		AtlasAssumedRoleExternalID: types.StringUnknown(),
+	})
+	require.False(t, diags.HasError())
+	prev := &cloudprovideraccess.TFRoleModel{
+		ProjectID:          types.StringValue(roleProjectID),
+		DeferAuthorization: types.BoolValue(true),
+		AWS:                prevAWS,
+	}
+
+	testCases := map[string]struct {
+		role           admin.CloudProviderAccessRole
+		expectedARN    types.String
+		expectedStatus string
+	}{
+		"pending role keeps the configured ARN": {
+			role: admin.CloudProviderAccessRole{
+				ProviderName:               "AWS",
+				RoleId:                     conversion.StringPtr(roleID),
+				AtlasAssumedRoleExternalId: conversion.StringPtr(externalID),
+			},
+			expectedARN:    types.StringValue(iamRoleARN),
+			expectedStatus: cloudprovideraccess.AuthorizationStatusPending,
+		},
+		"authorized role uses the Atlas ARN": {
+			role: admin.CloudProviderAccessRole{
+				ProviderName:               "AWS",
+				RoleId:                     conversion.StringPtr(roleID),
+				AtlasAssumedRoleExternalId: conversion.StringPtr(externalID),
+				IamAssumedRoleArn:          conversion.StringPtr("arn:aws:iam::123456789012:role/other"),
+				AuthorizedDate:             &authorizedDate,
+			},
+			expectedARN:    types.StringValue("arn:aws:iam::123456789012:role/other"),
+			expectedStatus: cloudprovideraccess.AuthorizationStatusAuthorized,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			model, diags := cloudprovideraccess.NewTFRoleModel(ctx, &tc.role, prev)
+			require.False(t, diags.HasError())
+			assert.Equal(t, roleProjectID, model.ProjectID.ValueString())
+			assert.Equal(t, roleID, model.RoleID.ValueString())
+			assert.True(t, model.DeferAuthorization.ValueBool())
+			assert.Equal(t, tc.expectedStatus, model.AuthorizationStatus.ValueString())
+			assert.True(t, model.Azure.IsNull())
+			assert.True(t, model.GCP.IsNull())
+			var aws cloudprovideraccess.TFAWSModel
+			require.False(t, model.AWS.As(ctx, &aws, basetypes.ObjectAsOptions{}).HasError())
+			assert.Equal(t, tc.expectedARN, aws.IAMAssumedRoleARN)
+			assert.Equal(t, externalID, aws.AtlasAssumedRoleExternalID.ValueString())
+		})
+	}
+}
+
+func TestNewTFRoleModelGCP(t *testing.T) {
+	ctx := t.Context()
+	role := &admin.CloudProviderAccessRole{
+		ProviderName:              "GCP",
+		RoleId:                    conversion.StringPtr(roleID),
+		GcpServiceAccountForAtlas: conversion.StringPtr(serviceAccount),
+		FeatureUsages: &[]admin.CloudProviderAccessFeatureUsage{
+			{
+				FeatureType: conversion.StringPtr("ENCRYPTION_AT_REST"),
+				FeatureId:   &admin.CloudProviderAccessFeatureUsagePushBasedLogExportFeatureId{GroupId: conversion.StringPtr(roleProjectID)},
+			},
+		},
+	}
+	model, diags := cloudprovideraccess.NewTFRoleModel(ctx, role, &cloudprovideraccess.TFRoleModel{ProjectID: types.StringValue(roleProjectID)})
+	require.False(t, diags.HasError())
+	assert.False(t, model.DeferAuthorization.ValueBool())
+	assert.True(t, model.AWS.IsNull())
+	assert.Equal(t, cloudprovideraccess.AuthorizationStatusAuthorized, model.AuthorizationStatus.ValueString())
+
+	var gcp cloudprovideraccess.TFGCPModel
+	require.False(t, model.GCP.As(ctx, &gcp, basetypes.ObjectAsOptions{}).HasError())
+	assert.Equal(t, serviceAccount, gcp.ServiceAccountForAtlas.ValueString())
+
+	var featureUsages []cloudprovideraccess.TFFeatureUsageModel
+	require.False(t, model.FeatureUsages.ElementsAs(ctx, &featureUsages, false).HasError())
+	require.Len(t, featureUsages, 1)
+	assert.Equal(t, "ENCRYPTION_AT_REST", featureUsages[0].FeatureType.ValueString())
+	var featureID cloudprovideraccess.TFFeatureIDModel
+	require.False(t, featureUsages[0].FeatureID.As(ctx, &featureID, basetypes.ObjectAsOptions{}).HasError())
+	assert.Equal(t, roleProjectID, featureID.ProjectID.ValueString())
+	assert.True(t, featureID.BucketName.IsNull())
+}
+
+func TestAuthorizeRole(t *testing.T) {
+	cannotAssumeRoleErr := &admin.GenericOpenAPIError{}
+	cannotAssumeRoleErr.SetError("cannot assume role")
+	cannotAssumeRoleErr.SetModel(admin.ApiError{ErrorCode: "CANNOT_ASSUME_ROLE", Error: 400})
+	otherErr := &admin.GenericOpenAPIError{}
+	otherErr.SetError("invalid role")
+	otherErr.SetModel(admin.ApiError{ErrorCode: "INVALID_ROLE", Error: 400})
+
+	awsRole := &admin.CloudProviderAccessRole{ProviderName: "AWS", RoleId: conversion.StringPtr(roleID)}
+	authorizedRole := &admin.CloudProviderAccessRole{ProviderName: "AWS", RoleId: conversion.StringPtr(roleID), AuthorizedDate: &authorizedDate}
+
+	testCases := map[string]struct {
+		executeErr         error
+		executeResult      *admin.CloudProviderAccessRole
+		expectedErr        error
+		iamAssumedRoleARN  string
+		expectedStatus     string
+		deferAuthorization bool
+		expectCall         bool
+		expectAnyErr       bool
+	}{
+		"no ARN skips authorization": {
+			expectedStatus: cloudprovideraccess.AuthorizationStatusPending,
+		},
+		"authorized": {
+			iamAssumedRoleARN: iamRoleARN,
+			executeResult:     authorizedRole,
+			expectCall:        true,
+			expectedStatus:    cloudprovideraccess.AuthorizationStatusAuthorized,
+		},
+		"deferred when role can't be assumed": {
+			iamAssumedRoleARN:  iamRoleARN,
+			deferAuthorization: true,
+			executeErr:         cannotAssumeRoleErr,
+			expectCall:         true,
+			expectedErr:        cloudprovideraccess.ErrAuthorizationDeferred,
+			expectedStatus:     cloudprovideraccess.AuthorizationStatusPending,
+		},
+		"other errors are not deferred": {
+			iamAssumedRoleARN:  iamRoleARN,
+			deferAuthorization: true,
+			executeErr:         otherErr,
+			expectCall:         true,
+			expectAnyErr:       true,
+			expectedStatus:     cloudprovideraccess.AuthorizationStatusPending,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			m := mockadmin.NewCloudProviderAccessApi(t)
+			if tc.expectCall {
+				m.EXPECT().AuthorizeCloudProviderAccessRole(mock.Anything, roleProjectID, roleID, mock.Anything).Return(admin.AuthorizeCloudProviderAccessRoleApiRequest{ApiService: m}).Once()
+				m.EXPECT().AuthorizeCloudProviderAccessRoleExecute(mock.Anything).Return(tc.executeResult, nil, tc.executeErr).Once()
+			}
+			role, err := cloudprovideraccess.AuthorizeRole(t.Context(), m, roleProjectID, awsRole, tc.iamAssumedRoleARN, tc.deferAuthorization, time.Minute)
+			switch {
+			case tc.expectedErr != nil:
+				require.ErrorIs(t, err, tc.expectedErr)
+			case tc.expectAnyErr:
+				require.Error(t, err)
+				require.NotErrorIs(t, err, cloudprovideraccess.ErrAuthorizationDeferred)
+			default:
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tc.expectedStatus, cloudprovideraccess.GetAuthorizationStatus(role))
+		})
+	}
+}
@@ -0,0 +1,304 @@
+package cloudprovideraccess
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/atlas-sdk/v20250312003/admin"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/constant"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/conversion"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/validate"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/config"
+)
+
+const (
+	roleResourceName     = "cloud_provider_access_role"
+	roleFullResourceName = "mongodbatlas_" + roleResourceName
+	errorRoleCreate      = "error creating resource " + roleFullResourceName
+	errorRoleRead        = "error reading resource " + roleFullResourceName
+	errorRoleUpdate      = "error updating resource " + roleFullResourceName
+	errorRoleDelete      = "error deleting resource " + roleFullResourceName
+	errorRoleImport      = "error importing resource " + roleFullResourceName
+	errorRoleAuthorize   = "error authorizing resource " + roleFullResourceName
+	warningRoleDeferred  = "Authorization of " + roleFullResourceName + " deferred"
+
+	errorCodeCannotAssumeRole = "CANNOT_ASSUME_ROLE"
+	defaultRoleTimeout        = 10 * time.Minute
+	roleRetryDelay            = 10 * time.Second
+)
+
+// ErrAuthorizationDeferred is returned when Atlas can't assume the IAM role yet and defer_authorization is enabled.
+var ErrAuthorizationDeferred = errors.New("atlas can't assume the IAM role yet, authorization will be retried in the next apply")
+
+var _ resource.ResourceWithConfigure = &roleRS{}
+var _ resource.ResourceWithImportState = &roleRS{}
+var _ resource.ResourceWithModifyPlan = &roleRS{}
+var _ resource.ResourceWithValidateConfig = &roleRS{}
+
+func RoleResource() resource.Resource {
+	return &roleRS{
+		RSCommon: config.RSCommon{
+			ResourceName: roleResourceName,
+		},
+	}
+}
+
+type roleRS struct {
+	config.RSCommon
+}
+
+func (r *roleRS) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = ResourceRoleSchema(ctx)
+	conversion.UpdateSchemaDescription(&resp.Schema)
+}
+
+func (r *roleRS) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config TFRoleModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() || config.ProviderName.IsUnknown() || config.ProviderName.IsNull() {
+		return
+	}
+	errs := ValidateProviderSettings(config.ProviderName.ValueString(), !config.AWS.IsNull(), !config.Azure.IsNull(), config.DeferAuthorization.ValueBool())
+	for _, err := range errs {
+		resp.Diagnostics.AddAttributeError(path.Root("provider_name"), "Invalid "+roleFullResourceName+" configuration", err.Error())
+	}
+}
+
+// ModifyPlan requires replacement when the IAM role ARN is removed as Atlas doesn't allow to deauthorize a role without deleting it.
+func (r *roleRS) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+	var plan, state TFRoleModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() || state.ProviderName.ValueString() != constant.AWS || plan.AWS.IsUnknown() {
+		return
+	}
+	stateARN, diags := GetIAMAssumedRoleARN(ctx, state.AWS)
+	resp.Diagnostics.Append(diags...)
+	planAWS, diags := newTFAWSModel(ctx, plan.AWS)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if (planAWS == nil || planAWS.IAMAssumedRoleARN.IsNull()) && stateARN != "" {
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root("aws").AtName("iam_assumed_role_arn"))
+	}
+}
+
+func (r *roleRS) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan TFRoleModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	createReq, diags := NewCreateRoleReq(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	timeout, diags := plan.Timeouts.Create(ctx, defaultRoleTimeout)
+	resp.Diagnostics.Append(diags...)
+	iamAssumedRoleARN, diags := GetIAMAssumedRoleARN(ctx, plan.AWS)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	api := r.Client.AtlasV2.CloudProviderAccessApi
+	projectID := plan.ProjectID.ValueString()
+	role, _, err := api.CreateCloudProviderAccessRole(ctx, projectID, createReq).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(errorRoleCreate, err.Error())
+		return
+	}
+	// The role is saved before being authorized so it's tainted instead of lost if the authorization fails.
+	newModel, diags := NewTFRoleModel(ctx, role, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, newModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	switch role.ProviderName {
+	case constant.GCP:
+		role, err = WaitGCPServiceAccount(ctx, api, projectID, GetRoleID(role), timeout)
+	default:
+		role, err = AuthorizeRole(ctx, api, projectID, role, iamAssumedRoleARN, plan.DeferAuthorization.ValueBool(), timeout)
+	}
+	if errors.Is(err, ErrAuthorizationDeferred) {
+		resp.Diagnostics.AddWarning(warningRoleDeferred, err.Error())
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(errorRoleAuthorize, err.Error())
+		return
+	}
+	newModel, diags = NewTFRoleModel(ctx, role, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, newModel)...)
+}
+
+func (r *roleRS) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state TFRoleModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	role, getResp, err := r.Client.AtlasV2.CloudProviderAccessApi.GetCloudProviderAccessRole(ctx, state.ProjectID.ValueString(), state.RoleID.ValueString()).Execute()
+	if err != nil {
+		if validate.StatusNotFound(getResp) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(errorRoleRead, err.Error())
+		return
+	}
+	// The IAM role ARN is only kept once Atlas has it, so a deferred authorization is planned as a change of aws.iam_assumed_role_arn
+	// and retried in the next apply.
+	prev := state
+	prev.AWS = types.ObjectNull(AWSObjType.AttrTypes)
+	newModel, diags := NewTFRoleModel(ctx, role, &prev)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, newModel)...)
+}
+
+func (r *roleRS) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state TFRoleModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	timeout, diags := plan.Timeouts.Update(ctx, defaultRoleTimeout)
+	resp.Diagnostics.Append(diags...)
+	planARN, diags := GetIAMAssumedRoleARN(ctx, plan.AWS)
+	resp.Diagnostics.Append(diags...)
+	stateARN, diags := GetIAMAssumedRoleARN(ctx, state.AWS)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	api := r.Client.AtlasV2.CloudProviderAccessApi
+	projectID := plan.ProjectID.ValueString()
+	role, _, err := api.GetCloudProviderAccessRole(ctx, projectID, plan.RoleID.ValueString()).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(errorRoleUpdate, err.Error())
+		return
+	}
+	if planARN != stateARN || GetAuthorizationStatus(role) != AuthorizationStatusAuthorized {
+		var authorized *admin.CloudProviderAccessRole
+		authorized, err = AuthorizeRole(ctx, api, projectID, role, planARN, plan.DeferAuthorization.ValueBool(), timeout)
+		switch {
+		case errors.Is(err, ErrAuthorizationDeferred):
+			resp.Diagnostics.AddWarning(warningRoleDeferred, err.Error())
+		case err != nil:
+			resp.Diagnostics.AddError(errorRoleAuthorize, err.Error())
+			return
+		default:
+			role = authorized
+		}
+	}
+	newModel, diags := NewTFRoleModel(ctx, role, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, newModel)...)
+}
+
+func (r *roleRS) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state TFRoleModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	httpResp, err := r.Client.AtlasV2.CloudProviderAccessApi.DeauthorizeCloudProviderAccessRole(ctx, state.ProjectID.ValueString(),
+		state.ProviderName.ValueString(), state.RoleID.ValueString()).Execute()
+	if err != nil && !validate.StatusNotFound(httpResp) {
+		resp.Diagnostics.AddError(errorRoleDelete, err.Error())
+	}
+}
+
+func (r *roleRS) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	projectID, providerName, roleID, err := splitCloudProviderAccessID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(errorRoleImport, err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), projectID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("provider_name"), providerName)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("role_id"), roleID)...)
+}
+
+// AuthorizeRole authorizes AWS and Azure roles. AWS roles are only authorized when iamAssumedRoleARN is set and, as IAM changes take
+// time to propagate, CANNOT_ASSUME_ROLE errors are retried until timeout unless deferAuthorization is true, in which case
+// ErrAuthorizationDeferred is returned. The role is returned unchanged when it can't be authorized.
+func AuthorizeRole(ctx context.Context, api admin.CloudProviderAccessApi, projectID string, role *admin.CloudProviderAccessRole, iamAssumedRoleARN string, deferAuthorization bool, timeout time.Duration) (*admin.CloudProviderAccessRole, error) {
+	req := NewAuthorizeRoleReq(role, iamAssumedRoleARN)
+	if req == nil {
+		return role, nil
+	}
+	var authorized *admin.CloudProviderAccessRole
+	err := retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+		var err error
+		authorized, _, err = api.AuthorizeCloudProviderAccessRole(ctx, projectID, GetRoleID(role), req).Execute()
+		switch {
+		case err == nil:
+			return nil
+		case admin.IsErrorCode(err, errorCodeCannotAssumeRole) && deferAuthorization:
+			return retry.NonRetryableError(fmt.Errorf("%w: %s", ErrAuthorizationDeferred, err.Error()))
+		case admin.IsErrorCode(err, errorCodeCannotAssumeRole):
+			time.Sleep(roleRetryDelay)
+			return retry.RetryableError(err)
+		default:
+			return retry.NonRetryableError(err)
+		}
+	})
+	if err != nil {
+		return role, err
+	}
+	return authorized, nil
+}
+
+// WaitGCPServiceAccount waits until Atlas provisions the GCP service account of the role.
+func WaitGCPServiceAccount(ctx context.Context, api admin.CloudProviderAccessApi, projectID, roleID string, timeout time.Duration) (*admin.CloudProviderAccessRole, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{AuthorizationStatusPending},
+		Target:     []string{AuthorizationStatusAuthorized},
+		Refresh:    refreshGCPRole(ctx, api, projectID, roleID),
+		Timeout:    timeout,
+		MinTimeout: roleRetryDelay,
+	}
+	result, err := stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error waiting for the GCP service account of role %s: %w", roleID, err)
+	}
+	return result.(*admin.CloudProviderAccessRole), nil
+}
+
+func refreshGCPRole(ctx context.Context, api admin.CloudProviderAccessApi, projectID, roleID string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		role, _, err := api.GetCloudProviderAccessRole(ctx, projectID, roleID).Execute()
+		if err != nil {
+			return nil, "", err
+		}
+		return role, GetAuthorizationStatus(role), nil
+	}
+}
@@ -0,0 +1,238 @@
+package cloudprovideraccess
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/constant"
+)
+
+const (
+	AuthorizationStatusPending    = "PENDING"
+	AuthorizationStatusAuthorized = "AUTHORIZED"
+)
+
+func ResourceRoleSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Unique 24-hexadecimal digit string that identifies your project.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"provider_name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Human-readable label that identifies the cloud provider of the role. Valid values are `AWS`, `AZURE` and `GCP`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(constant.AWS, constant.AZURE, constant.GCP),
+				},
+			},
+			"role_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Unique 24-hexadecimal digit string that identifies the role.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"defer_authorization": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Flag that indicates whether the role is kept unauthorized, instead of failing, when Atlas can't assume `aws.iam_assumed_role_arn` yet. The authorization is retried in the next apply until `authorization_status` is `AUTHORIZED`. This allows to build the IAM role ARN without referencing the IAM role resource, which depends on `aws.atlas_assumed_role_external_id`. Only for `AWS`. Defaults to `false`.",
+			},
+			"authorization_status": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Authorization status of the role. `PENDING` if the role is not authorized yet, for example because `aws.iam_assumed_role_arn` is not set, or the GCP service account is still being provisioned. `AUTHORIZED` otherwise.",
+			},
+			"authorized_date": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Date and time when the role was authorized. This parameter expresses its value in the ISO 8601 timestamp format in UTC.",
+			},
+			"created_date": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Date and time when the role was created. This parameter expresses its value in the ISO 8601 timestamp format in UTC.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_updated_date": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Date and time when the Azure Service Principal was last updated. This parameter expresses its value in the ISO 8601 timestamp format in UTC. Only for `AZURE`.",
+			},
+			"aws": schema.SingleNestedAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "AWS settings of the role. Only for `AWS`.",
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"iam_assumed_role_arn": schema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: "ARN of the IAM role that Atlas assumes to access your AWS account. Omit it to only create the role in Atlas, the role is authorized once it's set.",
+					},
+					"atlas_aws_account_arn": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "ARN of the Atlas AWS account used to assume IAM roles in your AWS account.",
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"atlas_assumed_role_external_id": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "Unique external ID that Atlas uses when assuming the IAM role in your AWS account. Use it in the trust policy of the IAM role.",
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+				},
+			},
+			"azure": schema.SingleNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "Azure settings of the role. Required for `AZURE`.",
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplace(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"atlas_azure_app_id": schema.StringAttribute{
+						Required:            true,
+						MarkdownDescription: "Azure Active Directory Application ID of Atlas.",
+					},
+					"service_principal_id": schema.StringAttribute{
+						Required:            true,
+						MarkdownDescription: "UUID string that identifies the Azure Service Principal.",
+					},
+					"tenant_id": schema.StringAttribute{
+						Required:            true,
+						MarkdownDescription: "UUID string that identifies the Azure Active Directory Tenant ID.",
+					},
+				},
+			},
+			"gcp": schema.SingleNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "GCP settings of the role. Only for `GCP`.",
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"service_account_for_atlas": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "Email address of the GCP service account that Atlas uses to access your GCP project. Grant it the IAM roles needed by the Atlas features that use this role.",
+					},
+				},
+			},
+			"feature_usages": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Atlas features that use this role.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"feature_type": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Human-readable label that describes the Atlas feature, for example `ENCRYPTION_AT_REST` or `PUSH_BASED_LOG_EXPORT`.",
+						},
+						"feature_id": schema.SingleNestedAttribute{
+							Computed:            true,
+							MarkdownDescription: "Identifier of the Atlas feature that uses this role.",
+							Attributes: map[string]schema.Attribute{
+								"project_id": schema.StringAttribute{
+									Computed:            true,
+									MarkdownDescription: "Unique 24-hexadecimal digit string that identifies the project of the feature.",
+								},
+								"bucket_name": schema.StringAttribute{
+									Computed:            true,
+									MarkdownDescription: "Name of the bucket used by the feature, for example by push-based log export.",
+								},
+							},
+						},
+					},
+				},
+			},
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+type TFRoleModel struct {
+	ProjectID           types.String   `tfsdk:"project_id"`
+	ProviderName        types.String   `tfsdk:"provider_name"`
+	RoleID              types.String   `tfsdk:"role_id"`
+	DeferAuthorization  types.Bool     `tfsdk:"defer_authorization"`
+	AuthorizationStatus types.String   `tfsdk:"authorization_status"`
+	AuthorizedDate      types.String   `tfsdk:"authorized_date"`
+	CreatedDate         types.String   `tfsdk:"created_date"`
+	LastUpdatedDate     types.String   `tfsdk:"last_updated_date"`
+	AWS                 types.Object   `tfsdk:"aws"`
+	Azure               types.Object   `tfsdk:"azure"`
+	GCP                 types.Object   `tfsdk:"gcp"`
+	FeatureUsages       types.List     `tfsdk:"feature_usages"`
+	Timeouts            timeouts.Value `tfsdk:"timeouts"`
+}
+
+type TFAWSModel struct {
+	IAMAssumedRoleARN          types.String `tfsdk:"iam_assumed_role_arn"`
+	AtlasAWSAccountARN         types.String `tfsdk:"atlas_aws_account_arn"`
+	AtlasAssumedRoleExternalID types.String `tfsdk:"atlas_assumed_role_external_id"`
+}
+
+type TFAzureModel struct {
+	AtlasAzureAppID    types.String `tfsdk:"atlas_azure_app_id"`
+	ServicePrincipalID types.String `tfsdk:"service_principal_id"`
+	TenantID           types.String `tfsdk:"tenant_id"`
+}
+
+type TFGCPModel struct {
+	ServiceAccountForAtlas types.String `tfsdk:"service_account_for_atlas"`
+}
+
+type TFFeatureUsageModel struct {
+	FeatureType types.String `tfsdk:"feature_type"`
+	FeatureID   types.Object `tfsdk:"feature_id"`
+}
+
+type TFFeatureIDModel struct {
+	ProjectID  types.String `tfsdk:"project_id"`
+	BucketName types.String `tfsdk:"bucket_name"`
+}
+
+var (
+	AWSObjType = types.ObjectType{AttrTypes: map[string]attr.Type{
+		"iam_assumed_role_arn":           types.StringType,
+		"atlas_aws_account_arn":          types.StringType,
+		"atlas_assumed_role_external_id": types.StringType,
+	}}
+	AzureObjType = types.ObjectType{AttrTypes: map[string]attr.Type{
+		"atlas_azure_app_id":   types.StringType,
+		"service_principal_id": types.StringType,
+		"tenant_id":            types.StringType,
+	}}
+	GCPObjType = types.ObjectType{AttrTypes: map[string]attr.Type{
+		"service_account_for_atlas": types.StringType,
+	}}
+	FeatureIDObjType = types.ObjectType{AttrTypes: map[string]attr.Type{
+		"project_id":  types.StringType,
+		"bucket_name": types.StringType,
+	}}
+	FeatureUsageObjType = types.ObjectType{AttrTypes: map[string]attr.Type{
+		"feature_type": types.StringType,
+		"feature_id":   FeatureIDObjType,
+	}}
+)
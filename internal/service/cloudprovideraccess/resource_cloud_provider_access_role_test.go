@@ -0,0 +1,213 @@
+package cloudprovideraccess_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/cloudprovideraccess"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/testutil/acc"
+)
+
+const resourceRoleName = "mongodbatlas_cloud_provider_access_role.test"
+
+func TestAccCloudProviderAccessRoleAWS_deferredAuthorization(t *testing.T) {
+	var (
+		projectID = acc.ProjectIDExecution(t)
+		roleName  = acc.RandomIAMRole()
+		config    = configRoleAWS(projectID, roleName)
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acc.PreCheckBasic(t) },
+		ExternalProviders:        acc.ExternalProvidersOnlyAWS(),
+		ProtoV6ProviderFactories: acc.TestAccProviderV6Factories,
+		CheckDestroy:             checkDestroyRole,
+		Steps: []resource.TestStep{
+			{
+				// the IAM role doesn't exist yet when Atlas tries to assume it so the authorization is deferred to the next apply
+				Config:             config,
+				ExpectNonEmptyPlan: true,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					checkRoleExists(resourceRoleName),
+					resource.TestCheckResourceAttr(resourceRoleName, "authorization_status", cloudprovideraccess.AuthorizationStatusPending),
+					resource.TestCheckResourceAttrSet(resourceRoleName, "aws.atlas_assumed_role_external_id"),
+					resource.TestCheckResourceAttrSet(resourceRoleName, "aws.atlas_aws_account_arn"),
+					resource.TestCheckNoResourceAttr(resourceRoleName, "authorized_date"),
+				),
+			},
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					checkRoleExists(resourceRoleName),
+					resource.TestCheckResourceAttr(resourceRoleName, "authorization_status", cloudprovideraccess.AuthorizationStatusAuthorized),
+					resource.TestCheckResourceAttrSet(resourceRoleName, "authorized_date"),
+					resource.TestCheckResourceAttrPair(resourceRoleName, "aws.iam_assumed_role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceRoleName, "feature_usages.#", "0"),
+				),
+			},
+			{
+				ResourceName:                         resourceRoleName,
+				ImportStateIdFunc:                    importStateIDFuncRole(resourceRoleName),
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "role_id",
+				ImportStateVerifyIgnore:              []string{"defer_authorization"},
+			},
+		},
+	})
+}
+
+func TestAccCloudProviderAccessRoleGCP_basic(t *testing.T) {
+	projectID := acc.ProjectIDExecution(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acc.PreCheckBasic(t) },
+		ProtoV6ProviderFactories: acc.TestAccProviderV6Factories,
+		CheckDestroy:             checkDestroyRole,
+		Steps: []resource.TestStep{
+			{
+				Config: configRoleGCP(projectID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					checkRoleExists(resourceRoleName),
+					resource.TestCheckResourceAttr(resourceRoleName, "provider_name", "GCP"),
+					resource.TestCheckResourceAttr(resourceRoleName, "authorization_status", cloudprovideraccess.AuthorizationStatusAuthorized),
+					resource.TestCheckResourceAttrSet(resourceRoleName, "gcp.service_account_for_atlas"),
+					resource.TestCheckNoResourceAttr(resourceRoleName, "aws.atlas_assumed_role_external_id"),
+				),
+			},
+			{
+				ResourceName:                         resourceRoleName,
+				ImportStateIdFunc:                    importStateIDFuncRole(resourceRoleName),
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "role_id",
+			},
+		},
+	})
+}
+
+func TestAccCloudProviderAccessRoleAzure_basic(t *testing.T) {
+	var (
+		atlasAzureAppID    = os.Getenv("AZURE_ATLAS_APP_ID")
+		servicePrincipalID = os.Getenv("AZURE_SERVICE_PRINCIPAL_ID")
+		tenantID           = os.Getenv("AZURE_TENANT_ID")
+		projectID          = acc.ProjectIDExecution(t)
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acc.PreCheckCloudProviderAccessAzure(t) },
+		ProtoV6ProviderFactories: acc.TestAccProviderV6Factories,
+		CheckDestroy:             checkDestroyRole,
+		Steps: []resource.TestStep{
+			{
+				Config: configRoleAzure(projectID, atlasAzureAppID, servicePrincipalID, tenantID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					checkRoleExists(resourceRoleName),
+					resource.TestCheckResourceAttr(resourceRoleName, "authorization_status", cloudprovideraccess.AuthorizationStatusAuthorized),
+					resource.TestCheckResourceAttr(resourceRoleName, "azure.atlas_azure_app_id", atlasAzureAppID),
+					resource.TestCheckResourceAttr(resourceRoleName, "azure.service_principal_id", servicePrincipalID),
+					resource.TestCheckResourceAttr(resourceRoleName, "azure.tenant_id", tenantID),
+				),
+			},
+		},
+	})
+}
+
+func configRoleAWS(projectID, roleName string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "mongodbatlas_cloud_provider_access_role" "test" {
+  project_id          = %[1]q
+  provider_name       = "AWS"
+  defer_authorization = true
+
+  aws = {
+    iam_assumed_role_arn = "arn:aws:iam::${data.aws_caller_identity.current.account_id}:role/%[2]s"
+  }
+}
+
+resource "aws_iam_role" "test" {
+  name = %[2]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect    = "Allow"
+      Principal = { AWS = mongodbatlas_cloud_provider_access_role.test.aws.atlas_aws_account_arn }
+      Action    = "sts:AssumeRole"
+      Condition = {
+        StringEquals = { "sts:ExternalId" = mongodbatlas_cloud_provider_access_role.test.aws.atlas_assumed_role_external_id }
+      }
+    }]
+  })
+}
+`, projectID, roleName)
+}
+
+func configRoleGCP(projectID string) string {
+	return fmt.Sprintf(`
+resource "mongodbatlas_cloud_provider_access_role" "test" {
+  project_id    = %[1]q
+  provider_name = "GCP"
+}
+`, projectID)
+}
+
+func configRoleAzure(projectID, atlasAzureAppID, servicePrincipalID, tenantID string) string {
+	return fmt.Sprintf(`
+resource "mongodbatlas_cloud_provider_access_role" "test" {
+  project_id    = %[1]q
+  provider_name = "AZURE"
+
+  azure = {
+    atlas_azure_app_id   = %[2]q
+    service_principal_id = %[3]q
+    tenant_id            = %[4]q
+  }
+}
+`, projectID, atlasAzureAppID, servicePrincipalID, tenantID)
+}
+
+func checkRoleExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+		projectID, roleID := rs.Primary.Attributes["project_id"], rs.Primary.Attributes["role_id"]
+		if _, _, err := acc.ConnV2().CloudProviderAccessApi.GetCloudProviderAccessRole(context.Background(), projectID, roleID).Execute(); err != nil {
+			return fmt.Errorf("cloud provider access role (%s) does not exist: %w", roleID, err)
+		}
+		return nil
+	}
+}
+
+func checkDestroyRole(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "mongodbatlas_cloud_provider_access_role" {
+			continue
+		}
+		projectID, roleID := rs.Primary.Attributes["project_id"], rs.Primary.Attributes["role_id"]
+		if _, _, err := acc.ConnV2().CloudProviderAccessApi.GetCloudProviderAccessRole(context.Background(), projectID, roleID).Execute(); err == nil {
+			return fmt.Errorf("cloud provider access role (%s) still exists", roleID)
+		}
+	}
+	return nil
+}
+
+func importStateIDFuncRole(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", resourceName)
+		}
+		attrs := rs.Primary.Attributes
+		return fmt.Sprintf("%s-%s-%s", attrs["project_id"], attrs["provider_name"], attrs["role_id"]), nil
+	}
+}
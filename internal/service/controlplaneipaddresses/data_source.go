@@ -32,6 +32,12 @@ func (d *controlPlaneIPAddressesDS) Schema(ctx context.Context, req datasource.S
 
 func (d *controlPlaneIPAddressesDS) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	connV2 := d.Client.AtlasV2
+	var tfConfig TFControlPlaneIpAddressesModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &tfConfig)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	apiResp, _, err := connV2.RootApi.ReturnAllControlPlaneIpAddresses(ctx).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError("error fetching control plane ip addresses", err.Error())
@@ -42,5 +48,12 @@ func (d *controlPlaneIPAddressesDS) Read(ctx context.Context, req datasource.Rea
 		resp.Diagnostics.Append(diags...)
 		return
 	}
+	newControlPlaneIPAddressesModel.Direction = tfConfig.Direction
+	newControlPlaneIPAddressesModel.Providers = tfConfig.Providers
+	newControlPlaneIPAddressesModel.Regions = tfConfig.Regions
+	resp.Diagnostics.Append(newControlPlaneIPAddressesModel.SetCIDRBlocks(ctx, apiResp)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, newControlPlaneIPAddressesModel)...)
 }
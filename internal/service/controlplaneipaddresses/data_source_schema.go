@@ -3,10 +3,15 @@ package controlplaneipaddresses
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/constant"
 )
 
 func DataSourceSchema(ctx context.Context) schema.Schema {
@@ -66,13 +71,56 @@ func DataSourceSchema(ctx context.Context) schema.Schema {
 				Computed:            true,
 				MarkdownDescription: "List of outbound IP addresses from the Atlas control plane, categorized by cloud provider. If your network allows inbound HTTP requests only from specific IP addresses, you must allow access from the following IP addresses so that Atlas can communicate with your webhooks and KMS.",
 			},
+			"direction": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Direction of the IP addresses included in `cidr_blocks`, `cidr_blocks_by_region` and `aggregated_cidr_blocks`. Valid values are `inbound` and `outbound`. All directions are included if not set.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(DirectionInbound, DirectionOutbound),
+				},
+			},
+			"providers": schema.SetAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "Cloud providers of the IP addresses included in `cidr_blocks`, `cidr_blocks_by_region` and `aggregated_cidr_blocks`. Valid values are `AWS`, `AZURE` and `GCP`. All cloud providers are included if not set.",
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(constant.AWS, constant.AZURE, constant.GCP)),
+				},
+			},
+			"regions": schema.SetAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "Regions of the IP addresses included in `cidr_blocks`, `cidr_blocks_by_region` and `aggregated_cidr_blocks`, as named by the cloud provider, e.g. `us-east-1`. All regions are included if not set.",
+			},
+			"cidr_blocks": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Computed:            true,
+				MarkdownDescription: "Deduplicated CIDR blocks of the control plane IP addresses matching `direction`, `providers` and `regions`, sorted by address.",
+			},
+			"cidr_blocks_by_region": schema.MapAttribute{
+				ElementType: types.ListType{
+					ElemType: types.StringType,
+				},
+				Computed:            true,
+				MarkdownDescription: "Deduplicated CIDR blocks of the control plane IP addresses matching `direction`, `providers` and `regions`, by region.",
+			},
+			"aggregated_cidr_blocks": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Computed:            true,
+				MarkdownDescription: "Minimal list of CIDR blocks covering exactly the addresses in `cidr_blocks`, adjacent blocks are merged. Use it to keep firewall rules short.",
+			},
 		},
 	}
 }
 
 type TFControlPlaneIpAddressesModel struct {
-	Inbound  InboundValue  `tfsdk:"inbound"`
-	Outbound OutboundValue `tfsdk:"outbound"`
+	Inbound              InboundValue  `tfsdk:"inbound"`
+	Outbound             OutboundValue `tfsdk:"outbound"`
+	Direction            types.String  `tfsdk:"direction"`
+	Providers            types.Set     `tfsdk:"providers"`
+	Regions              types.Set     `tfsdk:"regions"`
+	CidrBlocks           types.List    `tfsdk:"cidr_blocks"`
+	CidrBlocksByRegion   types.Map     `tfsdk:"cidr_blocks_by_region"`
+	AggregatedCidrBlocks types.List    `tfsdk:"aggregated_cidr_blocks"`
 }
 
 type InboundValue struct {
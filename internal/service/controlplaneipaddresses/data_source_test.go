@@ -17,6 +17,16 @@ func TestAccControlPlaneIpAddressesDS_basic(t *testing.T) {
 				Config: configBasic,
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttrWith(dataSourceName, "outbound.aws.us-east-1.0", acc.CIDRBlockExpression()),
+					resource.TestCheckResourceAttrWith(dataSourceName, "cidr_blocks.0", acc.CIDRBlockExpression()),
+					resource.TestCheckResourceAttrWith(dataSourceName, "aggregated_cidr_blocks.0", acc.CIDRBlockExpression()),
+				),
+			},
+			{
+				Config: configFiltered,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "cidr_blocks_by_region.%", "1"),
+					resource.TestCheckResourceAttrWith(dataSourceName, "cidr_blocks_by_region.us-east-1.0", acc.CIDRBlockExpression()),
+					resource.TestCheckResourceAttrWith(dataSourceName, "cidr_blocks.0", acc.CIDRBlockExpression()),
 				),
 			},
 		},
@@ -27,3 +37,11 @@ const configBasic = `
 data "mongodbatlas_control_plane_ip_addresses" "test" {
 }
 `
+
+const configFiltered = `
+data "mongodbatlas_control_plane_ip_addresses" "test" {
+  direction = "outbound"
+  providers = ["AWS"]
+  regions   = ["us-east-1"]
+}
+`
@@ -2,9 +2,12 @@ package controlplaneipaddresses
 
 import (
 	"context"
+	"fmt"
 	"slices"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/constant"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/conversion"
 	"go.mongodb.org/atlas-sdk/v20250312003/admin"
 )
@@ -38,3 +41,95 @@ func NewTFControlPlaneIPAddresses(ctx context.Context, apiResp *admin.ControlPla
 		},
 	}, nil
 }
+
+const (
+	DirectionInbound  = "inbound"
+	DirectionOutbound = "outbound"
+)
+
+// Filter selects the control plane IP addresses included in the CIDR block attributes, empty fields don't filter.
+type Filter struct {
+	Direction string
+	Providers []string
+	Regions   []string
+}
+
+// CIDRBlocksByRegion returns the deduplicated CIDR blocks of the IP addresses matching the filter, by region.
+func CIDRBlocksByRegion(apiResp *admin.ControlPlaneIPAddresses, filter Filter) (map[string][]string, error) {
+	inbound, outbound := apiResp.GetInbound(), apiResp.GetOutbound()
+	byDirection := map[string]map[string]map[string][]string{
+		DirectionInbound: {
+			constant.AWS:   inbound.GetAws(),
+			constant.AZURE: inbound.GetAzure(),
+			constant.GCP:   inbound.GetGcp(),
+		},
+		DirectionOutbound: {
+			constant.AWS:   outbound.GetAws(),
+			constant.AZURE: outbound.GetAzure(),
+			constant.GCP:   outbound.GetGcp(),
+		},
+	}
+	values := make(map[string][]string)
+	for direction, byProvider := range byDirection {
+		if filter.Direction != "" && filter.Direction != direction {
+			continue
+		}
+		for provider, byRegion := range byProvider {
+			if len(filter.Providers) > 0 && !slices.Contains(filter.Providers, provider) {
+				continue
+			}
+			for region, addresses := range byRegion {
+				if len(filter.Regions) > 0 && !slices.Contains(filter.Regions, region) {
+					continue
+				}
+				values[region] = append(values[region], addresses...)
+			}
+		}
+	}
+	result := make(map[string][]string, len(values))
+	for region, addresses := range values {
+		cidrs, err := conversion.NormalizeCIDRs(addresses)
+		if err != nil {
+			return nil, fmt.Errorf("region %s: %w", region, err)
+		}
+		result[region] = cidrs
+	}
+	return result, nil
+}
+
+// SetCIDRBlocks sets the CIDR block attributes using the direction, providers and regions attributes of the model as filter.
+func (m *TFControlPlaneIpAddressesModel) SetCIDRBlocks(ctx context.Context, apiResp *admin.ControlPlaneIPAddresses) diag.Diagnostics {
+	var diags diag.Diagnostics
+	filter := Filter{
+		Direction: m.Direction.ValueString(),
+		Providers: conversion.TypesSetToString(ctx, m.Providers),
+		Regions:   conversion.TypesSetToString(ctx, m.Regions),
+	}
+	byRegion, err := CIDRBlocksByRegion(apiResp, filter)
+	if err != nil {
+		diags.AddError("error parsing control plane ip addresses", err.Error())
+		return diags
+	}
+	var all []string
+	for _, cidrs := range byRegion {
+		all = append(all, cidrs...)
+	}
+	cidrs, err := conversion.NormalizeCIDRs(all)
+	if err != nil {
+		diags.AddError("error parsing control plane ip addresses", err.Error())
+		return diags
+	}
+	aggregated, err := conversion.AggregateCIDRs(all)
+	if err != nil {
+		diags.AddError("error aggregating control plane ip addresses", err.Error())
+		return diags
+	}
+	var localDiags diag.Diagnostics
+	m.CidrBlocks, localDiags = types.ListValueFrom(ctx, types.StringType, cidrs)
+	diags.Append(localDiags...)
+	m.CidrBlocksByRegion, localDiags = conversion.ToTFMapOfSlices(ctx, byRegion)
+	diags.Append(localDiags...)
+	m.AggregatedCidrBlocks, localDiags = types.ListValueFrom(ctx, types.StringType, aggregated)
+	diags.Append(localDiags...)
+	return diags
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/conversion"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/controlplaneipaddresses"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.mongodb.org/atlas-sdk/v20250312003/admin"
 )
 
@@ -106,3 +107,95 @@ func toTFMap(t *testing.T, values map[string][]string) basetypes.MapValue {
 	}
 	return result
 }
+
+func TestCIDRBlocksByRegion(t *testing.T) {
+	apiResp := &admin.ControlPlaneIPAddresses{
+		Inbound: &admin.InboundControlPlaneCloudProviderIPAddresses{
+			Aws: &map[string][]string{
+				"us-east-1": {"3.92.113.229/32", "3.92.113.228/32"},
+				"eu-west-1": {"34.240.0.10/32"},
+			},
+			Gcp: &map[string][]string{
+				"us-east1": {"35.185.0.1/32"},
+			},
+		},
+		Outbound: &admin.OutboundControlPlaneCloudProviderIPAddresses{
+			Aws: &map[string][]string{
+				"us-east-1": {"3.92.113.229/32", "3.208.110.31/32"},
+			},
+			Azure: &map[string][]string{
+				"eastus": {"20.42.0.0/31"},
+			},
+		},
+	}
+	testCases := map[string]struct {
+		expected map[string][]string
+		filter   controlplaneipaddresses.Filter
+	}{
+		"no filter": {
+			expected: map[string][]string{
+				"us-east-1": {"3.92.113.228/32", "3.92.113.229/32", "3.208.110.31/32"},
+				"eu-west-1": {"34.240.0.10/32"},
+				"us-east1":  {"35.185.0.1/32"},
+				"eastus":    {"20.42.0.0/31"},
+			},
+		},
+		"inbound": {
+			filter: controlplaneipaddresses.Filter{Direction: controlplaneipaddresses.DirectionInbound},
+			expected: map[string][]string{
+				"us-east-1": {"3.92.113.228/32", "3.92.113.229/32"},
+				"eu-west-1": {"34.240.0.10/32"},
+				"us-east1":  {"35.185.0.1/32"},
+			},
+		},
+		"outbound aws": {
+			filter: controlplaneipaddresses.Filter{Direction: controlplaneipaddresses.DirectionOutbound, Providers: []string{"AWS"}},
+			expected: map[string][]string{
+				"us-east-1": {"3.92.113.229/32", "3.208.110.31/32"},
+			},
+		},
+		"regions": {
+			filter: controlplaneipaddresses.Filter{Regions: []string{"us-east1", "eastus"}},
+			expected: map[string][]string{
+				"us-east1": {"35.185.0.1/32"},
+				"eastus":   {"20.42.0.0/31"},
+			},
+		},
+		"no match": {
+			filter:   controlplaneipaddresses.Filter{Providers: []string{"AZURE"}, Direction: controlplaneipaddresses.DirectionInbound},
+			expected: map[string][]string{},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			result, err := controlplaneipaddresses.CIDRBlocksByRegion(apiResp, tc.filter)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, result)
+		})
+	}
+}
+
+func TestSetCIDRBlocks(t *testing.T) {
+	apiResp := &admin.ControlPlaneIPAddresses{
+		Inbound: &admin.InboundControlPlaneCloudProviderIPAddresses{
+			Aws: &map[string][]string{
+				"us-east-1": {"3.92.113.229/32", "3.92.113.228/32"},
+			},
+		},
+		Outbound: &admin.OutboundControlPlaneCloudProviderIPAddresses{
+			Aws: &map[string][]string{
+				"us-east-1": {"3.92.113.230/32"},
+			},
+		},
+	}
+	model := controlplaneipaddresses.TFControlPlaneIpAddressesModel{
+		Direction: types.StringValue(controlplaneipaddresses.DirectionInbound),
+		Providers: types.SetNull(types.StringType),
+		Regions:   types.SetNull(types.StringType),
+	}
+	diags := model.SetCIDRBlocks(t.Context(), apiResp)
+	require.False(t, diags.HasError())
+	assert.Equal(t, []string{"3.92.113.228/32", "3.92.113.229/32"}, conversion.TypesListToString(t.Context(), model.CidrBlocks))
+	assert.Equal(t, []string{"3.92.113.228/31"}, conversion.TypesListToString(t.Context(), model.AggregatedCidrBlocks))
+	assert.Equal(t, toTFMap(t, map[string][]string{"us-east-1": {"3.92.113.228/32", "3.92.113.229/32"}}), model.CidrBlocksByRegion)
+}
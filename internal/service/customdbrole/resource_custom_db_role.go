@@ -9,11 +9,13 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/conversion"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/dbrole"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/validate"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/config"
 	"github.com/spf13/cast"
@@ -26,6 +28,7 @@ func Resource() *schema.Resource {
 		ReadContext:   resourceRead,
 		UpdateContext: resourceUpdate,
 		DeleteContext: resourceDelete,
+		CustomizeDiff: resourceCustomizeDiff,
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceImport,
 		},
@@ -56,8 +59,9 @@ func Resource() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"action": {
-							Type:     schema.TypeString,
-							Required: true,
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: validateActionName,
 						},
 						"resources": {
 							Type:     schema.TypeSet,
@@ -267,7 +271,64 @@ func resourceImport(ctx context.Context, d *schema.ResourceData, meta any) ([]*s
 	return []*schema.ResourceData{d}, nil
 }
 
-func expandActions(d *schema.ResourceData) *[]admin.DatabasePrivilegeAction {
+func resourceCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	var errs []error
+	if d.GetRawConfig().GetAttr("actions").IsWhollyKnown() && (d.Id() == "" || d.HasChange("actions")) {
+		for i, action := range *expandActions(d) {
+			for _, detail := range dbrole.CheckActionScopes(action.Action, actionResources(action.GetResources())) {
+				errs = append(errs, fmt.Errorf("invalid actions.%d.resources: %s", i, detail))
+			}
+		}
+	}
+	if len(errs) > 0 {
+		return errors.Join(errs...)
+	}
+
+	if !d.GetRawConfig().GetAttr("inherited_roles").IsWhollyKnown() || !d.NewValueKnown("role_name") || !d.NewValueKnown("project_id") {
+		return nil
+	}
+	projectID := d.Get("project_id").(string)
+	roleName := d.Get("role_name").(string)
+	inherited := dbrole.InheritedCustomRoles(*expandInheritedRoles(d))
+	if err := dbrole.CheckInheritanceCycle(nil, roleName, inherited); err != nil || len(inherited) == 0 || (d.Id() != "" && !d.HasChange("inherited_roles")) {
+		return err
+	}
+	// other roles in the same configuration are not visible here, mongodbatlas_custom_db_role_inheritance_check checks them
+	connV2 := meta.(*config.MongoDBClient).AtlasV2
+	roles, _, err := connV2.CustomDatabaseRolesApi.ListCustomDatabaseRoles(ctx, projectID).Execute()
+	if err != nil {
+		// the project may be created in the same apply
+		log.Printf("[WARN] couldn't list custom db roles in project %s to check inherited_roles cycles: %s", projectID, err)
+		return nil
+	}
+	return dbrole.CheckInheritanceCycle(dbrole.InheritanceGraph(roles), roleName, inherited)
+}
+
+func validateActionName(v any, p cty.Path) diag.Diagnostics {
+	errDetail, warningDetail := dbrole.CheckActionName(v.(string))
+	switch {
+	case errDetail != "":
+		return diag.Diagnostics{{Severity: diag.Error, Summary: "Invalid privilege action", Detail: errDetail, AttributePath: p}}
+	case warningDetail != "":
+		return diag.Diagnostics{{Severity: diag.Warning, Summary: "Unknown privilege action", Detail: warningDetail, AttributePath: p}}
+	}
+	return nil
+}
+
+func actionResources(resources []admin.DatabasePermittedNamespaceResource) []dbrole.ActionResource {
+	result := make([]dbrole.ActionResource, len(resources))
+	for i, r := range resources {
+		result[i] = dbrole.ActionResource{Database: r.Db, Collection: r.Collection, Cluster: r.Cluster}
+	}
+	return result
+}
+
+// resourceGetter reads attributes from both the resource state and the plan being customized.
+type resourceGetter interface {
+	Get(key string) any
+}
+
+func expandActions(d resourceGetter) *[]admin.DatabasePrivilegeAction {
 	actions := make([]admin.DatabasePrivilegeAction, len(d.Get("actions").([]any)))
 	for k, v := range d.Get("actions").([]any) {
 		a := v.(map[string]any)
@@ -320,7 +381,7 @@ func flattenActionResources(resources []admin.DatabasePermittedNamespaceResource
 	return actionResourceList
 }
 
-func expandInheritedRoles(d *schema.ResourceData) *[]admin.DatabaseInheritedRole {
+func expandInheritedRoles(d resourceGetter) *[]admin.DatabaseInheritedRole {
 	vIR := d.Get("inherited_roles").(*schema.Set).List()
 	ir := make([]admin.DatabaseInheritedRole, len(vIR))
 	if len(vIR) != 0 {
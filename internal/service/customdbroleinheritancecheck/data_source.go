@@ -0,0 +1,56 @@
+package customdbroleinheritancecheck
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"go.mongodb.org/atlas-sdk/v20250312003/admin"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/conversion"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/config"
+)
+
+const (
+	dataSourceName = "custom_db_role_inheritance_check"
+	errorRead      = "error reading data source mongodbatlas_" + dataSourceName
+)
+
+var _ datasource.DataSource = &ds{}
+var _ datasource.DataSourceWithConfigure = &ds{}
+
+func DataSource() datasource.DataSource {
+	return &ds{
+		DSCommon: config.DSCommon{
+			DataSourceName: dataSourceName,
+		},
+	}
+}
+
+type ds struct {
+	config.DSCommon
+}
+
+func (d *ds) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = DataSourceSchema(ctx)
+	conversion.UpdateSchemaDescription(&resp.Schema)
+}
+
+// Read only calls the Atlas API when project_id is set, so the data source can be used before the project exists.
+func (d *ds) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var tfModel TFInheritanceCheckModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &tfModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var atlasRoles []admin.UserCustomDBRole
+	if projectID := tfModel.ProjectID.ValueString(); projectID != "" {
+		roles, _, err := d.Client.AtlasV2.CustomDatabaseRolesApi.ListCustomDatabaseRoles(ctx, projectID).Execute()
+		if err != nil {
+			resp.Diagnostics.AddError(errorRead, "error listing custom db roles: "+err.Error())
+			return
+		}
+		atlasRoles = roles
+	}
+	CheckInheritance(&tfModel, atlasRoles)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &tfModel)...)
+}
@@ -0,0 +1,79 @@
+package customdbroleinheritancecheck
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func DataSourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Checks the `inherited_roles` of custom DB roles for cycles, including roles that don't exist in Atlas yet.",
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Unique 24-hexadecimal digit string that identifies your project. If set, the custom roles of the project in Atlas are checked too, and `roles` replace the Atlas roles with the same name.",
+			},
+			"roles": schema.ListNestedAttribute{
+				Required:            true,
+				MarkdownDescription: "Custom roles to check, usually the same values used for the `role_name` and `inherited_roles` of `mongodbatlas_custom_db_role` resources.",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"role_name": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "Name of the custom role.",
+						},
+						"inherited_roles": schema.SetNestedAttribute{
+							Optional:            true,
+							MarkdownDescription: "Roles inherited by the custom role. Only roles in the `admin` database can be custom roles, the rest are ignored.",
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"database_name": schema.StringAttribute{
+										Required:            true,
+										MarkdownDescription: "Database on which the inherited role is granted.",
+									},
+									"role_name": schema.StringAttribute{
+										Required:            true,
+										MarkdownDescription: "Name of the inherited role.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"errors": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Computed:            true,
+				MarkdownDescription: "Human-readable description of every problem found.",
+			},
+			"valid": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Flag that indicates whether no problems were found.",
+			},
+		},
+	}
+}
+
+type TFInheritanceCheckModel struct {
+	ProjectID types.String  `tfsdk:"project_id"`
+	Roles     []TFRoleModel `tfsdk:"roles"`
+	Errors    []string      `tfsdk:"errors"`
+	Valid     types.Bool    `tfsdk:"valid"`
+}
+
+type TFRoleModel struct {
+	RoleName       types.String           `tfsdk:"role_name"`
+	InheritedRoles []TFInheritedRoleModel `tfsdk:"inherited_roles"`
+}
+
+type TFInheritedRoleModel struct {
+	DatabaseName types.String `tfsdk:"database_name"`
+	RoleName     types.String `tfsdk:"role_name"`
+}
@@ -0,0 +1,53 @@
+package customdbroleinheritancecheck_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/testutil/acc"
+)
+
+const dataSourceName = "data.mongodbatlas_custom_db_role_inheritance_check.test"
+
+func TestAccCustomDBRoleInheritanceCheckDS_basic(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acc.PreCheckBasic(t) },
+		ProtoV6ProviderFactories: acc.TestAccProviderV6Factories,
+		Steps: []resource.TestStep{
+			{
+				Config: configBasic("readAnyDatabase"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "valid", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "errors.#", "0"),
+				),
+			},
+			{
+				Config: configBasic("roleA"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "valid", "false"),
+					resource.TestCheckResourceAttr(dataSourceName, "errors.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "errors.0", "inherited_roles form a cycle between custom roles: roleA -> roleB -> roleA"),
+				),
+			},
+		},
+	})
+}
+
+func configBasic(inheritedByRoleB string) string {
+	return fmt.Sprintf(`
+		data "mongodbatlas_custom_db_role_inheritance_check" "test" {
+			roles = [
+				{
+					role_name       = "roleA"
+					inherited_roles = [{ database_name = "admin", role_name = "roleB" }]
+				},
+				{
+					role_name       = "roleB"
+					inherited_roles = [{ database_name = "admin", role_name = %[1]q }]
+				},
+			]
+		}
+	`, inheritedByRoleB)
+}
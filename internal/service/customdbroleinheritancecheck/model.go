@@ -0,0 +1,35 @@
+package customdbroleinheritancecheck
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"go.mongodb.org/atlas-sdk/v20250312003/admin"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/dbrole"
+)
+
+// CheckInheritance fills the computed attributes of tfModel. The roles of tfModel replace the atlasRoles with the same name.
+func CheckInheritance(tfModel *TFInheritanceCheckModel, atlasRoles []admin.UserCustomDBRole) {
+	tfModel.Errors = []string{}
+	graph := dbrole.InheritanceGraph(atlasRoles)
+	configured := make(map[string]bool, len(tfModel.Roles))
+	for i := range tfModel.Roles {
+		role := &tfModel.Roles[i]
+		roleName := role.RoleName.ValueString()
+		if configured[roleName] {
+			tfModel.Errors = append(tfModel.Errors, fmt.Sprintf("custom role %s is set more than once in roles", roleName))
+			continue
+		}
+		configured[roleName] = true
+		inherited := make([]admin.DatabaseInheritedRole, len(role.InheritedRoles))
+		for j, r := range role.InheritedRoles {
+			inherited[j] = admin.DatabaseInheritedRole{Db: r.DatabaseName.ValueString(), Role: r.RoleName.ValueString()}
+		}
+		graph[roleName] = dbrole.InheritedCustomRoles(inherited)
+	}
+	for _, cycle := range dbrole.FindInheritanceCycles(graph) {
+		tfModel.Errors = append(tfModel.Errors, dbrole.DescribeInheritanceCycle(cycle))
+	}
+	tfModel.Valid = types.BoolValue(len(tfModel.Errors) == 0)
+}
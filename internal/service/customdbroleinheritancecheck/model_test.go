@@ -0,0 +1,84 @@
+package customdbroleinheritancecheck_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/atlas-sdk/v20250312003/admin"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/customdbroleinheritancecheck"
+)
+
+func TestCheckInheritance(t *testing.T) {
+	testCases := map[string]struct {
+		roles          []customdbroleinheritancecheck.TFRoleModel
+		atlasRoles     []admin.UserCustomDBRole
+		expectedErrors []string
+	}{
+		"no cycles": {
+			roles: []customdbroleinheritancecheck.TFRoleModel{
+				role("roleA", "roleB"),
+				role("roleB"),
+			},
+			expectedErrors: []string{},
+		},
+		"cycle between configured roles": {
+			roles: []customdbroleinheritancecheck.TFRoleModel{
+				role("roleA", "roleB"),
+				role("roleB", "roleC"),
+				role("roleC", "roleA"),
+			},
+			expectedErrors: []string{"inherited_roles form a cycle between custom roles: roleA -> roleB -> roleC -> roleA"},
+		},
+		"role inheriting itself": {
+			roles:          []customdbroleinheritancecheck.TFRoleModel{role("roleA", "roleA")},
+			expectedErrors: []string{"custom role roleA can't inherit itself"},
+		},
+		"cycle through an Atlas role": {
+			roles: []customdbroleinheritancecheck.TFRoleModel{role("roleA", "roleB")},
+			atlasRoles: []admin.UserCustomDBRole{
+				{RoleName: "roleB", InheritedRoles: &[]admin.DatabaseInheritedRole{{Db: "admin", Role: "roleA"}}},
+			},
+			expectedErrors: []string{"inherited_roles form a cycle between custom roles: roleA -> roleB -> roleA"},
+		},
+		"configured roles replace Atlas roles": {
+			roles: []customdbroleinheritancecheck.TFRoleModel{role("roleA", "roleB"), role("roleB")},
+			atlasRoles: []admin.UserCustomDBRole{
+				{RoleName: "roleB", InheritedRoles: &[]admin.DatabaseInheritedRole{{Db: "admin", Role: "roleA"}}},
+			},
+			expectedErrors: []string{},
+		},
+		"roles in other databases are ignored": {
+			roles: []customdbroleinheritancecheck.TFRoleModel{
+				{RoleName: types.StringValue("read"), InheritedRoles: []customdbroleinheritancecheck.TFInheritedRoleModel{
+					{DatabaseName: types.StringValue("sales"), RoleName: types.StringValue("read")},
+				}},
+			},
+			expectedErrors: []string{},
+		},
+		"duplicated role": {
+			roles:          []customdbroleinheritancecheck.TFRoleModel{role("roleA"), role("roleA")},
+			expectedErrors: []string{"custom role roleA is set more than once in roles"},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tfModel := customdbroleinheritancecheck.TFInheritanceCheckModel{Roles: tc.roles}
+			customdbroleinheritancecheck.CheckInheritance(&tfModel, tc.atlasRoles)
+			assert.Equal(t, tc.expectedErrors, tfModel.Errors)
+			assert.Equal(t, len(tc.expectedErrors) == 0, tfModel.Valid.ValueBool())
+		})
+	}
+}
+
+func role(name string, inherited ...string) customdbroleinheritancecheck.TFRoleModel {
+	model := customdbroleinheritancecheck.TFRoleModel{RoleName: types.StringValue(name)}
+	for _, r := range inherited {
+		model.InheritedRoles = append(model.InheritedRoles, customdbroleinheritancecheck.TFInheritedRoleModel{
+			DatabaseName: types.StringValue("admin"),
+			RoleName:     types.StringValue(r),
+		})
+	}
+	return model
+}
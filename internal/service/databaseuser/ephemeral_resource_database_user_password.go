@@ -0,0 +1,80 @@
+package databaseuser
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/conversion"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/config"
+)
+
+const (
+	passwordEphemeralResourceName = "database_user_password"
+	errorGeneratePassword         = "error generating database user password"
+)
+
+var _ ephemeral.EphemeralResource = &passwordEphemeralRS{}
+var _ ephemeral.EphemeralResourceWithConfigure = &passwordEphemeralRS{}
+
+func PasswordEphemeralResource() ephemeral.EphemeralResource {
+	return &passwordEphemeralRS{
+		EphemeralCommon: config.EphemeralCommon{
+			EphemeralResourceName: passwordEphemeralResourceName,
+		},
+	}
+}
+
+type passwordEphemeralRS struct {
+	config.EphemeralCommon
+}
+
+type TFPasswordEphemeralModel struct {
+	Length   types.Int64  `tfsdk:"length"`
+	Password types.String `tfsdk:"password"`
+}
+
+func (e *passwordEphemeralRS) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"length": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Number of characters of the password. Defaults to `32`.",
+				Validators: []validator.Int64{
+					int64validator.Between(MinPasswordLength, MaxPasswordLength),
+				},
+			},
+			"password": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "Generated password with at least one lowercase letter, uppercase letter, digit and symbol. Symbols are limited to `-`, `.`, `_` and `~` so the password can be used in connection strings without encoding.",
+			},
+		},
+	}
+	conversion.UpdateSchemaDescription(&resp.Schema)
+}
+
+// Open generates a new password every time, so it's never persisted in plan or state. It's sent to Atlas by the database user
+// resource only when password_wo must be rotated.
+func (e *passwordEphemeralRS) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var tfModel TFPasswordEphemeralModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &tfModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	length := DefaultPasswordLength
+	if !tfModel.Length.IsNull() {
+		length = int(tfModel.Length.ValueInt64())
+	}
+	password, err := GeneratePassword(length)
+	if err != nil {
+		resp.Diagnostics.AddError(errorGeneratePassword, err.Error())
+		return
+	}
+	tfModel.Password = types.StringValue(password)
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &tfModel)...)
+}
@@ -49,6 +49,13 @@ func NewMongoDBDatabaseUser(ctx context.Context, statePasswordValue, stateDescri
 		// Password value has been modified or no previous state was present. Password is only updated if changed in the terraform configuration CLOUDP-235738
 		result.Password = plan.Password.ValueStringPointer()
 	}
+	if !plan.PasswordWO.IsNull() && plan.PasswordLastRotated.IsUnknown() {
+		// password_wo is always null in the state, it's only sent when a rotation is planned
+		result.Password = plan.PasswordWO.ValueStringPointer()
+	}
+	if deleteAfterDate, ok := conversion.StringToTime(plan.DeleteAfterDate.ValueString()); ok {
+		result.DeleteAfterDate = &deleteAfterDate
+	}
 	if plan.Description.IsNull() && !stateDescriptionValue.Equal(plan.Description) {
 		// description is an optional attribute (i.e. null by default), if it is removed from the config during an update
 		// (i.e. user wants to remove the existing description from the database user), we send an empty string ("") as the value in API request for update (dumping null is not supported in the SDK)
@@ -92,12 +99,21 @@ func NewTfDatabaseUserModel(ctx context.Context, inModel *TfDatabaseUserModel, d
 		Roles:            rolesSet,
 		Labels:           labelsSet,
 		Scopes:           scopesSet,
+		DeleteAfterDate:  newDeleteAfterDate(types.StringNull(), dbUser.DeleteAfterDate),
 	}
 
 	if inModel != nil && inModel.Password.ValueString() != "" {
 		// The Password is not retuned from the endpoint so we use the one provided in the model
 		outModel.Password = inModel.Password
 	}
+	if inModel != nil {
+		// password_wo is never returned to the state
+		outModel.PasswordWOVersion = inModel.PasswordWOVersion
+		outModel.RotationDays = inModel.RotationDays
+		outModel.PasswordLastRotated = inModel.PasswordLastRotated
+		outModel.TTL = inModel.TTL
+		outModel.DeleteAfterDate = newDeleteAfterDate(inModel.DeleteAfterDate, dbUser.DeleteAfterDate)
+	}
 	if inModel != nil && outModel.Description.Equal(types.StringValue("")) && inModel.Description.IsNull() {
 		// null != "" in TPF:  Error: Provider produced inconsistent result after apply. .description: was null, but now cty.StringVal("")
 		outModel.Description = types.StringNull()
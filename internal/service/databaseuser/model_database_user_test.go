@@ -126,6 +126,20 @@ func TestNewMongoDBDatabaseUser(t *testing.T) {
 			expectedResult:      cloudDatabaseUserWithoutPassword,
 			expectedError:       false,
 		},
+		{
+			name:                "CloudDatabaseUser with write-only password when rotation is planned",
+			tfDatabaseUserModel: *getDatabaseUserModelWriteOnly(types.StringUnknown()),
+			passwordStateValue:  types.StringNull(),
+			expectedResult:      cloudDatabaseUser,
+			expectedError:       false,
+		},
+		{
+			name:                "CloudDatabaseUser with no password in model when rotation is not planned",
+			tfDatabaseUserModel: *getDatabaseUserModelWriteOnly(types.StringValue("2025-06-29T12:00:00Z")),
+			passwordStateValue:  types.StringNull(),
+			expectedResult:      cloudDatabaseUserWithoutPassword,
+			expectedError:       false,
+		},
 		{
 			name:                "Roles fail",
 			tfDatabaseUserModel: *getDatabaseUserModel(wrongRoleSet, labelsSet, scopesSet, types.StringValue(password)),
@@ -369,6 +383,13 @@ func getDatabaseUserModel(roles, labels, scopes basetypes.SetValue, password typ
 	}
 }
 
+func getDatabaseUserModelWriteOnly(lastRotated types.String) *databaseuser.TfDatabaseUserModel {
+	model := getDatabaseUserModel(rolesSet, labelsSet, scopesSet, types.StringNull())
+	model.PasswordWO = types.StringValue(password)
+	model.PasswordLastRotated = lastRotated
+	return model
+}
+
 func TestSplitDatabaseUserImportID(t *testing.T) {
 	tests := map[string]struct {
 		importID    string
@@ -0,0 +1,173 @@
+package databaseuser
+
+import (
+	"reflect"
+	"slices"
+	"sort"
+	"strings"
+
+	"go.mongodb.org/atlas-sdk/v20250312003/admin"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/conversion"
+)
+
+// UsersSetChanges are the usernames to create, update, delete, or replace because their authentication database changed.
+type UsersSetChanges struct {
+	Create  []string
+	Update  []string
+	Delete  []string
+	Replace []string
+}
+
+// Usernames returns all the usernames with changes, sorted.
+func (c *UsersSetChanges) Usernames() []string {
+	usernames := slices.Concat(c.Create, c.Update, c.Delete, c.Replace)
+	sort.Strings(usernames)
+	return usernames
+}
+
+// DiffUsersSet compares the users in the state and in the plan. Users are only updated if some of their attributes changed.
+func DiffUsersSet(state, plan map[string]TFSetUserModel) *UsersSetChanges {
+	changes := new(UsersSetChanges)
+	for username := range plan {
+		prev, found := state[username]
+		current := plan[username]
+		switch {
+		case !found:
+			changes.Create = append(changes.Create, username)
+		case !prev.AuthDatabaseName.Equal(current.AuthDatabaseName):
+			changes.Replace = append(changes.Replace, username)
+		case !SetUsersEqual(&prev, &current):
+			changes.Update = append(changes.Update, username)
+		}
+	}
+	for username := range state {
+		if _, found := plan[username]; !found {
+			changes.Delete = append(changes.Delete, username)
+		}
+	}
+	sort.Strings(changes.Create)
+	sort.Strings(changes.Update)
+	sort.Strings(changes.Delete)
+	sort.Strings(changes.Replace)
+	return changes
+}
+
+// SetUsersEqual returns true if both users have the same attributes, regardless of the order of roles and scopes.
+func SetUsersEqual(a, b *TFSetUserModel) bool {
+	return reflect.DeepEqual(sortedSetUser(a), sortedSetUser(b))
+}
+
+func sortedSetUser(user *TFSetUserModel) TFSetUserModel {
+	sorted := *user
+	sorted.Roles = slices.Clone(user.Roles)
+	slices.SortFunc(sorted.Roles, func(a, b TfRoleModel) int {
+		return strings.Compare(setRoleKey(&a), setRoleKey(&b))
+	})
+	sorted.Scopes = slices.Clone(user.Scopes)
+	slices.SortFunc(sorted.Scopes, func(a, b TfScopeModel) int {
+		return strings.Compare(a.Type.ValueString()+"/"+a.Name.ValueString(), b.Type.ValueString()+"/"+b.Name.ValueString())
+	})
+	if len(sorted.Roles) == 0 {
+		sorted.Roles = nil
+	}
+	if len(sorted.Scopes) == 0 {
+		sorted.Scopes = nil
+	}
+	if len(sorted.Labels) == 0 {
+		sorted.Labels = nil
+	}
+	return sorted
+}
+
+func setRoleKey(role *TfRoleModel) string {
+	return role.RoleName.ValueString() + "@" + role.DatabaseName.ValueString() + "/" + role.CollectionName.ValueString()
+}
+
+// NewAtlasSetUser returns the request to create or update a user of the set. The password is only sent if the user is created
+// (prev is nil) or the password changed. An empty description is sent to remove the description of an existing user.
+func NewAtlasSetUser(projectID, username string, user, prev *TFSetUserModel) *admin.CloudDatabaseUser {
+	roles := make([]admin.DatabaseUserRole, len(user.Roles))
+	for i, role := range user.Roles {
+		roles[i] = admin.DatabaseUserRole{
+			RoleName:       role.RoleName.ValueString(),
+			DatabaseName:   role.DatabaseName.ValueString(),
+			CollectionName: role.CollectionName.ValueStringPointer(),
+		}
+	}
+	scopes := make([]admin.UserScope, len(user.Scopes))
+	for i, scope := range user.Scopes {
+		scopes[i] = admin.UserScope{Name: scope.Name.ValueString(), Type: scope.Type.ValueString()}
+	}
+	keys := make([]string, 0, len(user.Labels))
+	for key := range user.Labels {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	labels := make([]admin.ComponentLabel, len(keys))
+	for i, key := range keys {
+		labels[i] = admin.ComponentLabel{Key: conversion.Pointer(key), Value: conversion.Pointer(user.Labels[key])}
+	}
+	result := &admin.CloudDatabaseUser{
+		GroupId:      projectID,
+		Username:     username,
+		DatabaseName: user.AuthDatabaseName.ValueString(),
+		Description:  user.Description.ValueStringPointer(),
+		X509Type:     user.X509Type.ValueStringPointer(),
+		OidcAuthType: user.OIDCAuthType.ValueStringPointer(),
+		LdapAuthType: user.LDAPAuthType.ValueStringPointer(),
+		AwsIAMType:   user.AWSIAMType.ValueStringPointer(),
+		Roles:        &roles,
+		Scopes:       &scopes,
+		Labels:       &labels,
+	}
+	if prev == nil || !prev.Password.Equal(user.Password) {
+		result.Password = user.Password.ValueStringPointer()
+	}
+	if prev != nil && user.Description.IsNull() && !prev.Description.IsNull() {
+		result.Description = conversion.Pointer("")
+	}
+	return result
+}
+
+// NewTFSetUser returns the user as stored in the state. The password isn't returned by Atlas so it's kept from prev, which is nil
+// for imported users.
+func NewTFSetUser(prev *TFSetUserModel, user *admin.CloudDatabaseUser) TFSetUserModel {
+	var roles []TfRoleModel
+	for _, role := range user.GetRoles() {
+		roles = append(roles, TfRoleModel{
+			RoleName:       types.StringValue(role.RoleName),
+			DatabaseName:   types.StringValue(role.DatabaseName),
+			CollectionName: conversion.StringPtrNullIfEmpty(role.CollectionName),
+		})
+	}
+	var scopes []TfScopeModel
+	for _, scope := range user.GetScopes() {
+		scopes = append(scopes, TfScopeModel{Name: types.StringValue(scope.Name), Type: types.StringValue(scope.Type)})
+	}
+	var labels map[string]string
+	for _, label := range user.GetLabels() {
+		if labels == nil {
+			labels = make(map[string]string)
+		}
+		labels[label.GetKey()] = label.GetValue()
+	}
+	model := TFSetUserModel{
+		AuthDatabaseName: types.StringValue(user.DatabaseName),
+		Description:      conversion.StringPtrNullIfEmpty(user.Description),
+		X509Type:         types.StringValue(user.GetX509Type()),
+		OIDCAuthType:     types.StringValue(user.GetOidcAuthType()),
+		LDAPAuthType:     types.StringValue(user.GetLdapAuthType()),
+		AWSIAMType:       types.StringValue(user.GetAwsIAMType()),
+		Roles:            roles,
+		Scopes:           scopes,
+		Labels:           labels,
+		Password:         types.StringNull(),
+	}
+	if prev != nil {
+		model.Password = prev.Password
+	}
+	return model
+}
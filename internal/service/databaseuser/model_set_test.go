@@ -0,0 +1,145 @@
+package databaseuser_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"go.mongodb.org/atlas-sdk/v20250312003/admin"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/databaseuser"
+)
+
+func setUser(authDatabaseName string, roles ...string) databaseuser.TFSetUserModel {
+	user := databaseuser.TFSetUserModel{
+		AuthDatabaseName: types.StringValue(authDatabaseName),
+		Password:         types.StringNull(),
+		Description:      types.StringNull(),
+		X509Type:         types.StringValue("NONE"),
+		OIDCAuthType:     types.StringValue("NONE"),
+		LDAPAuthType:     types.StringValue("NONE"),
+		AWSIAMType:       types.StringValue("NONE"),
+	}
+	for _, role := range roles {
+		user.Roles = append(user.Roles, databaseuser.TfRoleModel{
+			RoleName:       types.StringValue(role),
+			DatabaseName:   types.StringValue("app"),
+			CollectionName: types.StringNull(),
+		})
+	}
+	return user
+}
+
+func TestDiffUsersSet(t *testing.T) {
+	withLabel := setUser("admin", "read")
+	withLabel.Labels = map[string]string{"team": "payments"}
+	state := map[string]databaseuser.TFSetUserModel{
+		"unchanged":   setUser("admin", "read", "readWrite"),
+		"updated":     setUser("admin", "read"),
+		"deleted":     setUser("admin", "read"),
+		"replaced":    setUser("admin", "read"),
+		"labels-only": setUser("admin", "read"),
+	}
+	plan := map[string]databaseuser.TFSetUserModel{
+		"unchanged":   setUser("admin", "readWrite", "read"),
+		"updated":     setUser("admin", "readWrite"),
+		"replaced":    setUser("$external", "read"),
+		"labels-only": withLabel,
+		"created":     setUser("admin", "read"),
+	}
+	changes := databaseuser.DiffUsersSet(state, plan)
+	assert.Equal(t, &databaseuser.UsersSetChanges{
+		Create:  []string{"created"},
+		Update:  []string{"labels-only", "updated"},
+		Delete:  []string{"deleted"},
+		Replace: []string{"replaced"},
+	}, changes)
+	assert.Equal(t, []string{"created", "deleted", "labels-only", "replaced", "updated"}, changes.Usernames())
+
+	assert.Empty(t, databaseuser.DiffUsersSet(state, state).Usernames())
+	assert.Equal(t, []string{"deleted", "labels-only", "replaced", "unchanged", "updated"}, databaseuser.DiffUsersSet(state, nil).Delete)
+}
+
+func TestNewAtlasSetUser(t *testing.T) {
+	user := setUser("admin", "read")
+	user.Password = types.StringValue("new-password")
+	user.Labels = map[string]string{"b": "2", "a": "1"}
+	user.Scopes = []databaseuser.TfScopeModel{{Name: types.StringValue("cluster1"), Type: types.StringValue("CLUSTER")}}
+
+	req := databaseuser.NewAtlasSetUser("project", "user1", &user, nil)
+	assert.Equal(t, "new-password", req.GetPassword())
+	assert.Equal(t, "admin", req.DatabaseName)
+	assert.Equal(t, []admin.ComponentLabel{{Key: admin.PtrString("a"), Value: admin.PtrString("1")}, {Key: admin.PtrString("b"), Value: admin.PtrString("2")}}, req.GetLabels())
+	assert.Equal(t, []admin.UserScope{{Name: "cluster1", Type: "CLUSTER"}}, req.GetScopes())
+	assert.Nil(t, req.Description)
+
+	prev := user
+	prev.Description = types.StringValue("old description")
+	req = databaseuser.NewAtlasSetUser("project", "user1", &user, &prev)
+	assert.Nil(t, req.Password, "password not sent if unchanged")
+	assert.Equal(t, "", req.GetDescription(), "empty description sent to remove it")
+
+	prev.Password = types.StringValue("old-password")
+	req = databaseuser.NewAtlasSetUser("project", "user1", &user, &prev)
+	assert.Equal(t, "new-password", req.GetPassword())
+}
+
+func TestNewTFSetUser(t *testing.T) {
+	apiUser := &admin.CloudDatabaseUser{
+		Username:     "user1",
+		DatabaseName: "admin",
+		Description:  admin.PtrString(""),
+		X509Type:     admin.PtrString("NONE"),
+		OidcAuthType: admin.PtrString("NONE"),
+		LdapAuthType: admin.PtrString("NONE"),
+		AwsIAMType:   admin.PtrString("NONE"),
+		Roles:        &[]admin.DatabaseUserRole{{RoleName: "read", DatabaseName: "app", CollectionName: admin.PtrString("")}},
+		Labels:       &[]admin.ComponentLabel{},
+	}
+	expected := setUser("admin", "read")
+	assert.Equal(t, expected, databaseuser.NewTFSetUser(nil, apiUser))
+
+	prev := setUser("admin", "read")
+	prev.Password = types.StringValue("password")
+	expected.Password = prev.Password
+	apiUser.Labels = &[]admin.ComponentLabel{{Key: admin.PtrString("team"), Value: admin.PtrString("payments")}}
+	expected.Labels = map[string]string{"team": "payments"}
+	assert.Equal(t, expected, databaseuser.NewTFSetUser(&prev, apiUser))
+}
+
+func TestRunParallel(t *testing.T) {
+	const maxConcurrency = 3
+	var running, maxRunning atomic.Int32
+	keys := make([]string, 20)
+	for i := range keys {
+		keys[i] = fmt.Sprintf("user%d", i)
+	}
+	results, errs := databaseuser.RunParallel(t.Context(), keys, maxConcurrency, func(ctx context.Context, key string) (string, error) {
+		current := running.Add(1)
+		defer running.Add(-1)
+		for {
+			prev := maxRunning.Load()
+			if current <= prev || maxRunning.CompareAndSwap(prev, current) {
+				break
+			}
+		}
+		time.Sleep(5 * time.Millisecond)
+		if key == "user7" {
+			return "partial", errors.New("failed")
+		}
+		return "ok-" + key, nil
+	})
+	require.Len(t, results, len(keys))
+	assert.Equal(t, "ok-user0", results["user0"])
+	assert.Equal(t, "partial", results["user7"], "result kept for failed calls")
+	assert.Equal(t, map[string]error{"user7": errors.New("failed")}, errs)
+	assert.LessOrEqual(t, maxRunning.Load(), int32(maxConcurrency))
+	assert.Positive(t, maxRunning.Load())
+}
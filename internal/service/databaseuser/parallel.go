@@ -0,0 +1,40 @@
+package databaseuser
+
+import (
+	"context"
+	"sync"
+)
+
+// RunParallel calls fn for every key with at most maxConcurrency calls running at the same time. It waits for all the calls and
+// returns their results and errors by key, so the result of a call is available even if it failed.
+func RunParallel[T any](ctx context.Context, keys []string, maxConcurrency int, fn func(ctx context.Context, key string) (T, error)) (results map[string]T, errs map[string]error) {
+	results = make(map[string]T, len(keys))
+	errs = make(map[string]error)
+	if maxConcurrency < 1 {
+		maxConcurrency = 1
+	}
+	var (
+		mu  sync.Mutex
+		wg  sync.WaitGroup
+		sem = make(chan struct{}, maxConcurrency)
+	)
+	for _, key := range keys {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func() {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			result, err := fn(ctx, key)
+			mu.Lock()
+			defer mu.Unlock()
+			results[key] = result
+			if err != nil {
+				errs[key] = err
+			}
+		}()
+	}
+	wg.Wait()
+	return results, errs
+}
@@ -0,0 +1,92 @@
+package databaseuser
+
+import (
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/conversion"
+)
+
+const (
+	DefaultPasswordLength = 32
+	MinPasswordLength     = 12
+	MaxPasswordLength     = 128
+)
+
+// Password characters are limited to the URI unreserved set so generated passwords can be used in connection strings without
+// percent-encoding.
+var passwordCharClasses = []string{
+	"abcdefghijklmnopqrstuvwxyz",
+	"ABCDEFGHIJKLMNOPQRSTUVWXYZ",
+	"0123456789",
+	"-._~",
+}
+
+// GeneratePassword returns a random password of the given length with at least one lowercase letter, uppercase letter, digit and
+// symbol.
+func GeneratePassword(length int) (string, error) {
+	if length < MinPasswordLength || length > MaxPasswordLength {
+		return "", fmt.Errorf("password length must be between %d and %d, got %d", MinPasswordLength, MaxPasswordLength, length)
+	}
+	var all string
+	for _, class := range passwordCharClasses {
+		all += class
+	}
+	password := make([]byte, length)
+	for i := range password {
+		charset := all
+		if i < len(passwordCharClasses) {
+			charset = passwordCharClasses[i]
+		}
+		c, err := randomChar(charset)
+		if err != nil {
+			return "", err
+		}
+		password[i] = c
+	}
+	// shuffle so the required characters are not always at the beginning
+	for i := len(password) - 1; i > 0; i-- {
+		j, err := rand.Int(rand.Reader, big.NewInt(int64(i+1)))
+		if err != nil {
+			return "", err
+		}
+		password[i], password[j.Int64()] = password[j.Int64()], password[i]
+	}
+	return string(password), nil
+}
+
+func randomChar(charset string) (byte, error) {
+	n, err := rand.Int(rand.Reader, big.NewInt(int64(len(charset))))
+	if err != nil {
+		return 0, err
+	}
+	return charset[n.Int64()], nil
+}
+
+// RotationDue returns true if rotationDays have elapsed since lastRotated. It's false if rotation is disabled, and true if
+// lastRotated is empty or not a valid timestamp.
+func RotationDue(lastRotated string, rotationDays int64, now time.Time) bool {
+	if rotationDays <= 0 {
+		return false
+	}
+	last, ok := conversion.StringToTime(lastRotated)
+	if !ok {
+		return true
+	}
+	return !now.Before(last.Add(time.Duration(rotationDays) * 24 * time.Hour))
+}
+
+// PasswordRotationPlanned returns true if password_wo must be sent to Atlas: when the user is created, when password_wo is set for
+// the first time or password_wo_version changes, and when the rotation period has elapsed. The password is only rotated if
+// password_wo is set in the configuration.
+func PasswordRotationPlanned(state, plan, config *TfDatabaseUserModel, now time.Time) bool {
+	if config.PasswordWO.IsNull() {
+		return false
+	}
+	if state == nil || state.PasswordLastRotated.IsNull() || !state.PasswordWOVersion.Equal(plan.PasswordWOVersion) {
+		return true
+	}
+	return RotationDue(state.PasswordLastRotated.ValueString(), plan.RotationDays.ValueInt64(), now)
+}
@@ -0,0 +1,111 @@
+package databaseuser_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/databaseuser"
+)
+
+func TestGeneratePassword(t *testing.T) {
+	for _, length := range []int{databaseuser.MinPasswordLength, databaseuser.DefaultPasswordLength, databaseuser.MaxPasswordLength} {
+		password, err := databaseuser.GeneratePassword(length)
+		require.NoError(t, err)
+		assert.Len(t, password, length)
+		for _, class := range []string{"abcdefghijklmnopqrstuvwxyz", "ABCDEFGHIJKLMNOPQRSTUVWXYZ", "0123456789", "-._~"} {
+			assert.True(t, strings.ContainsAny(password, class), "password %s has no characters from %s", password, class)
+		}
+	}
+	first, err := databaseuser.GeneratePassword(databaseuser.DefaultPasswordLength)
+	require.NoError(t, err)
+	second, err := databaseuser.GeneratePassword(databaseuser.DefaultPasswordLength)
+	require.NoError(t, err)
+	assert.NotEqual(t, first, second)
+}
+
+func TestGeneratePasswordInvalidLength(t *testing.T) {
+	_, err := databaseuser.GeneratePassword(databaseuser.MinPasswordLength - 1)
+	require.ErrorContains(t, err, "password length must be between")
+	_, err = databaseuser.GeneratePassword(databaseuser.MaxPasswordLength + 1)
+	require.ErrorContains(t, err, "password length must be between")
+}
+
+func TestRotationDue(t *testing.T) {
+	now := time.Date(2025, 6, 30, 12, 0, 0, 0, time.UTC)
+	testCases := map[string]struct {
+		lastRotated  string
+		rotationDays int64
+		expected     bool
+	}{
+		"rotation disabled":  {lastRotated: "2020-01-01T00:00:00Z"},
+		"period not elapsed": {lastRotated: "2025-06-01T12:00:01Z", rotationDays: 29},
+		"period elapsed":     {lastRotated: "2025-06-01T12:00:00Z", rotationDays: 29, expected: true},
+		"never rotated":      {rotationDays: 30, expected: true},
+		"invalid timestamp":  {lastRotated: "yesterday", rotationDays: 30, expected: true},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, databaseuser.RotationDue(tc.lastRotated, tc.rotationDays, now))
+		})
+	}
+}
+
+func TestPasswordRotationPlanned(t *testing.T) {
+	now := time.Date(2025, 6, 30, 12, 0, 0, 0, time.UTC)
+	recent := types.StringValue("2025-06-29T12:00:00Z")
+	config := &databaseuser.TfDatabaseUserModel{PasswordWO: types.StringValue("secret")}
+	testCases := map[string]struct {
+		state    *databaseuser.TfDatabaseUserModel
+		plan     *databaseuser.TfDatabaseUserModel
+		config   *databaseuser.TfDatabaseUserModel
+		expected bool
+	}{
+		"create": {
+			plan:     &databaseuser.TfDatabaseUserModel{},
+			config:   config,
+			expected: true,
+		},
+		"create without password_wo": {
+			plan:   &databaseuser.TfDatabaseUserModel{},
+			config: &databaseuser.TfDatabaseUserModel{},
+		},
+		"password_wo added": {
+			state:    &databaseuser.TfDatabaseUserModel{PasswordLastRotated: types.StringNull()},
+			plan:     &databaseuser.TfDatabaseUserModel{},
+			config:   config,
+			expected: true,
+		},
+		"version changed": {
+			state:    &databaseuser.TfDatabaseUserModel{PasswordLastRotated: recent, PasswordWOVersion: types.Int64Value(1)},
+			plan:     &databaseuser.TfDatabaseUserModel{PasswordWOVersion: types.Int64Value(2)},
+			config:   config,
+			expected: true,
+		},
+		"version unchanged": {
+			state:  &databaseuser.TfDatabaseUserModel{PasswordLastRotated: recent, PasswordWOVersion: types.Int64Value(1)},
+			plan:   &databaseuser.TfDatabaseUserModel{PasswordWOVersion: types.Int64Value(1), RotationDays: types.Int64Value(30)},
+			config: config,
+		},
+		"rotation period elapsed": {
+			state:    &databaseuser.TfDatabaseUserModel{PasswordLastRotated: recent},
+			plan:     &databaseuser.TfDatabaseUserModel{RotationDays: types.Int64Value(1)},
+			config:   config,
+			expected: true,
+		},
+		"rotation period elapsed without password_wo": {
+			state:  &databaseuser.TfDatabaseUserModel{PasswordLastRotated: recent},
+			plan:   &databaseuser.TfDatabaseUserModel{RotationDays: types.Int64Value(1)},
+			config: &databaseuser.TfDatabaseUserModel{},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, databaseuser.PasswordRotationPlanned(tc.state, tc.plan, tc.config, now))
+		})
+	}
+}
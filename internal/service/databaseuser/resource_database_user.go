@@ -3,8 +3,11 @@ package databaseuser
 import (
 	"context"
 	"errors"
+	"fmt"
 	"regexp"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -16,6 +19,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"go.mongodb.org/atlas-sdk/v20250312003/admin"
 
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/conversion"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/validate"
@@ -24,11 +28,14 @@ import (
 
 const (
 	databaseUserResourceName = "database_user"
-	ErrorImportFormat        = "import format error: to import a Database User, use the format {project_id}-{username}-{auth_database_name} OR {project_id}/{username}/{auth_database_name}"
+	// maxTTLMinutes is one week, the maximum time in the future that Atlas accepts for deleteAfterDate.
+	maxTTLMinutes     = 7 * 24 * 60
+	ErrorImportFormat = "import format error: to import a Database User, use the format {project_id}-{username}-{auth_database_name} OR {project_id}/{username}/{auth_database_name}"
 )
 
 var _ resource.ResourceWithConfigure = &databaseUserRS{}
 var _ resource.ResourceWithImportState = &databaseUserRS{}
+var _ resource.ResourceWithModifyPlan = &databaseUserRS{}
 
 type databaseUserRS struct {
 	config.RSCommon
@@ -48,6 +55,7 @@ type TfDatabaseUserModel struct {
 	AuthDatabaseName types.String `tfsdk:"auth_database_name"`
 	Username         types.String `tfsdk:"username"`
 	Password         types.String `tfsdk:"password"`
+	PasswordWO       types.String `tfsdk:"password_wo"`
 	X509Type         types.String `tfsdk:"x509_type"`
 	OIDCAuthType     types.String `tfsdk:"oidc_auth_type"`
 	LDAPAuthType     types.String `tfsdk:"ldap_auth_type"`
@@ -56,6 +64,12 @@ type TfDatabaseUserModel struct {
 	Roles            types.Set    `tfsdk:"roles"`
 	Labels           types.Set    `tfsdk:"labels"`
 	Scopes           types.Set    `tfsdk:"scopes"`
+
+	PasswordWOVersion   types.Int64  `tfsdk:"password_wo_version"`
+	RotationDays        types.Int64  `tfsdk:"rotation_days"`
+	PasswordLastRotated types.String `tfsdk:"password_last_rotated"`
+	DeleteAfterDate     types.String `tfsdk:"delete_after_date"`
+	TTL                 types.String `tfsdk:"ttl"`
 }
 
 type TfRoleModel struct {
@@ -122,9 +136,51 @@ func (r *databaseUserRS) Schema(ctx context.Context, req resource.SchemaRequest,
 						path.MatchRelative().AtParent().AtName("x509_type"),
 						path.MatchRelative().AtParent().AtName("ldap_auth_type"),
 						path.MatchRelative().AtParent().AtName("aws_iam_type"),
+						path.MatchRelative().AtParent().AtName("password_wo"),
 					}...),
 				},
 			},
+			"password_wo": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+				WriteOnly: true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.Expressions{
+						path.MatchRoot("x509_type"),
+						path.MatchRoot("ldap_auth_type"),
+						path.MatchRoot("aws_iam_type"),
+					}...),
+					// Terraform doesn't detect changes in write-only attributes, so rotations are tracked with the version
+					stringvalidator.AlsoRequires(path.MatchRoot("password_wo_version")),
+				},
+			},
+			"password_wo_version": schema.Int64Attribute{
+				Optional: true,
+			},
+			"rotation_days": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+					int64validator.AlsoRequires(path.MatchRoot("password_wo")),
+				},
+			},
+			"password_last_rotated": schema.StringAttribute{
+				Computed: true,
+			},
+			"delete_after_date": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					validate.StringIsTimestamp(),
+					stringvalidator.ConflictsWith(path.MatchRoot("ttl")),
+				},
+			},
+			"ttl": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					validate.ValidDurationBetween(1, maxTTLMinutes),
+				},
+			},
 			"description": schema.StringAttribute{
 				Optional: true,
 			},
@@ -207,14 +263,52 @@ func (r *databaseUserRS) Schema(ctx context.Context, req resource.SchemaRequest,
 	}
 }
 
+// ModifyPlan plans a new password_last_rotated, which makes Create or Update send password_wo to Atlas, when the password must be
+// rotated because password_wo_version changes or rotation_days have elapsed. Otherwise the last rotation time is kept from the state.
+// delete_after_date is resolved from ttl during apply when the user is created or ttl changes, and removed when the previous apply set
+// one of them and neither is set now.
+func (r *databaseUserRS) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	var plan, config TfDatabaseUserModel
+	var state *TfDatabaseUserModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	lastRotated := types.StringNull()
+	if PasswordRotationPlanned(state, &plan, &config, time.Now()) {
+		lastRotated = types.StringUnknown()
+	} else if state != nil && !config.PasswordWO.IsNull() {
+		lastRotated = state.PasswordLastRotated
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("password_last_rotated"), lastRotated)...)
+	if config.DeleteAfterDate.IsNull() {
+		configured, diags := deleteAfterDateConfigured(ctx, req.Private)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("delete_after_date"), PlannedDeleteAfterDate(state, &plan, &config, configured))...)
+	}
+}
+
 func (r *databaseUserRS) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var plan *TfDatabaseUserModel
+	var plan, config *TfDatabaseUserModel
 
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	plan.PasswordWO = config.PasswordWO
+	resolveDeleteAfterDate(plan, time.Now())
 
 	dbUserReq, localDiags := NewMongoDBDatabaseUser(ctx, types.StringNull(), types.StringNull(), plan)
 	resp.Diagnostics.Append(localDiags...)
@@ -234,9 +328,11 @@ func (r *databaseUserRS) Create(ctx context.Context, req resource.CreateRequest,
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	setPasswordLastRotated(plan, dbUserModel)
 
 	resp.Diagnostics.AddWarning("If the password value will be managed externally it is advised to remove the attribute", "More details can be found in resource documentation under the 'password' attribute")
 
+	resp.Diagnostics.Append(setDeleteAfterDateConfigured(ctx, resp.Private, config)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &dbUserModel)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -260,6 +356,10 @@ func (r *databaseUserRS) Read(ctx context.Context, req resource.ReadRequest, res
 		// case 404
 		// deleted in the backend case
 		if validate.StatusNotFound(httpResponse) {
+			if Expired(state.DeleteAfterDate.ValueString(), time.Now()) {
+				resp.Diagnostics.AddWarning("Database user expired",
+					fmt.Sprintf("database user %s was deleted by Atlas after its delete_after_date %s, it's removed from the state", username, state.DeleteAfterDate.ValueString()))
+			}
 			resp.State.RemoveResource(ctx)
 			return
 		}
@@ -280,13 +380,16 @@ func (r *databaseUserRS) Read(ctx context.Context, req resource.ReadRequest, res
 }
 
 func (r *databaseUserRS) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan, state *TfDatabaseUserModel
+	var plan, state, config *TfDatabaseUserModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	plan.PasswordWO = config.PasswordWO
+	resolveDeleteAfterDate(plan, time.Now())
 
 	dbUserReq, localDiags := NewMongoDBDatabaseUser(ctx, state.Password, state.Description, plan)
 	resp.Diagnostics.Append(localDiags...)
@@ -294,11 +397,16 @@ func (r *databaseUserRS) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
-	connV2 := r.Client.AtlasV2
-	dbUser, _, err := connV2.DatabaseUsersApi.UpdateDatabaseUser(ctx,
-		plan.ProjectID.ValueString(),
-		plan.AuthDatabaseName.ValueString(),
-		plan.Username.ValueString(), dbUserReq).Execute()
+	var dbUser *admin.CloudDatabaseUser
+	var err error
+	if ClearsDeleteAfterDate(state, plan) {
+		dbUser, err = updateUserClearingDeleteAfterDate(ctx, r.Client, dbUserReq)
+	} else {
+		dbUser, _, err = r.Client.AtlasV2.DatabaseUsersApi.UpdateDatabaseUser(ctx,
+			plan.ProjectID.ValueString(),
+			plan.AuthDatabaseName.ValueString(),
+			plan.Username.ValueString(), dbUserReq).Execute()
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("error during database user creation", err.Error())
 		return
@@ -309,7 +417,9 @@ func (r *databaseUserRS) Update(ctx context.Context, req resource.UpdateRequest,
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	setPasswordLastRotated(plan, dbUserModel)
 
+	resp.Diagnostics.Append(setDeleteAfterDateConfigured(ctx, resp.Private, config)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &dbUserModel)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -347,6 +457,13 @@ func (r *databaseUserRS) ImportState(ctx context.Context, req resource.ImportSta
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("auth_database_name"), authDatabaseName)...)
 }
 
+// setPasswordLastRotated sets the rotation time when password_wo has been sent to Atlas.
+func setPasswordLastRotated(plan, model *TfDatabaseUserModel) {
+	if plan.PasswordLastRotated.IsUnknown() {
+		model.PasswordLastRotated = types.StringValue(conversion.TimeToString(time.Now()))
+	}
+}
+
 func SplitDatabaseUserImportID(id string) (projectID, username, authDatabaseName string, err error) {
 	ok, projectID, username, authDatabaseName := conversion.ImportSplit3(id)
 	if ok {
@@ -6,6 +6,7 @@ import (
 	"os"
 	"slices"
 	"testing"
+	"time"
 
 	"maps"
 
@@ -485,6 +486,144 @@ func TestAccDatabaseUser_withOIDCAuthType(t *testing.T) {
 	})
 }
 
+func TestAccDatabaseUser_withPasswordWriteOnly(t *testing.T) {
+	var (
+		projectID   = acc.ProjectIDExecution(t)
+		username    = acc.RandomName()
+		lastRotated string
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acc.PreCheckBasic(t) },
+		ProtoV6ProviderFactories: acc.TestAccProviderV6Factories,
+		CheckDestroy:             checkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: configPasswordWriteOnly(projectID, username, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					checkExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "password_wo_version", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rotation_days", "30"),
+					resource.TestCheckNoResourceAttr(resourceName, "password"),
+					resource.TestCheckNoResourceAttr(resourceName, "password_wo"),
+					resource.TestCheckResourceAttrWith(resourceName, "password_last_rotated", func(value string) error {
+						lastRotated = value
+						return nil
+					}),
+				),
+			},
+			{
+				Config: configPasswordWriteOnly(projectID, username, 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "password_wo_version", "2"),
+					resource.TestCheckResourceAttrWith(resourceName, "password_last_rotated", func(value string) error {
+						if value == lastRotated {
+							return fmt.Errorf("password_last_rotated not updated after rotation: %s", value)
+						}
+						return nil
+					}),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportStateIdFunc:       importStateIDFunc(resourceName),
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password_wo_version", "rotation_days", "password_last_rotated"},
+			},
+		},
+	})
+}
+
+func configPasswordWriteOnly(projectID, username string, passwordVersion int) string {
+	return fmt.Sprintf(`
+		ephemeral "mongodbatlas_database_user_password" "test" {
+			length = 24
+		}
+
+		resource "mongodbatlas_database_user" "test" {
+			project_id          = %[1]q
+			username            = %[2]q
+			auth_database_name  = "admin"
+			password_wo         = ephemeral.mongodbatlas_database_user_password.test.password
+			password_wo_version = %[3]d
+			rotation_days       = 30
+
+			roles {
+				role_name     = "read"
+				database_name = "admin"
+			}
+		}
+	`, projectID, username, passwordVersion)
+}
+
+func TestAccDatabaseUser_withTTL(t *testing.T) {
+	var (
+		projectID       = acc.ProjectIDExecution(t)
+		username        = acc.RandomName()
+		deleteAfterDate string
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acc.PreCheckBasic(t) },
+		ProtoV6ProviderFactories: acc.TestAccProviderV6Factories,
+		CheckDestroy:             checkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: configTTL(projectID, username, "24h"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					checkExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "ttl", "24h"),
+					resource.TestCheckResourceAttrWith(resourceName, "delete_after_date", func(value string) error {
+						date, ok := conversion.StringToTime(value)
+						if !ok || date.Before(time.Now().Add(23*time.Hour)) || date.After(time.Now().Add(25*time.Hour)) {
+							return fmt.Errorf("unexpected delete_after_date for ttl 24h: %s", value)
+						}
+						deleteAfterDate = value
+						return nil
+					}),
+				),
+			},
+			{
+				Config: configTTL(projectID, username, "48h"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "ttl", "48h"),
+					resource.TestCheckResourceAttrWith(resourceName, "delete_after_date", func(value string) error {
+						if value == deleteAfterDate {
+							return fmt.Errorf("delete_after_date not updated after ttl change: %s", value)
+						}
+						return nil
+					}),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportStateIdFunc:       importStateIDFunc(resourceName),
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password", "ttl"},
+			},
+		},
+	})
+}
+
+func configTTL(projectID, username, ttl string) string {
+	return fmt.Sprintf(`
+		resource "mongodbatlas_database_user" "test" {
+			project_id         = %[1]q
+			username           = %[2]q
+			password           = "test-acc-password"
+			auth_database_name = "admin"
+			ttl                = %[3]q
+
+			roles {
+				role_name     = "read"
+				database_name = "admin"
+			}
+		}
+	`, projectID, username, ttl)
+}
+
 func checkAttrs(projectID, username, authDBName string, extraAttrs map[string]string, extra ...resource.TestCheckFunc) resource.TestCheckFunc {
 	attrsMap := map[string]string{
 		"project_id":         projectID,
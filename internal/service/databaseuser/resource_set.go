@@ -0,0 +1,229 @@
+package databaseuser
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"net/http"
+	"slices"
+
+	"go.mongodb.org/atlas-sdk/v20250312003/admin"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/conversion"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/dsschema"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/validate"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/config"
+)
+
+const (
+	databaseUsersSetResourceName = "database_users_set"
+	errorUsersSetCreate          = "error creating database user of the set"
+	errorUsersSetRead            = "error getting database users of the set"
+	errorUsersSetUpdate          = "error updating database user of the set"
+	errorUsersSetDelete          = "error deleting database user of the set"
+	errorUsersSetImport          = "error importing database users set"
+)
+
+type databaseUsersSetRS struct {
+	config.RSCommon
+}
+
+func SetResource() resource.Resource {
+	return &databaseUsersSetRS{
+		RSCommon: config.RSCommon{
+			ResourceName: databaseUsersSetResourceName,
+		},
+	}
+}
+
+var _ resource.ResourceWithConfigure = &databaseUsersSetRS{}
+var _ resource.ResourceWithImportState = &databaseUsersSetRS{}
+
+func (r *databaseUsersSetRS) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = ResourceSetSchema(ctx)
+	conversion.UpdateSchemaDescription(&resp.Schema)
+}
+
+// Create creates the users in parallel. If some users fail, the ones that were created are saved in the state so they are not
+// left untracked, and Terraform marks the resource as tainted.
+func (r *databaseUsersSetRS) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan TFDatabaseUsersSetModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state := r.apply(ctx, &plan, nil, DiffUsersSet(nil, plan.Users), &resp.Diagnostics)
+	if len(state.Users) == 0 && resp.Diagnostics.HasError() {
+		return
+	}
+	state.ID = plan.ProjectID
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// Read lists the users of the project once instead of getting each user. Users deleted outside Terraform are removed from the
+// state so they are planned to be created again. All the users of the project are read after import.
+func (r *databaseUsersSetRS) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state TFDatabaseUsersSetModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	projectID := state.ProjectID.ValueString()
+	apiUsers, httpResp, err := listSetUsers(ctx, r.Client.AtlasV2.DatabaseUsersApi, projectID)
+	if err != nil {
+		if validate.StatusNotFound(httpResp) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(errorUsersSetRead, err.Error())
+		return
+	}
+	imported := state.Users == nil
+	users := make(map[string]TFSetUserModel)
+	for i := range apiUsers {
+		apiUser := &apiUsers[i]
+		if imported {
+			if _, found := users[apiUser.Username]; found {
+				resp.Diagnostics.AddError(errorUsersSetImport, fmt.Sprintf("username %s exists in more than one authentication database", apiUser.Username))
+				return
+			}
+			users[apiUser.Username] = NewTFSetUser(nil, apiUser)
+			continue
+		}
+		if prev, found := state.Users[apiUser.Username]; found && prev.AuthDatabaseName.ValueString() == apiUser.DatabaseName {
+			users[apiUser.Username] = NewTFSetUser(&prev, apiUser)
+		}
+	}
+	if imported {
+		state.MaxConcurrency = types.Int64Value(defaultSetMaxConcurrency)
+	}
+	state.ID = types.StringValue(projectID)
+	state.Users = users
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update only calls the API for the users that changed. If some users fail, the state keeps their previous values and the other
+// users are saved with the new ones.
+func (r *databaseUsersSetRS) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state TFDatabaseUsersSetModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	newState := r.apply(ctx, &plan, state.Users, DiffUsersSet(state.Users, plan.Users), &resp.Diagnostics)
+	newState.ID = state.ID
+	resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
+}
+
+// Delete deletes the users in parallel. If some users fail, the state keeps them so the deletion can be retried.
+func (r *databaseUsersSetRS) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state TFDatabaseUsersSetModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	target := state
+	target.Users = nil
+	newState := r.apply(ctx, &target, state.Users, DiffUsersSet(state.Users, nil), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		newState.ID = state.ID
+		resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
+	}
+}
+
+func (r *databaseUsersSetRS) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), req.ID)...)
+}
+
+// setUserResult is the outcome of the API calls for a user. deleted is true if the user no longer exists in Atlas, even if a later
+// call failed, e.g. when the user was deleted to be created in another authentication database.
+type setUserResult struct {
+	user    *admin.CloudDatabaseUser
+	deleted bool
+}
+
+// apply calls the API for the changed users, with at most max_concurrency calls at the same time, and returns the new state: the
+// users in prevUsers updated with the calls that succeeded. An error is added for every user that failed.
+func (r *databaseUsersSetRS) apply(ctx context.Context, target *TFDatabaseUsersSetModel, prevUsers map[string]TFSetUserModel, changes *UsersSetChanges, diags *diag.Diagnostics) *TFDatabaseUsersSetModel {
+	client := r.Client.AtlasV2.DatabaseUsersApi
+	projectID := target.ProjectID.ValueString()
+	summaries := make(map[string]string)
+	for _, username := range changes.Create {
+		summaries[username] = errorUsersSetCreate
+	}
+	for _, username := range slices.Concat(changes.Update, changes.Replace) {
+		summaries[username] = errorUsersSetUpdate
+	}
+	for _, username := range changes.Delete {
+		summaries[username] = errorUsersSetDelete
+	}
+
+	results, errs := RunParallel(ctx, changes.Usernames(), int(target.MaxConcurrency.ValueInt64()), func(ctx context.Context, username string) (setUserResult, error) {
+		var result setUserResult
+		prev, hasPrev := prevUsers[username]
+		user, inTarget := target.Users[username]
+		if hasPrev && (!inTarget || !prev.AuthDatabaseName.Equal(user.AuthDatabaseName)) {
+			if err := deleteSetUser(ctx, client, projectID, username, prev.AuthDatabaseName.ValueString()); err != nil {
+				return result, err
+			}
+			result.deleted = true
+			hasPrev = false
+		}
+		if !inTarget {
+			return result, nil
+		}
+		var err error
+		if hasPrev {
+			result.user, _, err = client.UpdateDatabaseUser(ctx, projectID, user.AuthDatabaseName.ValueString(), username, NewAtlasSetUser(projectID, username, &user, &prev)).Execute()
+		} else {
+			result.user, _, err = client.CreateDatabaseUser(ctx, projectID, NewAtlasSetUser(projectID, username, &user, nil)).Execute()
+		}
+		return result, err
+	})
+
+	users := make(map[string]TFSetUserModel, len(prevUsers))
+	maps.Copy(users, prevUsers)
+	for username, result := range results {
+		if result.deleted {
+			delete(users, username)
+		}
+		if result.user != nil {
+			user := target.Users[username]
+			users[username] = NewTFSetUser(&user, result.user)
+		}
+	}
+	failed := slices.Sorted(maps.Keys(errs))
+	for _, username := range failed {
+		diags.AddError(fmt.Sprintf("%s: %s", summaries[username], username), errs[username].Error())
+	}
+	return &TFDatabaseUsersSetModel{
+		ProjectID:      target.ProjectID,
+		MaxConcurrency: target.MaxConcurrency,
+		Users:          users,
+	}
+}
+
+func deleteSetUser(ctx context.Context, client admin.DatabaseUsersApi, projectID, username, authDatabaseName string) error {
+	httpResp, err := client.DeleteDatabaseUser(ctx, projectID, authDatabaseName, username).Execute()
+	if err != nil && !validate.StatusNotFound(httpResp) {
+		return err
+	}
+	return nil
+}
+
+func listSetUsers(ctx context.Context, client admin.DatabaseUsersApi, projectID string) ([]admin.CloudDatabaseUser, *http.Response, error) {
+	var lastResp *http.Response
+	users, err := dsschema.AllPages(ctx, func(ctx context.Context, pageNum int) (dsschema.PaginateResponse[admin.CloudDatabaseUser], *http.Response, error) {
+		page, httpResp, err := client.ListDatabaseUsers(ctx, projectID).PageNum(pageNum).Execute()
+		lastResp = httpResp
+		return page, httpResp, err
+	})
+	return users, lastResp, err
+}
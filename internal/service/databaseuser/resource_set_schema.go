@@ -0,0 +1,176 @@
+package databaseuser
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const defaultSetMaxConcurrency = 10
+
+func ResourceSetSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Unique 24-hexadecimal digit string that identifies your project.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"max_concurrency": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(defaultSetMaxConcurrency),
+				MarkdownDescription: "Maximum number of database users that are created, updated or deleted at the same time. Defaults to `10`.",
+				Validators: []validator.Int64{
+					int64validator.Between(1, 50),
+				},
+			},
+			"users": schema.MapNestedAttribute{
+				Required:            true,
+				MarkdownDescription: "Database users managed by this resource, keyed by username.",
+				Validators: []validator.Map{
+					mapvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"auth_database_name": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "Database against which Atlas authenticates the user. Changing it deletes and creates the user again.",
+						},
+						"password": schema.StringAttribute{
+							Optional:            true,
+							Sensitive:           true,
+							MarkdownDescription: "User's password. It's only sent to Atlas when the user is created or the value changes. The password is stored in the Terraform state as plain-text.",
+							Validators: []validator.String{
+								stringvalidator.ConflictsWith(path.Expressions{
+									path.MatchRelative().AtParent().AtName("x509_type"),
+									path.MatchRelative().AtParent().AtName("ldap_auth_type"),
+									path.MatchRelative().AtParent().AtName("aws_iam_type"),
+								}...),
+							},
+						},
+						"description": schema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: "Description of the database user.",
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+						"x509_type": setAuthTypeAttribute("X.509 method by which the user is authenticated.", "NONE", "MANAGED", "CUSTOMER"),
+						"oidc_auth_type": setAuthTypeAttribute("Whether the user authenticates with OIDC federated authentication.",
+							"NONE", "IDP_GROUP", "USER"),
+						"ldap_auth_type": setAuthTypeAttribute("Method by which the user is authenticated with LDAP.", "NONE", "USER", "GROUP"),
+						"aws_iam_type":   setAuthTypeAttribute("Whether the user authenticates with AWS IAM credentials.", "NONE", "USER", "ROLE"),
+						"roles": schema.SetNestedAttribute{
+							Required:            true,
+							MarkdownDescription: "Roles granted to the user and the databases or collections on which they apply.",
+							Validators: []validator.Set{
+								setvalidator.SizeAtLeast(1),
+							},
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"role_name": schema.StringAttribute{
+										Required:            true,
+										MarkdownDescription: "Name of the role to grant.",
+									},
+									"database_name": schema.StringAttribute{
+										Required:            true,
+										MarkdownDescription: "Database on which the user has the role. Use `admin` for custom roles.",
+									},
+									"collection_name": schema.StringAttribute{
+										Optional:            true,
+										MarkdownDescription: "Collection on which the role applies.",
+										Validators: []validator.String{
+											stringvalidator.LengthAtLeast(1),
+										},
+									},
+								},
+							},
+						},
+						"scopes": schema.SetNestedAttribute{
+							Optional:            true,
+							MarkdownDescription: "Clusters and Atlas Data Lakes the user has access to. The user has access to all of them if not set.",
+							Validators: []validator.Set{
+								setvalidator.SizeAtLeast(1),
+							},
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"name": schema.StringAttribute{
+										Required:            true,
+										MarkdownDescription: "Name of the cluster or Atlas Data Lake.",
+									},
+									"type": schema.StringAttribute{
+										Required:            true,
+										MarkdownDescription: "Type of resource, `CLUSTER` or `DATA_LAKE`.",
+										Validators: []validator.String{
+											stringvalidator.OneOf("CLUSTER", "DATA_LAKE"),
+										},
+									},
+								},
+							},
+						},
+						"labels": schema.MapAttribute{
+							Optional:            true,
+							ElementType:         types.StringType,
+							MarkdownDescription: "Key-value pairs that tag and categorize the user.",
+							Validators: []validator.Map{
+								mapvalidator.SizeAtLeast(1),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func setAuthTypeAttribute(description string, values ...string) schema.StringAttribute {
+	return schema.StringAttribute{
+		Optional:            true,
+		Computed:            true,
+		Default:             stringdefault.StaticString(values[0]),
+		MarkdownDescription: description + " Defaults to `" + values[0] + "`.",
+		Validators: []validator.String{
+			stringvalidator.OneOf(values...),
+		},
+	}
+}
+
+type TFDatabaseUsersSetModel struct {
+	Users          map[string]TFSetUserModel `tfsdk:"users"`
+	ID             types.String              `tfsdk:"id"`
+	ProjectID      types.String              `tfsdk:"project_id"`
+	MaxConcurrency types.Int64               `tfsdk:"max_concurrency"`
+}
+
+type TFSetUserModel struct {
+	Labels           map[string]string `tfsdk:"labels"`
+	AuthDatabaseName types.String      `tfsdk:"auth_database_name"`
+	Password         types.String      `tfsdk:"password"`
+	Description      types.String      `tfsdk:"description"`
+	X509Type         types.String      `tfsdk:"x509_type"`
+	OIDCAuthType     types.String      `tfsdk:"oidc_auth_type"`
+	LDAPAuthType     types.String      `tfsdk:"ldap_auth_type"`
+	AWSIAMType       types.String      `tfsdk:"aws_iam_type"`
+	Roles            []TfRoleModel     `tfsdk:"roles"`
+	Scopes           []TfScopeModel    `tfsdk:"scopes"`
+}
@@ -0,0 +1,117 @@
+package databaseuser_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/testutil/acc"
+)
+
+const resourceSetName = "mongodbatlas_database_users_set.test"
+
+func TestAccDatabaseUsersSet_basic(t *testing.T) {
+	var (
+		orgID       = os.Getenv("MONGODB_ATLAS_ORG_ID")
+		projectName = acc.RandomProjectName()
+		user1       = acc.RandomName()
+		user2       = acc.RandomName()
+		user3       = acc.RandomName()
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acc.PreCheckBasic(t) },
+		ProtoV6ProviderFactories: acc.TestAccProviderV6Factories,
+		CheckDestroy:             acc.CheckDestroyProject,
+		Steps: []resource.TestStep{
+			{
+				Config: configSet(orgID, projectName, map[string]string{user1: "read", user2: "read"}),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceSetName, "users.%", "2"),
+					resource.TestCheckResourceAttr(resourceSetName, "max_concurrency", "2"),
+					resource.TestCheckResourceAttr(resourceSetName, fmt.Sprintf("users.%s.auth_database_name", user1), "admin"),
+					resource.TestCheckResourceAttr(resourceSetName, fmt.Sprintf("users.%s.x509_type", user1), "NONE"),
+					resource.TestCheckResourceAttr(resourceSetName, fmt.Sprintf("users.%s.labels.team", user1), "payments"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceSetName, fmt.Sprintf("users.%s.roles.*", user2), map[string]string{"role_name": "read", "database_name": "app"}),
+					checkSetUserExists(resourceSetName, user1, true),
+					checkSetUserExists(resourceSetName, user2, true),
+				),
+			},
+			{
+				Config: configSet(orgID, projectName, map[string]string{user1: "readWrite", user3: "read"}),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceSetName, "users.%", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceSetName, fmt.Sprintf("users.%s.roles.*", user1), map[string]string{"role_name": "readWrite", "database_name": "app"}),
+					checkSetUserExists(resourceSetName, user1, true),
+					checkSetUserExists(resourceSetName, user2, false),
+					checkSetUserExists(resourceSetName, user3, true),
+				),
+			},
+			{
+				ResourceName:      resourceSetName,
+				ImportStateIdFunc: acc.ImportStateProjectIDFunc(resourceSetName),
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"max_concurrency",
+					fmt.Sprintf("users.%s.password", user1),
+					fmt.Sprintf("users.%s.password", user3),
+				},
+			},
+		},
+	})
+}
+
+func configSet(orgID, projectName string, userRoles map[string]string) string {
+	var usersStr strings.Builder
+	for username, role := range userRoles {
+		usersStr.WriteString(fmt.Sprintf(`
+			%[1]q = {
+				auth_database_name = "admin"
+				password           = "test-acc-password"
+				roles = [{
+					role_name     = %[2]q
+					database_name = "app"
+				}]
+				labels = {
+					team = "payments"
+				}
+			}`, username, role))
+	}
+	return fmt.Sprintf(`
+		resource "mongodbatlas_project" "test" {
+			org_id = %[1]q
+			name   = %[2]q
+		}
+
+		resource "mongodbatlas_database_users_set" "test" {
+			project_id      = mongodbatlas_project.test.id
+			max_concurrency = 2
+			users = {
+				%[3]s
+			}
+		}
+	`, orgID, projectName, usersStr.String())
+}
+
+func checkSetUserExists(resourceName, username string, shouldExist bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+		_, _, err := acc.ConnV2().DatabaseUsersApi.GetDatabaseUser(context.Background(), rs.Primary.Attributes["project_id"], "admin", username).Execute()
+		if shouldExist && err != nil {
+			return fmt.Errorf("database user (%s) does not exist", username)
+		}
+		if !shouldExist && err == nil {
+			return fmt.Errorf("database user (%s) still exists", username)
+		}
+		return nil
+	}
+}
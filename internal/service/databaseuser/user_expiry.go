@@ -0,0 +1,141 @@
+package databaseuser
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"go.mongodb.org/atlas-sdk/v20250312003/admin"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/conversion"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/config"
+)
+
+// privateDeleteAfterDateConfigured is set in the private state when delete_after_date or ttl were set in the config of the last apply.
+const privateDeleteAfterDateConfigured = "delete_after_date_configured"
+
+// privateData is implemented by the private state of the framework requests and responses.
+type privateData interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
+// ResolveTTL returns the delete_after_date for a ttl duration starting at now.
+func ResolveTTL(ttl string, now time.Time) (string, bool) {
+	duration, err := time.ParseDuration(ttl)
+	if err != nil {
+		return "", false
+	}
+	return conversion.TimeToString(now.Add(duration).Truncate(time.Second)), true
+}
+
+// Expired returns true if deleteAfterDate is a valid timestamp that is not after now.
+func Expired(deleteAfterDate string, now time.Time) bool {
+	date, ok := conversion.StringToTime(deleteAfterDate)
+	return ok && !now.Before(date)
+}
+
+// PlannedDeleteAfterDate returns the planned delete_after_date when it's not set in the config: unknown when ttl must be resolved
+// during apply and the state value when ttl doesn't change. When ttl is not set either, it's null if configured is true, so the
+// expiration set by the previous config is removed, and the state value otherwise, so a date set outside Terraform, e.g. of an
+// imported user, is kept.
+func PlannedDeleteAfterDate(state, plan, config *TfDatabaseUserModel, configured bool) types.String {
+	switch {
+	case state == nil:
+		if config.TTL.IsNull() {
+			return types.StringNull()
+		}
+		return types.StringUnknown()
+	case !config.TTL.IsNull() && !state.TTL.Equal(plan.TTL):
+		return types.StringUnknown()
+	case config.TTL.IsNull() && configured:
+		return types.StringNull()
+	default:
+		return state.DeleteAfterDate
+	}
+}
+
+func deleteAfterDateConfigured(ctx context.Context, private privateData) (bool, diag.Diagnostics) {
+	value, diags := private.GetKey(ctx, privateDeleteAfterDateConfigured)
+	return string(value) == "true", diags
+}
+
+func setDeleteAfterDateConfigured(ctx context.Context, private privateData, config *TfDatabaseUserModel) diag.Diagnostics {
+	var value []byte // removes the key
+	if !config.DeleteAfterDate.IsNull() || !config.TTL.IsNull() {
+		value = []byte("true")
+	}
+	return private.SetKey(ctx, privateDeleteAfterDateConfigured, value)
+}
+
+// ClearsDeleteAfterDate returns true if the update must remove the expiration of the user in Atlas.
+func ClearsDeleteAfterDate(state, plan *TfDatabaseUserModel) bool {
+	return !state.DeleteAfterDate.IsNull() && plan.DeleteAfterDate.IsNull()
+}
+
+// NewUpdateBodyClearingDeleteAfterDate returns the update request of user with an explicit null deleteAfterDate, which the SDK can't
+// send as it omits nil values.
+func NewUpdateBodyClearingDeleteAfterDate(user *admin.CloudDatabaseUser) ([]byte, error) {
+	body, err := json.Marshal(user)
+	if err != nil {
+		return nil, err
+	}
+	var fields map[string]any
+	if err := json.Unmarshal(body, &fields); err != nil {
+		return nil, err
+	}
+	fields["deleteAfterDate"] = nil
+	return json.Marshal(fields)
+}
+
+func updateUserClearingDeleteAfterDate(ctx context.Context, client *config.MongoDBClient, user *admin.CloudDatabaseUser) (*admin.CloudDatabaseUser, error) {
+	body, err := NewUpdateBodyClearingDeleteAfterDate(user)
+	if err != nil {
+		return nil, err
+	}
+	apiResp, err := client.UntypedAPICall(ctx, &config.APICallParams{
+		VersionHeader: "application/vnd.atlas.2023-01-01+json",
+		RelativePath:  "/api/atlas/v2/groups/{groupId}/databaseUsers/{databaseName}/{username}",
+		PathParams:    map[string]string{"groupId": user.GroupId, "databaseName": user.DatabaseName, "username": user.Username},
+		Method:        http.MethodPatch,
+	}, body)
+	if err != nil {
+		return nil, err
+	}
+	defer apiResp.Body.Close()
+	respBody, err := io.ReadAll(apiResp.Body)
+	if err != nil {
+		return nil, err
+	}
+	result := new(admin.CloudDatabaseUser)
+	if err := json.Unmarshal(respBody, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// resolveDeleteAfterDate sets delete_after_date from ttl when it's planned to be resolved during apply.
+func resolveDeleteAfterDate(plan *TfDatabaseUserModel, now time.Time) {
+	if !plan.DeleteAfterDate.IsUnknown() {
+		return
+	}
+	plan.DeleteAfterDate = types.StringNull()
+	if date, ok := ResolveTTL(plan.TTL.ValueString(), now); ok {
+		plan.DeleteAfterDate = types.StringValue(date)
+	}
+}
+
+// newDeleteAfterDate returns the date returned by Atlas, keeping the planned value if it's the same instant in a different format.
+func newDeleteAfterDate(planned types.String, date *time.Time) types.String {
+	if date == nil {
+		return types.StringNull()
+	}
+	if plannedDate, ok := conversion.StringToTime(planned.ValueString()); ok && plannedDate.Equal(*date) {
+		return planned
+	}
+	return types.StringValue(conversion.TimeToString(*date))
+}
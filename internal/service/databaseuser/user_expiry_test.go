@@ -0,0 +1,149 @@
+package databaseuser_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/atlas-sdk/v20250312003/admin"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/databaseuser"
+)
+
+func TestResolveTTL(t *testing.T) {
+	now := time.Date(2025, 6, 30, 12, 0, 0, 123456789, time.UTC)
+	testCases := map[string]struct {
+		ttl      string
+		expected string
+		ok       bool
+	}{
+		"hours":           {ttl: "4h", expected: "2025-06-30T16:00:00Z", ok: true},
+		"hours and mins":  {ttl: "1h30m", expected: "2025-06-30T13:30:00Z", ok: true},
+		"one week":        {ttl: "168h", expected: "2025-07-07T12:00:00Z", ok: true},
+		"invalid":         {ttl: "2d"},
+		"empty":           {ttl: ""},
+		"unit is missing": {ttl: "30"},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			date, ok := databaseuser.ResolveTTL(tc.ttl, now)
+			assert.Equal(t, tc.ok, ok)
+			assert.Equal(t, tc.expected, date)
+		})
+	}
+}
+
+func TestExpired(t *testing.T) {
+	now := time.Date(2025, 6, 30, 12, 0, 0, 0, time.UTC)
+	assert.True(t, databaseuser.Expired("2025-06-30T11:59:59Z", now))
+	assert.True(t, databaseuser.Expired("2025-06-30T12:00:00Z", now))
+	assert.True(t, databaseuser.Expired("2025-06-30T13:00:00+02:00", now))
+	assert.False(t, databaseuser.Expired("2025-06-30T12:00:01Z", now))
+	assert.False(t, databaseuser.Expired("", now))
+	assert.False(t, databaseuser.Expired("invalid", now))
+}
+
+func TestDeleteAfterDate(t *testing.T) {
+	model := getDatabaseUserModel(rolesSet, labelsSet, scopesSet, types.StringNull())
+	model.DeleteAfterDate = types.StringValue("2025-07-01T14:00:00+02:00")
+	req, diags := databaseuser.NewMongoDBDatabaseUser(t.Context(), types.StringNull(), types.StringNull(), model)
+	require.False(t, diags.HasError())
+	require.NotNil(t, req.DeleteAfterDate)
+	assert.True(t, req.DeleteAfterDate.Equal(time.Date(2025, 7, 1, 12, 0, 0, 0, time.UTC)))
+
+	apiUser := *cloudDatabaseUser
+	apiUser.DeleteAfterDate = admin.PtrTime(time.Date(2025, 7, 1, 12, 0, 0, 0, time.UTC))
+	result, diags := databaseuser.NewTfDatabaseUserModel(t.Context(), model, &apiUser)
+	require.False(t, diags.HasError())
+	assert.Equal(t, "2025-07-01T14:00:00+02:00", result.DeleteAfterDate.ValueString(), "planned format kept for the same instant")
+
+	model.DeleteAfterDate = types.StringNull()
+	result, diags = databaseuser.NewTfDatabaseUserModel(t.Context(), model, &apiUser)
+	require.False(t, diags.HasError())
+	assert.Equal(t, "2025-07-01T12:00:00Z", result.DeleteAfterDate.ValueString())
+
+	apiUser.DeleteAfterDate = nil
+	result, diags = databaseuser.NewTfDatabaseUserModel(t.Context(), model, &apiUser)
+	require.False(t, diags.HasError())
+	assert.True(t, result.DeleteAfterDate.IsNull())
+}
+
+func TestPlannedDeleteAfterDate(t *testing.T) {
+	date := types.StringValue("2025-07-01T12:00:00Z")
+	testCases := map[string]struct {
+		state      *databaseuser.TfDatabaseUserModel
+		plan       *databaseuser.TfDatabaseUserModel
+		config     *databaseuser.TfDatabaseUserModel
+		expected   types.String
+		configured bool
+	}{
+		"create with ttl": {
+			plan:     &databaseuser.TfDatabaseUserModel{TTL: types.StringValue("1h")},
+			config:   &databaseuser.TfDatabaseUserModel{TTL: types.StringValue("1h")},
+			expected: types.StringUnknown(),
+		},
+		"ttl changed": {
+			state:    &databaseuser.TfDatabaseUserModel{TTL: types.StringValue("1h"), DeleteAfterDate: date},
+			plan:     &databaseuser.TfDatabaseUserModel{TTL: types.StringValue("2h")},
+			config:   &databaseuser.TfDatabaseUserModel{TTL: types.StringValue("2h")},
+			expected: types.StringUnknown(),
+		},
+		"ttl unchanged": {
+			state:    &databaseuser.TfDatabaseUserModel{TTL: types.StringValue("1h"), DeleteAfterDate: date},
+			plan:     &databaseuser.TfDatabaseUserModel{TTL: types.StringValue("1h")},
+			config:   &databaseuser.TfDatabaseUserModel{TTL: types.StringValue("1h")},
+			expected: date,
+		},
+		"ttl removed": {
+			state:      &databaseuser.TfDatabaseUserModel{TTL: types.StringValue("1h"), DeleteAfterDate: date},
+			plan:       &databaseuser.TfDatabaseUserModel{},
+			config:     &databaseuser.TfDatabaseUserModel{},
+			configured: true,
+			expected:   types.StringNull(),
+		},
+		"delete_after_date removed": {
+			state:      &databaseuser.TfDatabaseUserModel{DeleteAfterDate: date},
+			plan:       &databaseuser.TfDatabaseUserModel{},
+			config:     &databaseuser.TfDatabaseUserModel{},
+			configured: true,
+			expected:   types.StringNull(),
+		},
+		"imported user keeps the date": {
+			state:    &databaseuser.TfDatabaseUserModel{DeleteAfterDate: date},
+			plan:     &databaseuser.TfDatabaseUserModel{},
+			config:   &databaseuser.TfDatabaseUserModel{},
+			expected: date,
+		},
+		"create without expiration": {
+			plan:     &databaseuser.TfDatabaseUserModel{},
+			config:   &databaseuser.TfDatabaseUserModel{},
+			expected: types.StringNull(),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, databaseuser.PlannedDeleteAfterDate(tc.state, tc.plan, tc.config, tc.configured))
+		})
+	}
+}
+
+func TestClearsDeleteAfterDate(t *testing.T) {
+	date := types.StringValue("2025-07-01T12:00:00Z")
+	assert.True(t, databaseuser.ClearsDeleteAfterDate(&databaseuser.TfDatabaseUserModel{DeleteAfterDate: date}, &databaseuser.TfDatabaseUserModel{}))
+	assert.False(t, databaseuser.ClearsDeleteAfterDate(&databaseuser.TfDatabaseUserModel{DeleteAfterDate: date}, &databaseuser.TfDatabaseUserModel{DeleteAfterDate: date}))
+	assert.False(t, databaseuser.ClearsDeleteAfterDate(&databaseuser.TfDatabaseUserModel{}, &databaseuser.TfDatabaseUserModel{}))
+}
+
+func TestNewUpdateBodyClearingDeleteAfterDate(t *testing.T) {
+	user := &admin.CloudDatabaseUser{
+		GroupId:      "projectID",
+		Username:     "user",
+		DatabaseName: "admin",
+		Roles:        &[]admin.DatabaseUserRole{{RoleName: "read", DatabaseName: "admin"}},
+	}
+	body, err := databaseuser.NewUpdateBodyClearingDeleteAfterDate(user)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"groupId":"projectID","username":"user","databaseName":"admin","deleteAfterDate":null,"roles":[{"roleName":"read","databaseName":"admin"}]}`, string(body))
+}
@@ -0,0 +1,56 @@
+package databaseusereffectiveprivileges
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/conversion"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/config"
+)
+
+const (
+	dataSourceName = "database_user_effective_privileges"
+	errorRead      = "error reading data source mongodbatlas_" + dataSourceName
+)
+
+var _ datasource.DataSource = &ds{}
+var _ datasource.DataSourceWithConfigure = &ds{}
+
+func DataSource() datasource.DataSource {
+	return &ds{
+		DSCommon: config.DSCommon{
+			DataSourceName: dataSourceName,
+		},
+	}
+}
+
+type ds struct {
+	config.DSCommon
+}
+
+func (d *ds) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = DataSourceSchema()
+	conversion.UpdateSchemaDescription(&resp.Schema)
+}
+
+func (d *ds) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var tfModel TFModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &tfModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	connV2 := d.Client.AtlasV2
+	projectID := tfModel.ProjectID.ValueString()
+	user, _, err := connV2.DatabaseUsersApi.GetDatabaseUser(ctx, projectID, tfModel.AuthDatabaseName.ValueString(), tfModel.Username.ValueString()).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(errorRead, err.Error())
+		return
+	}
+	customRoles, _, err := connV2.CustomDatabaseRolesApi.ListCustomDatabaseRoles(ctx, projectID).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(errorRead, "error listing custom db roles: "+err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, NewTFModel(&tfModel, user, customRoles))...)
+}
@@ -0,0 +1,118 @@
+package databaseusereffectiveprivileges
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func DataSourceSchema() schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Unique 24-hexadecimal digit string that identifies your project.",
+			},
+			"auth_database_name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Database against which the user authenticates, `admin` for SCRAM users and `$external` for X.509, LDAP, OIDC and AWS IAM users.",
+			},
+			"username": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Username of the database user.",
+			},
+			"cluster_name": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Name of the cluster where the privileges are checked. If the user `scopes` don't include the cluster, `has_access` is `false` and `privileges` is empty.",
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("data_lake_name")),
+				},
+			},
+			"data_lake_name": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Name of the data lake or federated database instance where the privileges are checked. If the user `scopes` don't include the data lake, `has_access` is `false` and `privileges` is empty.",
+			},
+			"has_access": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Flag that indicates whether the user can access `cluster_name` or `data_lake_name`. Users without `scopes` can access all the clusters and data lakes in the project. It's always `true` if neither `cluster_name` nor `data_lake_name` is set.",
+			},
+			"scopes": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Clusters and data lakes the user is restricted to. If empty, the privileges apply to all the clusters and data lakes in the project.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Name of the cluster or data lake.",
+						},
+						"type": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Type of resource, `CLUSTER` or `DATA_LAKE`.",
+						},
+					},
+				},
+			},
+			"privileges": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Privileges granted to the user by its built-in roles, custom roles and the roles they inherit, sorted by action. Each privilege appears once.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"action": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Privilege action, using the names of custom role actions, e.g. `FIND`.",
+						},
+						"database_name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Database on which the action is granted. Empty if the action is granted on all databases or on the cluster.",
+						},
+						"collection_name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Collection on which the action is granted. Empty if the action is granted on all collections of the database or on the cluster.",
+						},
+						"cluster": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Flag that indicates whether the action is granted on the cluster resource instead of a namespace.",
+						},
+						"granted_by": schema.ListAttribute{
+							ElementType:         types.StringType,
+							Computed:            true,
+							MarkdownDescription: "Roles of the user that grant the privilege, in `role@database` format.",
+						},
+					},
+				},
+			},
+			"unresolved_roles": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Computed:            true,
+				MarkdownDescription: "Roles, in `role@database` format, that are neither built-in roles known by the provider nor custom roles of the project. Their privileges are not included in `privileges`.",
+			},
+		},
+	}
+}
+
+type TFModel struct {
+	ProjectID        types.String       `tfsdk:"project_id"`
+	AuthDatabaseName types.String       `tfsdk:"auth_database_name"`
+	Username         types.String       `tfsdk:"username"`
+	ClusterName      types.String       `tfsdk:"cluster_name"`
+	DataLakeName     types.String       `tfsdk:"data_lake_name"`
+	HasAccess        types.Bool         `tfsdk:"has_access"`
+	Scopes           []TFScopeModel     `tfsdk:"scopes"`
+	Privileges       []TFPrivilegeModel `tfsdk:"privileges"`
+	UnresolvedRoles  []types.String     `tfsdk:"unresolved_roles"`
+}
+
+type TFScopeModel struct {
+	Name types.String `tfsdk:"name"`
+	Type types.String `tfsdk:"type"`
+}
+
+type TFPrivilegeModel struct {
+	Action         types.String   `tfsdk:"action"`
+	DatabaseName   types.String   `tfsdk:"database_name"`
+	CollectionName types.String   `tfsdk:"collection_name"`
+	Cluster        types.Bool     `tfsdk:"cluster"`
+	GrantedBy      []types.String `tfsdk:"granted_by"`
+}
@@ -0,0 +1,99 @@
+package databaseusereffectiveprivileges_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/testutil/acc"
+)
+
+const dataSourceName = "data.mongodbatlas_database_user_effective_privileges.test"
+
+func TestAccDatabaseUserEffectivePrivilegesDS_basic(t *testing.T) {
+	var (
+		projectID = acc.ProjectIDExecution(t)
+		roleName  = acc.RandomName()
+		username  = acc.RandomName()
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acc.PreCheckBasic(t) },
+		ProtoV6ProviderFactories: acc.TestAccProviderV6Factories,
+		Steps: []resource.TestStep{
+			{
+				Config: configBasic(projectID, roleName, username, "cluster1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "has_access", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "scopes.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "unresolved_roles.#", "0"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "privileges.*", map[string]string{
+						"action":          "INSERT",
+						"database_name":   "sales",
+						"collection_name": "orders",
+						"cluster":         "false",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "privileges.*", map[string]string{
+						"action":        "FIND",
+						"database_name": "audit",
+						"granted_by.0":  "read@audit",
+					}),
+				),
+			},
+			{
+				Config: configBasic(projectID, roleName, username, "cluster2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "has_access", "false"),
+					resource.TestCheckResourceAttr(dataSourceName, "privileges.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func configBasic(projectID, roleName, username, clusterName string) string {
+	return fmt.Sprintf(`
+resource "mongodbatlas_custom_db_role" "test" {
+  project_id = %[1]q
+  role_name  = %[2]q
+
+  actions {
+    action = "INSERT"
+    resources {
+      database_name   = "sales"
+      collection_name = "orders"
+    }
+  }
+}
+
+resource "mongodbatlas_database_user" "test" {
+  project_id         = %[1]q
+  username           = %[3]q
+  password           = "test-acc-password"
+  auth_database_name = "admin"
+
+  roles {
+    role_name     = mongodbatlas_custom_db_role.test.role_name
+    database_name = "admin"
+  }
+
+  roles {
+    role_name     = "read"
+    database_name = "audit"
+  }
+
+  scopes {
+    name = "cluster1"
+    type = "CLUSTER"
+  }
+}
+
+data "mongodbatlas_database_user_effective_privileges" "test" {
+  project_id         = mongodbatlas_database_user.test.project_id
+  auth_database_name = mongodbatlas_database_user.test.auth_database_name
+  username           = mongodbatlas_database_user.test.username
+  cluster_name       = %[4]q
+}
+`, projectID, roleName, username, clusterName)
+}
@@ -0,0 +1,240 @@
+package databaseusereffectiveprivileges
+
+import (
+	_ "embed" // used to embed builtin_roles.json
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+
+	"go.mongodb.org/atlas-sdk/v20250312003/admin"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/dbrole"
+)
+
+// builtin_roles.json lists the privileges of the built-in roles that Atlas database users can be granted, using the privilege action
+// names of custom roles. DATABASE privileges apply to the database and collection the role is granted on, or to all databases for
+// anyDatabase roles. Inherited roles are granted on the same namespace.
+//
+//go:embed builtin_roles.json
+var builtinRolesJSON []byte
+
+var builtinRoles = mustLoadBuiltinRoles()
+
+const (
+	scopeCluster = "CLUSTER"
+	// customRoleDB is the database where custom roles are granted.
+	customRoleDB = "admin"
+)
+
+type builtinRole struct {
+	Inherits   []string `json:"inherits"`
+	Privileges []struct {
+		Scope   string   `json:"scope"`
+		Actions []string `json:"actions"`
+	} `json:"privileges"`
+	AnyDatabase bool `json:"anyDatabase"`
+}
+
+func mustLoadBuiltinRoles() map[string]builtinRole {
+	var roles map[string]builtinRole
+	if err := json.Unmarshal(builtinRolesJSON, &roles); err != nil {
+		panic(fmt.Sprintf("invalid built-in roles catalog: %s", err))
+	}
+	return roles
+}
+
+// BuiltinRoles returns the built-in role names known by the catalog, sorted.
+func BuiltinRoles() []string {
+	names := make([]string, 0, len(builtinRoles))
+	for name := range builtinRoles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Privilege is an action a user can run on a namespace or on the cluster. An empty database means all databases and an empty
+// collection all collections in the database.
+type Privilege struct {
+	Action     string
+	Database   string
+	Collection string
+	GrantedBy  []string
+	Cluster    bool
+}
+
+type privilegeKey struct {
+	action     string
+	database   string
+	collection string
+	cluster    bool
+}
+
+type resolver struct {
+	customRoles map[string]*admin.UserCustomDBRole
+	privileges  map[privilegeKey]*Privilege
+	unresolved  []string
+}
+
+// ResolvePrivileges expands the roles of a database user into the privileges they grant, following the actions and inherited roles
+// of custom roles. GrantedBy contains the user roles, as role@database, that grant each privilege. Roles that are neither built-in
+// nor found in customRoles are returned as unresolved.
+func ResolvePrivileges(roles []admin.DatabaseUserRole, customRoles []admin.UserCustomDBRole) (privileges []Privilege, unresolved []string) {
+	r := &resolver{
+		customRoles: make(map[string]*admin.UserCustomDBRole, len(customRoles)),
+		privileges:  make(map[privilegeKey]*Privilege),
+	}
+	for i := range customRoles {
+		r.customRoles[customRoles[i].RoleName] = &customRoles[i]
+	}
+	for _, role := range roles {
+		grantedBy := roleID(role.RoleName, role.DatabaseName)
+		r.expandRole(grantedBy, role.RoleName, role.DatabaseName, role.GetCollectionName(), make(map[string]bool))
+	}
+
+	privileges = make([]Privilege, 0, len(r.privileges))
+	for _, p := range r.privileges {
+		sort.Strings(p.GrantedBy)
+		privileges = append(privileges, *p)
+	}
+	sort.Slice(privileges, func(i, j int) bool {
+		a, b := privileges[i], privileges[j]
+		if a.Action != b.Action {
+			return a.Action < b.Action
+		}
+		if a.Cluster != b.Cluster {
+			return a.Cluster
+		}
+		if a.Database != b.Database {
+			return a.Database < b.Database
+		}
+		return a.Collection < b.Collection
+	})
+	sort.Strings(r.unresolved)
+	return privileges, r.unresolved
+}
+
+// expandRole grants the privileges of a role granted on a database and optionally a collection. visited avoids expanding the same
+// custom role twice when inherited roles form a cycle.
+func (r *resolver) expandRole(grantedBy, roleName, database, collection string, visited map[string]bool) {
+	if custom, found := r.customRoles[roleName]; found && database == customRoleDB {
+		if visited[roleName] {
+			return
+		}
+		visited[roleName] = true
+		for _, action := range custom.GetActions() {
+			for _, res := range action.GetResources() {
+				r.grant(grantedBy, action.Action, dbrole.ActionResource{Database: res.Db, Collection: res.Collection, Cluster: res.Cluster})
+			}
+		}
+		for _, inherited := range custom.GetInheritedRoles() {
+			r.expandRole(grantedBy, inherited.Role, inherited.Db, "", visited)
+		}
+		return
+	}
+	role, found := builtinRoles[roleName]
+	if !found {
+		if id := roleID(roleName, database); !slices.Contains(r.unresolved, id) {
+			r.unresolved = append(r.unresolved, id)
+		}
+		return
+	}
+	if role.AnyDatabase {
+		database, collection = "", ""
+	}
+	for _, privilege := range role.Privileges {
+		for _, action := range privilege.Actions {
+			res := dbrole.ActionResource{Database: database, Collection: collection}
+			if privilege.Scope == scopeCluster {
+				res = dbrole.ActionResource{Cluster: true}
+			} else if collection != "" && len(dbrole.CheckActionScopes(action, []dbrole.ActionResource{res})) > 0 {
+				continue // database actions are not granted when the role is restricted to a collection
+			}
+			r.grant(grantedBy, action, res)
+		}
+	}
+	for _, inherited := range role.Inherits {
+		r.expandRole(grantedBy, inherited, database, collection, visited)
+	}
+}
+
+func (r *resolver) grant(grantedBy, action string, res dbrole.ActionResource) {
+	key := privilegeKey{action: action, database: res.Database, collection: res.Collection, cluster: res.Cluster}
+	if res.Cluster {
+		key.database, key.collection = "", ""
+	}
+	p, found := r.privileges[key]
+	if !found {
+		p = &Privilege{Action: key.action, Database: key.database, Collection: key.collection, Cluster: key.cluster}
+		r.privileges[key] = p
+	}
+	if !slices.Contains(p.GrantedBy, grantedBy) {
+		p.GrantedBy = append(p.GrantedBy, grantedBy)
+	}
+}
+
+// HasAccess returns false if the user is restricted to some clusters or data lakes with scopes and the given cluster or data lake is
+// not one of them. Users without scopes have access to all the resources in the project.
+func HasAccess(scopes []admin.UserScope, clusterName, dataLakeName string) bool {
+	if len(scopes) == 0 {
+		return true
+	}
+	for _, scope := range scopes {
+		if clusterName != "" && scope.Type == "CLUSTER" && scope.Name == clusterName {
+			return true
+		}
+		if dataLakeName != "" && scope.Type == "DATA_LAKE" && scope.Name == dataLakeName {
+			return true
+		}
+	}
+	return clusterName == "" && dataLakeName == ""
+}
+
+func roleID(roleName, database string) string {
+	return roleName + "@" + database
+}
+
+func NewTFModel(config *TFModel, user *admin.CloudDatabaseUser, customRoles []admin.UserCustomDBRole) *TFModel {
+	scopes := make([]TFScopeModel, len(user.GetScopes()))
+	for i, scope := range user.GetScopes() {
+		scopes[i] = TFScopeModel{Name: types.StringValue(scope.Name), Type: types.StringValue(scope.Type)}
+	}
+	hasAccess := HasAccess(user.GetScopes(), config.ClusterName.ValueString(), config.DataLakeName.ValueString())
+	privileges, unresolved := ResolvePrivileges(user.GetRoles(), customRoles)
+	if !hasAccess {
+		privileges = nil
+	}
+	tfPrivileges := make([]TFPrivilegeModel, len(privileges))
+	for i := range privileges {
+		p := &privileges[i]
+		grantedBy := make([]types.String, len(p.GrantedBy))
+		for j, role := range p.GrantedBy {
+			grantedBy[j] = types.StringValue(role)
+		}
+		tfPrivileges[i] = TFPrivilegeModel{
+			Action:         types.StringValue(p.Action),
+			DatabaseName:   types.StringValue(p.Database),
+			CollectionName: types.StringValue(p.Collection),
+			Cluster:        types.BoolValue(p.Cluster),
+			GrantedBy:      grantedBy,
+		}
+	}
+	unresolvedRoles := make([]types.String, len(unresolved))
+	for i, role := range unresolved {
+		unresolvedRoles[i] = types.StringValue(role)
+	}
+	return &TFModel{
+		ProjectID:        config.ProjectID,
+		AuthDatabaseName: config.AuthDatabaseName,
+		Username:         config.Username,
+		ClusterName:      config.ClusterName,
+		DataLakeName:     config.DataLakeName,
+		HasAccess:        types.BoolValue(hasAccess),
+		Scopes:           scopes,
+		Privileges:       tfPrivileges,
+		UnresolvedRoles:  unresolvedRoles,
+	}
+}
@@ -0,0 +1,207 @@
+package databaseusereffectiveprivileges_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/atlas-sdk/v20250312003/admin"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/dbrole"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/databaseusereffectiveprivileges"
+)
+
+var customRoles = []admin.UserCustomDBRole{
+	{
+		RoleName: "orderWriter",
+		Actions: &[]admin.DatabasePrivilegeAction{
+			{Action: "INSERT", Resources: &[]admin.DatabasePermittedNamespaceResource{{Db: "sales", Collection: "orders"}}},
+			{Action: "SERVER_STATUS", Resources: &[]admin.DatabasePermittedNamespaceResource{{Cluster: true}}},
+		},
+		InheritedRoles: &[]admin.DatabaseInheritedRole{{Db: "admin", Role: "auditor"}},
+	},
+	{
+		RoleName: "auditor",
+		Actions: &[]admin.DatabasePrivilegeAction{
+			{Action: "FIND", Resources: &[]admin.DatabasePermittedNamespaceResource{{Db: "audit"}}},
+		},
+		InheritedRoles: &[]admin.DatabaseInheritedRole{{Db: "admin", Role: "orderWriter"}, {Db: "admin", Role: "missingRole"}},
+	},
+}
+
+func TestResolvePrivileges(t *testing.T) {
+	testCases := map[string]struct {
+		privileges map[string][]string // action@database/collection or action@cluster to granted_by
+		roles      []admin.DatabaseUserRole
+		unresolved []string
+	}{
+		"built-in role on database": {
+			roles: []admin.DatabaseUserRole{{RoleName: "read", DatabaseName: "sales"}},
+			privileges: map[string][]string{
+				"FIND@sales/":                {"read@sales"},
+				"LIST_COLLECTIONS@sales/":    {"read@sales"},
+				"LIST_INDEXES@sales/":        {"read@sales"},
+				"LIST_SEARCH_INDEXES@sales/": {"read@sales"},
+				"COLL_STATS@sales/":          {"read@sales"},
+				"DB_STATS@sales/":            {"read@sales"},
+				"DB_HASH@sales/":             {"read@sales"},
+				"CHANGE_STREAM@sales/":       {"read@sales"},
+			},
+		},
+		"built-in role on collection skips database actions": {
+			roles: []admin.DatabaseUserRole{{RoleName: "read", DatabaseName: "sales", CollectionName: admin.PtrString("orders")}},
+			privileges: map[string][]string{
+				"FIND@sales/orders":                {"read@sales"},
+				"LIST_INDEXES@sales/orders":        {"read@sales"},
+				"LIST_SEARCH_INDEXES@sales/orders": {"read@sales"},
+				"COLL_STATS@sales/orders":          {"read@sales"},
+				"DB_HASH@sales/orders":             {"read@sales"},
+				"CHANGE_STREAM@sales/orders":       {"read@sales"},
+			},
+		},
+		"any database role": {
+			roles: []admin.DatabaseUserRole{{RoleName: "readAnyDatabase", DatabaseName: "admin"}},
+			privileges: map[string][]string{
+				"LIST_DATABASES@cluster": {"readAnyDatabase@admin"},
+				"FIND@/":                 {"readAnyDatabase@admin"},
+				"LIST_COLLECTIONS@/":     {"readAnyDatabase@admin"},
+				"LIST_INDEXES@/":         {"readAnyDatabase@admin"},
+				"LIST_SEARCH_INDEXES@/":  {"readAnyDatabase@admin"},
+				"COLL_STATS@/":           {"readAnyDatabase@admin"},
+				"DB_STATS@/":             {"readAnyDatabase@admin"},
+				"DB_HASH@/":              {"readAnyDatabase@admin"},
+				"CHANGE_STREAM@/":        {"readAnyDatabase@admin"},
+			},
+		},
+		"custom roles with inheritance cycle and unknown role": {
+			roles: []admin.DatabaseUserRole{{RoleName: "orderWriter", DatabaseName: "admin"}},
+			privileges: map[string][]string{
+				"INSERT@sales/orders":   {"orderWriter@admin"},
+				"SERVER_STATUS@cluster": {"orderWriter@admin"},
+				"FIND@audit/":           {"orderWriter@admin"},
+			},
+			unresolved: []string{"missingRole@admin"},
+		},
+		"same privilege from several roles": {
+			roles: []admin.DatabaseUserRole{{RoleName: "read", DatabaseName: "audit"}, {RoleName: "auditor", DatabaseName: "admin"}},
+			privileges: map[string][]string{
+				"FIND@audit/":                {"auditor@admin", "read@audit"},
+				"LIST_COLLECTIONS@audit/":    {"read@audit"},
+				"LIST_INDEXES@audit/":        {"read@audit"},
+				"LIST_SEARCH_INDEXES@audit/": {"read@audit"},
+				"COLL_STATS@audit/":          {"read@audit"},
+				"DB_STATS@audit/":            {"read@audit"},
+				"DB_HASH@audit/":             {"read@audit"},
+				"CHANGE_STREAM@audit/":       {"read@audit"},
+				"SERVER_STATUS@cluster":      {"auditor@admin"},
+				"INSERT@sales/orders":        {"auditor@admin"},
+			},
+			unresolved: []string{"missingRole@admin"},
+		},
+		"custom role name on other database": {
+			roles:      []admin.DatabaseUserRole{{RoleName: "auditor", DatabaseName: "sales"}},
+			privileges: map[string][]string{},
+			unresolved: []string{"auditor@sales"},
+		},
+		"no roles": {
+			privileges: map[string][]string{},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			privileges, unresolved := databaseusereffectiveprivileges.ResolvePrivileges(tc.roles, customRoles)
+			assert.Equal(t, tc.unresolved, unresolved)
+			result := make(map[string][]string)
+			for _, p := range privileges {
+				result[privilegeID(&p)] = p.GrantedBy
+			}
+			assert.Equal(t, tc.privileges, result)
+		})
+	}
+}
+
+func TestResolvePrivilegesSorted(t *testing.T) {
+	privileges, _ := databaseusereffectiveprivileges.ResolvePrivileges([]admin.DatabaseUserRole{{RoleName: "atlasAdmin", DatabaseName: "admin"}}, nil)
+	require.NotEmpty(t, privileges)
+	for i := 1; i < len(privileges); i++ {
+		assert.LessOrEqual(t, privileges[i-1].Action, privileges[i].Action)
+	}
+	assert.Equal(t, []string{"atlasAdmin@admin"}, grantedBy(privileges, "DROP_DATABASE@/"))
+	assert.Equal(t, []string{"atlasAdmin@admin"}, grantedBy(privileges, "KILL_OP@cluster"))
+
+	privileges, _ = databaseusereffectiveprivileges.ResolvePrivileges([]admin.DatabaseUserRole{{RoleName: "read", DatabaseName: "audit"}, {RoleName: "dbAdmin", DatabaseName: "audit"}}, nil)
+	assert.Equal(t, []string{"dbAdmin@audit", "read@audit"}, grantedBy(privileges, "LIST_COLLECTIONS@audit/"))
+}
+
+func TestBuiltinRolesUseKnownActions(t *testing.T) {
+	known := dbrole.PrivilegeActions()
+	for _, role := range databaseusereffectiveprivileges.BuiltinRoles() {
+		privileges, unresolved := databaseusereffectiveprivileges.ResolvePrivileges([]admin.DatabaseUserRole{{RoleName: role, DatabaseName: "admin"}}, nil)
+		assert.Empty(t, unresolved, "role %s inherits unknown roles", role)
+		for _, p := range privileges {
+			assert.Contains(t, known, p.Action, "role %s", role)
+		}
+	}
+}
+
+func TestHasAccess(t *testing.T) {
+	scopes := []admin.UserScope{{Name: "cluster1", Type: "CLUSTER"}, {Name: "lake1", Type: "DATA_LAKE"}}
+	testCases := map[string]struct {
+		scopes       []admin.UserScope
+		clusterName  string
+		dataLakeName string
+		expected     bool
+	}{
+		"no scopes":                 {clusterName: "any", expected: true},
+		"no resource":               {scopes: scopes, expected: true},
+		"cluster in scopes":         {scopes: scopes, clusterName: "cluster1", expected: true},
+		"cluster not in scopes":     {scopes: scopes, clusterName: "cluster2"},
+		"data lake in scopes":       {scopes: scopes, dataLakeName: "lake1", expected: true},
+		"data lake name as cluster": {scopes: scopes, clusterName: "lake1"},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, databaseusereffectiveprivileges.HasAccess(tc.scopes, tc.clusterName, tc.dataLakeName))
+		})
+	}
+}
+
+func TestNewTFModelWithoutAccess(t *testing.T) {
+	config := &databaseusereffectiveprivileges.TFModel{
+		ProjectID:        types.StringValue("111111111111111111111111"),
+		AuthDatabaseName: types.StringValue("admin"),
+		Username:         types.StringValue("user"),
+		ClusterName:      types.StringValue("cluster2"),
+		DataLakeName:     types.StringNull(),
+	}
+	user := &admin.CloudDatabaseUser{
+		Roles:  &[]admin.DatabaseUserRole{{RoleName: "read", DatabaseName: "sales"}},
+		Scopes: &[]admin.UserScope{{Name: "cluster1", Type: "CLUSTER"}},
+	}
+	model := databaseusereffectiveprivileges.NewTFModel(config, user, nil)
+	assert.False(t, model.HasAccess.ValueBool())
+	assert.Empty(t, model.Privileges)
+	assert.Equal(t, []databaseusereffectiveprivileges.TFScopeModel{{Name: types.StringValue("cluster1"), Type: types.StringValue("CLUSTER")}}, model.Scopes)
+
+	config.ClusterName = types.StringValue("cluster1")
+	model = databaseusereffectiveprivileges.NewTFModel(config, user, nil)
+	assert.True(t, model.HasAccess.ValueBool())
+	assert.Len(t, model.Privileges, 8)
+}
+
+func privilegeID(p *databaseusereffectiveprivileges.Privilege) string {
+	if p.Cluster {
+		return p.Action + "@cluster"
+	}
+	return p.Action + "@" + p.Database + "/" + p.Collection
+}
+
+func grantedBy(privileges []databaseusereffectiveprivileges.Privilege, id string) []string {
+	for i := range privileges {
+		if privilegeID(&privileges[i]) == id {
+			return privileges[i].GrantedBy
+		}
+	}
+	return nil
+}
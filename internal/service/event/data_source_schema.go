@@ -0,0 +1,164 @@
+package event
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/validate"
+)
+
+func PluralDataSourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Unique 24-hexadecimal digit string that identifies the project whose events to return. Exactly one of `project_id` or `org_id` must be set.",
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("org_id")),
+				},
+			},
+			"org_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Unique 24-hexadecimal digit string that identifies the organization whose events to return. Exactly one of `project_id` or `org_id` must be set.",
+			},
+			"event_types": schema.SetAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "Event type names of the events to return, for example `CLUSTER_CREATED`. Omit to return events of any type.",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"min_date": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Date and time from when to return events, in RFC3339 format, for example `2025-01-02T15:04:05Z`.",
+				Validators: []validator.String{
+					validate.StringIsTimestamp(),
+				},
+			},
+			"max_date": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Date and time until when to return events, in RFC3339 format, for example `2025-01-02T15:04:05Z`.",
+				Validators: []validator.String{
+					validate.StringIsTimestamp(),
+				},
+			},
+			"results": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "List of events matching the filters.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"event_id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Unique 24-hexadecimal digit string that identifies the event.",
+						},
+						"event_type_name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Unique identifier of event type.",
+						},
+						"created": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Date and time when this event occurred. This parameter expresses its value in the ISO 8601 timestamp format in UTC.",
+						},
+						"project_id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Unique 24-hexadecimal digit string that identifies the project in which the event occurred.",
+						},
+						"org_id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Unique 24-hexadecimal digit string that identifies the organization to which the event applies.",
+						},
+						"user_id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Unique 24-hexadecimal digit string that identifies the console user who triggered the event.",
+						},
+						"username": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Email address for the user who triggered this event.",
+						},
+						"api_key_id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Unique 24-hexadecimal digit string that identifies the API Key that triggered the event.",
+						},
+						"public_key": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Public part of the API key that triggered the event.",
+						},
+						"remote_address": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "IPv4 or IPv6 address from which the user triggered this event.",
+						},
+						"is_global_admin": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Flag that indicates whether a MongoDB employee triggered the specified event.",
+						},
+						"alert_id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Unique 24-hexadecimal digit string that identifies the alert associated with the event.",
+						},
+						"alert_config_id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Unique 24-hexadecimal digit string that identifies the alert configuration associated with the `alert_id`.",
+						},
+						"target_username": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Email address for the console user that this event targets.",
+						},
+						"resource_id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Unique 24-hexadecimal digit string that identifies the resource associated with the event.",
+						},
+						"resource_type": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Unique identifier of resource type.",
+						},
+						"replica_set_name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Human-readable label of the replica set associated with the event. Only returned for project events.",
+						},
+						"db_user_username": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The username of the MongoDB User that was created, deleted, or edited.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type TFEventsDSModel struct {
+	ProjectID  types.String   `tfsdk:"project_id"`
+	OrgID      types.String   `tfsdk:"org_id"`
+	EventTypes types.Set      `tfsdk:"event_types"`
+	MinDate    types.String   `tfsdk:"min_date"`
+	MaxDate    types.String   `tfsdk:"max_date"`
+	Results    []TFEventModel `tfsdk:"results"`
+}
+
+type TFEventModel struct {
+	EventID        types.String `tfsdk:"event_id"`
+	EventTypeName  types.String `tfsdk:"event_type_name"`
+	Created        types.String `tfsdk:"created"`
+	ProjectID      types.String `tfsdk:"project_id"`
+	OrgID          types.String `tfsdk:"org_id"`
+	UserID         types.String `tfsdk:"user_id"`
+	Username       types.String `tfsdk:"username"`
+	APIKeyID       types.String `tfsdk:"api_key_id"`
+	PublicKey      types.String `tfsdk:"public_key"`
+	RemoteAddress  types.String `tfsdk:"remote_address"`
+	AlertID        types.String `tfsdk:"alert_id"`
+	AlertConfigID  types.String `tfsdk:"alert_config_id"`
+	TargetUsername types.String `tfsdk:"target_username"`
+	ResourceID     types.String `tfsdk:"resource_id"`
+	ResourceType   types.String `tfsdk:"resource_type"`
+	ReplicaSetName types.String `tfsdk:"replica_set_name"`
+	DBUserUsername types.String `tfsdk:"db_user_username"`
+	IsGlobalAdmin  types.Bool   `tfsdk:"is_global_admin"`
+}
@@ -0,0 +1,105 @@
+package event
+
+import (
+	"context"
+
+	"go.mongodb.org/atlas-sdk/v20250312003/admin"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/conversion"
+)
+
+// ValidateDateRange checks that min_date is not after max_date. Invalid timestamps are reported by the attribute validators.
+func ValidateDateRange(tfModel *TFEventsDSModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if tfModel.MinDate.IsNull() || tfModel.MinDate.IsUnknown() || tfModel.MaxDate.IsNull() || tfModel.MaxDate.IsUnknown() {
+		return diags
+	}
+	minDate, okMin := conversion.StringToTime(tfModel.MinDate.ValueString())
+	maxDate, okMax := conversion.StringToTime(tfModel.MaxDate.ValueString())
+	if okMin && okMax && minDate.After(maxDate) {
+		diags.AddAttributeError(path.Root("min_date"), "Invalid date range",
+			"min_date must not be after max_date.")
+	}
+	return diags
+}
+
+func NewEventFilters(ctx context.Context, tfModel *TFEventsDSModel) (*EventFilters, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	filters := &EventFilters{}
+	if !tfModel.EventTypes.IsNull() {
+		var eventTypes []string
+		diags.Append(tfModel.EventTypes.ElementsAs(ctx, &eventTypes, false)...)
+		filters.EventTypes = &eventTypes
+	}
+	if minDate, ok := conversion.StringPtrToTimePtr(tfModel.MinDate.ValueStringPointer()); ok {
+		filters.MinDate = minDate
+	} else {
+		diags.AddAttributeError(path.Root("min_date"), "Invalid date", "min_date must be a valid RFC3339 timestamp.")
+	}
+	if maxDate, ok := conversion.StringPtrToTimePtr(tfModel.MaxDate.ValueStringPointer()); ok {
+		filters.MaxDate = maxDate
+	} else {
+		diags.AddAttributeError(path.Root("max_date"), "Invalid date", "max_date must be a valid RFC3339 timestamp.")
+	}
+	return filters, diags
+}
+
+func NewTFProjectEventModels(events []admin.EventViewForNdsGroup) []TFEventModel {
+	results := make([]TFEventModel, 0, len(events))
+	for i := range events {
+		event := &events[i]
+		results = append(results, TFEventModel{
+			EventID:        types.StringPointerValue(event.Id),
+			EventTypeName:  types.StringPointerValue(event.EventTypeName),
+			Created:        types.StringPointerValue(conversion.TimePtrToStringPtr(event.Created)),
+			ProjectID:      types.StringPointerValue(event.GroupId),
+			OrgID:          types.StringPointerValue(event.OrgId),
+			UserID:         types.StringPointerValue(event.UserId),
+			Username:       types.StringPointerValue(event.Username),
+			APIKeyID:       types.StringPointerValue(event.ApiKeyId),
+			PublicKey:      types.StringPointerValue(event.PublicKey),
+			RemoteAddress:  types.StringPointerValue(event.RemoteAddress),
+			IsGlobalAdmin:  types.BoolPointerValue(event.IsGlobalAdmin),
+			AlertID:        types.StringPointerValue(event.AlertId),
+			AlertConfigID:  types.StringPointerValue(event.AlertConfigId),
+			TargetUsername: types.StringPointerValue(event.TargetUsername),
+			ResourceID:     types.StringPointerValue(event.ResourceId),
+			ResourceType:   types.StringPointerValue(event.ResourceType),
+			ReplicaSetName: types.StringPointerValue(event.ReplicaSetName),
+			DBUserUsername: types.StringPointerValue(event.DbUserUsername),
+		})
+	}
+	return results
+}
+
+func NewTFOrgEventModels(events []admin.EventViewForOrg) []TFEventModel {
+	results := make([]TFEventModel, 0, len(events))
+	for i := range events {
+		event := &events[i]
+		results = append(results, TFEventModel{
+			EventID:        types.StringPointerValue(event.Id),
+			EventTypeName:  types.StringPointerValue(event.EventTypeName),
+			Created:        types.StringPointerValue(conversion.TimePtrToStringPtr(event.Created)),
+			ProjectID:      types.StringPointerValue(event.GroupId),
+			OrgID:          types.StringPointerValue(event.OrgId),
+			UserID:         types.StringPointerValue(event.UserId),
+			Username:       types.StringPointerValue(event.Username),
+			APIKeyID:       types.StringPointerValue(event.ApiKeyId),
+			PublicKey:      types.StringPointerValue(event.PublicKey),
+			RemoteAddress:  types.StringPointerValue(event.RemoteAddress),
+			IsGlobalAdmin:  types.BoolPointerValue(event.IsGlobalAdmin),
+			AlertID:        types.StringPointerValue(event.AlertId),
+			AlertConfigID:  types.StringPointerValue(event.AlertConfigId),
+			TargetUsername: types.StringPointerValue(event.TargetUsername),
+			ResourceID:     types.StringPointerValue(event.ResourceId),
+			ResourceType:   types.StringPointerValue(event.ResourceType),
+			ReplicaSetName: types.StringNull(),
+			DBUserUsername: types.StringPointerValue(event.DbUserUsername),
+		})
+	}
+	return results
+}
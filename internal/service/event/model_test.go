@@ -0,0 +1,120 @@
+package event_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go.mongodb.org/atlas-sdk/v20250312003/admin"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/conversion"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/event"
+)
+
+func TestValidateDateRange(t *testing.T) {
+	testCases := map[string]struct {
+		minDate types.String
+		maxDate types.String
+		wantErr bool
+	}{
+		"valid range":        {minDate: types.StringValue("2025-01-01T00:00:00Z"), maxDate: types.StringValue("2025-01-02T00:00:00Z")},
+		"same date":          {minDate: types.StringValue("2025-01-01T00:00:00Z"), maxDate: types.StringValue("2025-01-01T00:00:00Z")},
+		"min after max":      {minDate: types.StringValue("2025-01-03T00:00:00Z"), maxDate: types.StringValue("2025-01-02T00:00:00Z"), wantErr: true},
+		"offsets compared":   {minDate: types.StringValue("2025-01-01T10:00:00+02:00"), maxDate: types.StringValue("2025-01-01T09:00:00Z")},
+		"only min date":      {minDate: types.StringValue("2025-01-03T00:00:00Z"), maxDate: types.StringNull()},
+		"unknown max date":   {minDate: types.StringValue("2025-01-03T00:00:00Z"), maxDate: types.StringUnknown()},
+		"invalid is skipped": {minDate: types.StringValue("yesterday"), maxDate: types.StringValue("2025-01-02T00:00:00Z")},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			diags := event.ValidateDateRange(&event.TFEventsDSModel{MinDate: tc.minDate, MaxDate: tc.maxDate})
+			assert.Equal(t, tc.wantErr, diags.HasError())
+		})
+	}
+}
+
+func TestNewEventFilters(t *testing.T) {
+	ctx := context.Background()
+	eventTypes, _ := types.SetValueFrom(ctx, types.StringType, []string{"CLUSTER_CREATED"})
+	filters, diags := event.NewEventFilters(ctx, &event.TFEventsDSModel{
+		EventTypes: eventTypes,
+		MinDate:    types.StringValue("2025-01-01T00:00:00Z"),
+		MaxDate:    types.StringNull(),
+	})
+	require.False(t, diags.HasError())
+	minDate := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	assert.Equal(t, &event.EventFilters{
+		EventTypes: &[]string{"CLUSTER_CREATED"},
+		MinDate:    &minDate,
+	}, filters)
+
+	filters, diags = event.NewEventFilters(ctx, &event.TFEventsDSModel{
+		EventTypes: types.SetNull(types.StringType),
+		MinDate:    types.StringNull(),
+		MaxDate:    types.StringNull(),
+	})
+	require.False(t, diags.HasError())
+	assert.Equal(t, &event.EventFilters{}, filters)
+}
+
+func TestNewTFProjectEventModels(t *testing.T) {
+	created := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	events := []admin.EventViewForNdsGroup{
+		{
+			Id:             admin.PtrString("eventID"),
+			EventTypeName:  admin.PtrString("CLUSTER_CREATED"),
+			Created:        &created,
+			GroupId:        admin.PtrString("projectID"),
+			OrgId:          admin.PtrString("orgID"),
+			Username:       admin.PtrString("user@example.com"),
+			IsGlobalAdmin:  admin.PtrBool(false),
+			ReplicaSetName: admin.PtrString("rs0"),
+		},
+	}
+	assert.Equal(t, []event.TFEventModel{
+		{
+			EventID:        types.StringValue("eventID"),
+			EventTypeName:  types.StringValue("CLUSTER_CREATED"),
+			Created:        types.StringValue(conversion.TimeToString(created)),
+			ProjectID:      types.StringValue("projectID"),
+			OrgID:          types.StringValue("orgID"),
+			UserID:         types.StringNull(),
+			Username:       types.StringValue("user@example.com"),
+			APIKeyID:       types.StringNull(),
+			PublicKey:      types.StringNull(),
+			RemoteAddress:  types.StringNull(),
+			IsGlobalAdmin:  types.BoolValue(false),
+			AlertID:        types.StringNull(),
+			AlertConfigID:  types.StringNull(),
+			TargetUsername: types.StringNull(),
+			ResourceID:     types.StringNull(),
+			ResourceType:   types.StringNull(),
+			ReplicaSetName: types.StringValue("rs0"),
+			DBUserUsername: types.StringNull(),
+		},
+	}, event.NewTFProjectEventModels(events))
+}
+
+func TestNewTFOrgEventModels(t *testing.T) {
+	events := []admin.EventViewForOrg{
+		{
+			Id:            admin.PtrString("eventID"),
+			EventTypeName: admin.PtrString("ORG_CREATED"),
+			OrgId:         admin.PtrString("orgID"),
+			PublicKey:     admin.PtrString("publicKey"),
+			ApiKeyId:      admin.PtrString("apiKeyID"),
+		},
+	}
+	models := event.NewTFOrgEventModels(events)
+	require.Len(t, models, 1)
+	assert.Equal(t, "eventID", models[0].EventID.ValueString())
+	assert.Equal(t, "orgID", models[0].OrgID.ValueString())
+	assert.Equal(t, "apiKeyID", models[0].APIKeyID.ValueString())
+	assert.True(t, models[0].ProjectID.IsNull())
+	assert.True(t, models[0].ReplicaSetName.IsNull())
+	assert.Empty(t, event.NewTFOrgEventModels(nil))
+}
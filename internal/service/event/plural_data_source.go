@@ -0,0 +1,120 @@
+package event
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"go.mongodb.org/atlas-sdk/v20250312003/admin"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/conversion"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/dsschema"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/config"
+)
+
+const (
+	resourceName     = "event"
+	errorReadProject = "error getting events for project %s"
+	errorReadOrg     = "error getting events for organization %s"
+)
+
+var _ datasource.DataSource = &pluralDS{}
+var _ datasource.DataSourceWithConfigure = &pluralDS{}
+var _ datasource.DataSourceWithValidateConfig = &pluralDS{}
+
+func PluralDataSource() datasource.DataSource {
+	return &pluralDS{
+		DSCommon: config.DSCommon{
+			DataSourceName: fmt.Sprintf("%ss", resourceName),
+		},
+	}
+}
+
+type pluralDS struct {
+	config.DSCommon
+}
+
+func (d *pluralDS) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = PluralDataSourceSchema(ctx)
+	conversion.UpdateSchemaDescription(&resp.Schema)
+}
+
+func (d *pluralDS) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var tfModel TFEventsDSModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &tfModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(ValidateDateRange(&tfModel)...)
+}
+
+func (d *pluralDS) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var tfModel TFEventsDSModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &tfModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	filters, diags := NewEventFilters(ctx, &tfModel)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	connV2 := d.Client.AtlasV2
+	var results []TFEventModel
+	if projectID := tfModel.ProjectID.ValueString(); projectID != "" {
+		events, err := ListProjectEvents(ctx, projectID, filters, connV2.EventsApi)
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf(errorReadProject, projectID), err.Error())
+			return
+		}
+		results = NewTFProjectEventModels(events)
+	} else {
+		orgID := tfModel.OrgID.ValueString()
+		events, err := ListOrgEvents(ctx, orgID, filters, connV2.EventsApi)
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf(errorReadOrg, orgID), err.Error())
+			return
+		}
+		results = NewTFOrgEventModels(events)
+	}
+	tfModel.Results = results
+	resp.Diagnostics.Append(resp.State.Set(ctx, tfModel)...)
+}
+
+// EventFilters are the query parameters shared by the project and organization events endpoints.
+type EventFilters struct {
+	EventTypes *[]string
+	MinDate    *time.Time
+	MaxDate    *time.Time
+}
+
+func ListProjectEvents(ctx context.Context, projectID string, filters *EventFilters, client admin.EventsApi) ([]admin.EventViewForNdsGroup, error) {
+	params := admin.ListProjectEventsApiParams{
+		GroupId:   projectID,
+		EventType: filters.EventTypes,
+		MinDate:   filters.MinDate,
+		MaxDate:   filters.MaxDate,
+	}
+	return dsschema.AllPages(ctx, func(ctx context.Context, pageNum int) (dsschema.PaginateResponse[admin.EventViewForNdsGroup], *http.Response, error) {
+		request := client.ListProjectEventsWithParams(ctx, &params)
+		request = request.PageNum(pageNum)
+		return request.Execute()
+	})
+}
+
+func ListOrgEvents(ctx context.Context, orgID string, filters *EventFilters, client admin.EventsApi) ([]admin.EventViewForOrg, error) {
+	params := admin.ListOrganizationEventsApiParams{
+		OrgId:     orgID,
+		EventType: filters.EventTypes,
+		MinDate:   filters.MinDate,
+		MaxDate:   filters.MaxDate,
+	}
+	return dsschema.AllPages(ctx, func(ctx context.Context, pageNum int) (dsschema.PaginateResponse[admin.EventViewForOrg], *http.Response, error) {
+		request := client.ListOrganizationEventsWithParams(ctx, &params)
+		request = request.PageNum(pageNum)
+		return request.Execute()
+	})
+}
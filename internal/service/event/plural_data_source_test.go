@@ -0,0 +1,76 @@
+package event_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/conversion"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/testutil/acc"
+)
+
+const dataSourceName = "data.mongodbatlas_events.test"
+
+func TestAccEventsDS_project(t *testing.T) {
+	var (
+		projectID = acc.ProjectIDExecution(t)
+	)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acc.PreCheckBasic(t) },
+		ProtoV6ProviderFactories: acc.TestAccProviderV6Factories,
+		Steps: []resource.TestStep{
+			{
+				Config: configProject(projectID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "project_id", projectID),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.event_type_name", "GROUP_CREATED"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.project_id", projectID),
+					resource.TestCheckResourceAttrSet(dataSourceName, "results.0.event_id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "results.0.created"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEventsDS_organization(t *testing.T) {
+	var (
+		orgID   = os.Getenv("MONGODB_ATLAS_ORG_ID")
+		minDate = conversion.TimeToString(time.Now().Add(-24 * time.Hour))
+	)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acc.PreCheckBasic(t) },
+		ProtoV6ProviderFactories: acc.TestAccProviderV6Factories,
+		Steps: []resource.TestStep{
+			{
+				Config: configOrganization(orgID, minDate),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "org_id", orgID),
+					resource.TestCheckResourceAttr(dataSourceName, "min_date", minDate),
+					resource.TestCheckResourceAttrSet(dataSourceName, "results.#"),
+				),
+			},
+		},
+	})
+}
+
+func configProject(projectID string) string {
+	return fmt.Sprintf(`
+		data "mongodbatlas_events" "test" {
+			project_id  = %[1]q
+			event_types = ["GROUP_CREATED"]
+		}
+	`, projectID)
+}
+
+func configOrganization(orgID, minDate string) string {
+	return fmt.Sprintf(`
+		data "mongodbatlas_events" "test" {
+			org_id   = %[1]q
+			min_date = %[2]q
+		}
+	`, orgID, minDate)
+}
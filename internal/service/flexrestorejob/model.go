@@ -3,6 +3,7 @@ package flexrestorejob
 import (
 	"go.mongodb.org/atlas-sdk/v20250312003/admin"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/conversion"
@@ -40,3 +41,29 @@ func NewTFModelPluralDS(projectID, name string, apiResp *[]admin.FlexBackupResto
 		Results:   results,
 	}
 }
+
+func NewTFRSModel(apiResp *admin.FlexBackupRestoreJob20241113, timeout timeouts.Value) *TFRSModel {
+	return &TFRSModel{
+		ProjectID:                types.StringPointerValue(apiResp.ProjectId),
+		Name:                     types.StringPointerValue(apiResp.InstanceName),
+		SnapshotID:               types.StringPointerValue(apiResp.SnapshotId),
+		TargetDeploymentItemName: types.StringPointerValue(apiResp.TargetDeploymentItemName),
+		TargetProjectID:          types.StringPointerValue(apiResp.TargetProjectId),
+		RestoreJobID:             types.StringPointerValue(apiResp.Id),
+		DeliveryType:             types.StringPointerValue(apiResp.DeliveryType),
+		ExpirationDate:           types.StringPointerValue(conversion.TimePtrToStringPtr(apiResp.ExpirationDate)),
+		RestoreFinishedDate:      types.StringPointerValue(conversion.TimePtrToStringPtr(apiResp.RestoreFinishedDate)),
+		RestoreScheduledDate:     types.StringPointerValue(conversion.TimePtrToStringPtr(apiResp.RestoreScheduledDate)),
+		SnapshotFinishedDate:     types.StringPointerValue(conversion.TimePtrToStringPtr(apiResp.SnapshotFinishedDate)),
+		Status:                   types.StringPointerValue(apiResp.Status),
+		Timeouts:                 timeout,
+	}
+}
+
+func NewAtlasCreateReq(plan *TFRSModel) *admin.FlexBackupRestoreJobCreate20241113 {
+	return &admin.FlexBackupRestoreJobCreate20241113{
+		SnapshotId:               plan.SnapshotID.ValueString(),
+		TargetDeploymentItemName: plan.TargetDeploymentItemName.ValueString(),
+		TargetProjectId:          conversion.NilForUnknownOrEmptyString(plan.TargetProjectID),
+	}
+}
@@ -78,3 +78,40 @@ func TestFlexRestoreJobSDKToTFModel(t *testing.T) {
 		})
 	}
 }
+
+func TestFlexRestoreJobCreateReq(t *testing.T) {
+	testCases := map[string]struct {
+		plan        *flexrestorejob.TFRSModel
+		expectedReq *admin.FlexBackupRestoreJobCreate20241113
+	}{
+		"Restore to another project": {
+			plan: &flexrestorejob.TFRSModel{
+				SnapshotID:               types.StringValue("snapshotID"),
+				TargetDeploymentItemName: types.StringValue("targetName"),
+				TargetProjectID:          types.StringValue("targetProjectID"),
+			},
+			expectedReq: &admin.FlexBackupRestoreJobCreate20241113{
+				SnapshotId:               "snapshotID",
+				TargetDeploymentItemName: "targetName",
+				TargetProjectId:          conversion.StringPtr("targetProjectID"),
+			},
+		},
+		"Restore to the same project": {
+			plan: &flexrestorejob.TFRSModel{
+				SnapshotID:               types.StringValue("snapshotID"),
+				TargetDeploymentItemName: types.StringValue("targetName"),
+				TargetProjectID:          types.StringUnknown(),
+			},
+			expectedReq: &admin.FlexBackupRestoreJobCreate20241113{
+				SnapshotId:               "snapshotID",
+				TargetDeploymentItemName: "targetName",
+			},
+		},
+	}
+
+	for testName, tc := range testCases {
+		t.Run(testName, func(t *testing.T) {
+			assert.Equal(t, tc.expectedReq, flexrestorejob.NewAtlasCreateReq(tc.plan))
+		})
+	}
+}
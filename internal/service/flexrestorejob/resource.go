@@ -0,0 +1,128 @@
+package flexrestorejob
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/conversion"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/validate"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/config"
+)
+
+const (
+	errorCreate          = "error creating flex restore job"
+	errorWait            = "error waiting for flex restore job to finish"
+	errorImportFormat    = "import format error: to import a flex restore job, use the format {project_id}-{name}-{restore_job_id}"
+	warningDeleteSummary = "Flex restore job removed from state only"
+	warningDeleteDetail  = "Atlas doesn't allow deleting or cancelling flex restore jobs, the restore job is only removed from the Terraform state."
+	defaultTimeout       = 1 * time.Hour
+)
+
+var _ resource.ResourceWithConfigure = &rs{}
+var _ resource.ResourceWithImportState = &rs{}
+
+func Resource() resource.Resource {
+	return &rs{
+		RSCommon: config.RSCommon{
+			ResourceName: resourceName,
+		},
+	}
+}
+
+type rs struct {
+	config.RSCommon
+}
+
+func (r *rs) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = ResourceSchema(ctx)
+	conversion.UpdateSchemaDescription(&resp.Schema)
+}
+
+func (r *rs) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan TFRSModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	timeout, diags := plan.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	connV2 := r.Client.AtlasV2
+	projectID := plan.ProjectID.ValueString()
+	name := plan.Name.ValueString()
+	restoreJob, _, err := connV2.FlexRestoreJobsApi.CreateFlexBackupRestoreJob(ctx, projectID, name, NewAtlasCreateReq(&plan)).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(errorCreate, err.Error())
+		return
+	}
+
+	restoreJobResp, err := WaitStateTransition(ctx, projectID, name, restoreJob.GetId(), connV2.FlexRestoreJobsApi, timeout)
+	if err != nil {
+		resp.Diagnostics.AddError(errorWait, err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, NewTFRSModel(restoreJobResp, plan.Timeouts))...)
+}
+
+func (r *rs) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state TFRSModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	connV2 := r.Client.AtlasV2
+	restoreJob, apiResp, err := connV2.FlexRestoreJobsApi.GetFlexBackupRestoreJob(ctx, state.ProjectID.ValueString(), state.Name.ValueString(), state.RestoreJobID.ValueString()).Execute()
+	if err != nil {
+		if validate.StatusNotFound(apiResp) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(errorRead, err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, NewTFRSModel(restoreJob, state.Timeouts))...)
+}
+
+// Update only changes timeouts, all other attributes require replacement.
+func (r *rs) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan TFRSModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *rs) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.Diagnostics.AddWarning(warningDeleteSummary, warningDeleteDetail)
+}
+
+func (r *rs) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	projectID, name, restoreJobID, err := splitImportID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("error splitting import ID", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), projectID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("restore_job_id"), restoreJobID)...)
+}
+
+func splitImportID(id string) (projectID, name, restoreJobID string, err error) {
+	var re = regexp.MustCompile(`(?s)^([0-9a-fA-F]{24})-(.+)-([0-9a-fA-F]{24})$`)
+	parts := re.FindStringSubmatch(id)
+	if len(parts) != 4 {
+		return "", "", "", errors.New(errorImportFormat)
+	}
+	return parts[1], parts[2], parts[3], nil
+}
@@ -0,0 +1,57 @@
+package flexrestorejob
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/atlas-sdk/v20250312003/admin"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+)
+
+const (
+	StatusPending    = "PENDING"
+	StatusInProgress = "IN_PROGRESS"
+	StatusCompleted  = "COMPLETED"
+	StatusFailed     = "FAILED"
+)
+
+// WaitStateTransition waits until the restore job finishes, returning an error if the job fails.
+func WaitStateTransition(ctx context.Context, projectID, name, restoreJobID string, client admin.FlexRestoreJobsApi, timeout time.Duration) (*admin.FlexBackupRestoreJob20241113, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{StatusPending, StatusInProgress},
+		Target:     []string{StatusCompleted},
+		Refresh:    refreshFunc(ctx, projectID, name, restoreJobID, client),
+		Timeout:    timeout,
+		MinTimeout: 3 * time.Second,
+		Delay:      0,
+	}
+
+	restoreJobResp, err := stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if restoreJob, ok := restoreJobResp.(*admin.FlexBackupRestoreJob20241113); ok && restoreJob != nil {
+		return restoreJob, nil
+	}
+
+	return nil, errors.New("did not obtain valid result when waiting for flex restore job state transition")
+}
+
+func refreshFunc(ctx context.Context, projectID, name, restoreJobID string, client admin.FlexRestoreJobsApi) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		restoreJob, _, err := client.GetFlexBackupRestoreJob(ctx, projectID, name, restoreJobID).Execute()
+		if err != nil {
+			return nil, "", err
+		}
+		status := restoreJob.GetStatus()
+		if status == StatusFailed {
+			return nil, "", fmt.Errorf("restore job %s of snapshot %s to %s in project %s failed, Atlas doesn't return the failure reason, check the project activity feed for details",
+				restoreJobID, restoreJob.GetSnapshotId(), restoreJob.GetTargetDeploymentItemName(), restoreJob.GetTargetProjectId())
+		}
+		return restoreJob, status, nil
+	}
+}
@@ -0,0 +1,86 @@
+package flexrestorejob_test
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"go.mongodb.org/atlas-sdk/v20250312003/admin"
+	"go.mongodb.org/atlas-sdk/v20250312003/mockadmin"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/flexrestorejob"
+)
+
+type response struct {
+	status *string
+	err    error
+}
+
+func TestFlexRestoreJobStateTransition(t *testing.T) {
+	var (
+		pending    = flexrestorejob.StatusPending
+		inProgress = flexrestorejob.StatusInProgress
+		completed  = flexrestorejob.StatusCompleted
+		failed     = flexrestorejob.StatusFailed
+	)
+	testCases := map[string]struct {
+		mockResponses []response
+		expectedError string
+	}{
+		"Successful transition to COMPLETED": {
+			mockResponses: []response{
+				{status: &pending},
+				{status: &inProgress},
+				{status: &completed},
+			},
+		},
+		"Already COMPLETED": {
+			mockResponses: []response{
+				{status: &completed},
+			},
+		},
+		"Error when restore job fails": {
+			mockResponses: []response{
+				{status: &inProgress},
+				{status: &failed},
+			},
+			expectedError: "restore job restoreJobID of snapshot snapshotID to targetCluster in project targetProjectID failed",
+		},
+		"Error when API returns error": {
+			mockResponses: []response{
+				{err: errors.New("Internal server error")},
+			},
+			expectedError: "Internal server error",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			m := mockadmin.NewFlexRestoreJobsApi(t)
+			m.EXPECT().GetFlexBackupRestoreJob(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(admin.GetFlexBackupRestoreJobApiRequest{ApiService: m})
+			for _, resp := range tc.mockResponses {
+				var job *admin.FlexBackupRestoreJob20241113
+				if resp.status != nil {
+					job = &admin.FlexBackupRestoreJob20241113{
+						Status:                   resp.status,
+						SnapshotId:               admin.PtrString("snapshotID"),
+						TargetDeploymentItemName: admin.PtrString("targetCluster"),
+						TargetProjectId:          admin.PtrString("targetProjectID"),
+					}
+				}
+				m.EXPECT().GetFlexBackupRestoreJobExecute(mock.Anything).Return(job, &http.Response{}, resp.err).Once()
+			}
+			resp, err := flexrestorejob.WaitStateTransition(t.Context(), "projectID", "name", "restoreJobID", m, time.Minute)
+			if tc.expectedError != "" {
+				assert.ErrorContains(t, err, tc.expectedError)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, flexrestorejob.StatusCompleted, resp.GetStatus())
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package flexsnapshot
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/atlas-sdk/v20250312003/admin"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/conversion"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/config"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/flexrestorejob"
+)
+
+const (
+	ephemeralResourceName = "flex_snapshot_download"
+	errorDownload         = "error requesting flex cluster snapshot download"
+	defaultDownloadWait   = 20 * time.Minute
+)
+
+var _ ephemeral.EphemeralResource = &ephemeralRS{}
+var _ ephemeral.EphemeralResourceWithConfigure = &ephemeralRS{}
+
+func EphemeralResource() ephemeral.EphemeralResource {
+	return &ephemeralRS{
+		EphemeralCommon: config.EphemeralCommon{
+			EphemeralResourceName: ephemeralResourceName,
+		},
+	}
+}
+
+type ephemeralRS struct {
+	config.EphemeralCommon
+}
+
+func (e *ephemeralRS) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = EphemeralResourceSchema(ctx)
+	conversion.UpdateSchemaDescription(&resp.Schema)
+}
+
+// Open requests a new download link every time, so the link is never persisted in plan or state.
+func (e *ephemeralRS) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var tfModel TFEphemeralModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &tfModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	timeout, diags := tfModel.Timeouts.Open(ctx, defaultDownloadWait)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	connV2 := e.Client.AtlasV2
+	projectID := tfModel.ProjectID.ValueString()
+	name := tfModel.Name.ValueString()
+	downloadReq := admin.NewFlexBackupSnapshotDownloadCreate20241113(tfModel.SnapshotID.ValueString())
+	downloadJob, _, err := connV2.FlexSnapshotsApi.DownloadFlexBackup(ctx, name, projectID, downloadReq).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(errorDownload, err.Error())
+		return
+	}
+	downloadJob, err = flexrestorejob.WaitStateTransition(ctx, projectID, name, downloadJob.GetId(), connV2.FlexRestoreJobsApi, timeout)
+	if err != nil {
+		resp.Diagnostics.AddError(errorDownload, err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.Result.Set(ctx, NewTFEphemeralModel(&tfModel, downloadJob))...)
+}
@@ -0,0 +1,57 @@
+package flexsnapshot
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/ephemeral/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func EphemeralResourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Unique 24-hexadecimal digit string that identifies your project. Use the [/groups](#tag/Projects/operation/listProjects) endpoint to retrieve all projects to which the authenticated user has access.\n\n**NOTE**: Groups and projects are synonymous terms. Your group id is the same as your project id. For existing groups, your group/project id remains the same. The resource and corresponding endpoints use the term groups.",
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Human-readable label that identifies the flex cluster whose snapshot you want to download.",
+			},
+			"snapshot_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Unique 24-hexadecimal digit string that identifies the snapshot to download.",
+			},
+			"restore_job_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Unique 24-hexadecimal digit string that identifies the download restore job.",
+			},
+			"snapshot_url": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "Internet address from which you can download the compressed snapshot files.",
+			},
+			"expiration_date": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Date and time when the download link no longer works. This parameter expresses its value in the ISO 8601 timestamp format in UTC.",
+			},
+			"status": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Phase of the restore workflow for the download job.",
+			},
+			"timeouts": timeouts.Attributes(ctx),
+		},
+	}
+}
+
+type TFEphemeralModel struct {
+	ProjectID      types.String   `tfsdk:"project_id"`
+	Name           types.String   `tfsdk:"name"`
+	SnapshotID     types.String   `tfsdk:"snapshot_id"`
+	RestoreJobID   types.String   `tfsdk:"restore_job_id"`
+	SnapshotURL    types.String   `tfsdk:"snapshot_url"`
+	ExpirationDate types.String   `tfsdk:"expiration_date"`
+	Status         types.String   `tfsdk:"status"`
+	Timeouts       timeouts.Value `tfsdk:"timeouts"`
+}
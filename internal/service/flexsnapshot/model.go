@@ -36,3 +36,16 @@ func NewTFModelPluralDS(projectID, name string, apiResp *[]admin.FlexBackupSnaps
 		Results:   results,
 	}
 }
+
+func NewTFEphemeralModel(config *TFEphemeralModel, apiResp *admin.FlexBackupRestoreJob20241113) *TFEphemeralModel {
+	return &TFEphemeralModel{
+		ProjectID:      config.ProjectID,
+		Name:           config.Name,
+		SnapshotID:     config.SnapshotID,
+		RestoreJobID:   types.StringPointerValue(apiResp.Id),
+		SnapshotURL:    types.StringPointerValue(apiResp.SnapshotUrl),
+		ExpirationDate: types.StringPointerValue(conversion.TimePtrToStringPtr(apiResp.ExpirationDate)),
+		Status:         types.StringPointerValue(apiResp.Status),
+		Timeouts:       config.Timeouts,
+	}
+}
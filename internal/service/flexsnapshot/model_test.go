@@ -73,3 +73,33 @@ func TestFlexSnapshotSDKToTFModel(t *testing.T) {
 		})
 	}
 }
+
+func TestFlexSnapshotDownloadSDKToTFEphemeralModel(t *testing.T) {
+	var (
+		id          = "id"
+		snapshotURL = "https://example.com/snapshot.tar.gz"
+		status      = "COMPLETED"
+		now         = time.Now()
+		config      = &flexsnapshot.TFEphemeralModel{
+			ProjectID:  types.StringValue("projectID"),
+			Name:       types.StringValue("name"),
+			SnapshotID: types.StringValue("snapshotID"),
+		}
+	)
+	apiResp := &admin.FlexBackupRestoreJob20241113{
+		Id:             &id,
+		SnapshotUrl:    &snapshotURL,
+		ExpirationDate: &now,
+		Status:         &status,
+	}
+	expected := &flexsnapshot.TFEphemeralModel{
+		ProjectID:      types.StringValue("projectID"),
+		Name:           types.StringValue("name"),
+		SnapshotID:     types.StringValue("snapshotID"),
+		RestoreJobID:   types.StringValue(id),
+		SnapshotURL:    types.StringValue(snapshotURL),
+		ExpirationDate: types.StringPointerValue(conversion.TimePtrToStringPtr(&now)),
+		Status:         types.StringValue(status),
+	}
+	assert.Equal(t, expected, flexsnapshot.NewTFEphemeralModel(config, apiResp))
+}
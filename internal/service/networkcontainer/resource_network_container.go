@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"net/http"
 	"strings"
 	"time"
 
@@ -18,6 +19,7 @@ import (
 
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/constant"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/conversion"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/dsschema"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/validate"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/config"
 )
@@ -35,6 +37,7 @@ func Resource() *schema.Resource {
 		ReadContext:   resourceRead,
 		UpdateContext: resourceUpdate,
 		DeleteContext: resourceDelete,
+		CustomizeDiff: resourceCustomizeDiff,
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceImport,
 		},
@@ -276,6 +279,53 @@ func resourceDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.
 	return nil
 }
 
+func resourceCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	if !d.NewValueKnown("atlas_cidr_block") || (d.Id() != "" && !d.HasChange("atlas_cidr_block")) {
+		return nil
+	}
+	cidr := d.Get("atlas_cidr_block").(string)
+	if err := validate.CheckAtlasCIDRBlock(cidr); err != nil {
+		return fmt.Errorf("invalid atlas_cidr_block: %w", err)
+	}
+	if !d.NewValueKnown("project_id") || !d.NewValueKnown("provider_name") {
+		return nil
+	}
+	connV2 := meta.(*config.MongoDBClient).AtlasV2
+	containerID := conversion.DecodeStateID(d.Id())["container_id"]
+	return CheckContainerOverlap(ctx, connV2.NetworkPeeringApi, d.Get("project_id").(string), d.Get("provider_name").(string), containerID, cidr)
+}
+
+// CheckContainerOverlap returns an error if cidr overlaps the Atlas CIDR block of another container in the same project and cloud provider.
+// Containers can't be listed if the project doesn't exist yet, in that case the check is skipped.
+func CheckContainerOverlap(ctx context.Context, api admin.NetworkPeeringApi, projectID, providerName, containerID, cidr string) error {
+	containers, err := dsschema.AllPages(ctx, func(ctx context.Context, pageNum int) (dsschema.PaginateResponse[admin.CloudProviderContainer], *http.Response, error) {
+		params := &admin.ListPeeringContainerByCloudProviderApiParams{
+			GroupId:      projectID,
+			ProviderName: &providerName,
+			PageNum:      &pageNum,
+		}
+		return api.ListPeeringContainerByCloudProviderWithParams(ctx, params).Execute()
+	})
+	if err != nil {
+		log.Printf("[WARN] skipping atlas_cidr_block overlap check, couldn't list %s containers in project %s: %s", providerName, projectID, err)
+		return nil
+	}
+	for i := range containers {
+		container := &containers[i]
+		if container.GetId() == containerID || container.GetAtlasCidrBlock() == "" {
+			continue
+		}
+		overlap, err := validate.CIDRsOverlap(cidr, container.GetAtlasCidrBlock())
+		if err != nil {
+			return err
+		}
+		if overlap {
+			return fmt.Errorf("atlas_cidr_block %s overlaps the Atlas CIDR block %s of %s container %s in project %s", cidr, container.GetAtlasCidrBlock(), providerName, container.GetId(), projectID)
+		}
+	}
+	return nil
+}
+
 func resourceImport(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
 	connV2 := meta.(*config.MongoDBClient).AtlasV2
 
@@ -0,0 +1,50 @@
+package networkcontainer_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/atlas-sdk/v20250312003/admin"
+	"go.mongodb.org/atlas-sdk/v20250312003/mockadmin"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/conversion"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/networkcontainer"
+)
+
+func TestCheckContainerOverlap(t *testing.T) {
+	containers := []admin.CloudProviderContainer{
+		{Id: conversion.StringPtr("c1"), AtlasCidrBlock: conversion.StringPtr("10.8.0.0/21")},
+		{Id: conversion.StringPtr("c2"), AtlasCidrBlock: conversion.StringPtr("192.168.0.0/24")},
+	}
+	testCases := map[string]struct {
+		listErr       error
+		containerID   string
+		cidr          string
+		expectedError string
+	}{
+		"no overlap":                 {cidr: "10.8.8.0/21"},
+		"overlap with other":         {cidr: "10.8.4.0/24", expectedError: "atlas_cidr_block 10.8.4.0/24 overlaps the Atlas CIDR block 10.8.0.0/21 of AWS container c1 in project p1"},
+		"own container is ignored":   {cidr: "10.8.4.0/24", containerID: "c1"},
+		"list error skips the check": {cidr: "10.8.4.0/24", listErr: errors.New("not found")},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			api := mockadmin.NewNetworkPeeringApi(t)
+			api.EXPECT().ListPeeringContainerByCloudProviderWithParams(mock.Anything, mock.Anything).Return(admin.ListPeeringContainerByCloudProviderApiRequest{ApiService: api})
+			if tc.listErr != nil {
+				api.EXPECT().ListPeeringContainerByCloudProviderExecute(mock.Anything).Return(nil, nil, tc.listErr)
+			} else {
+				api.EXPECT().ListPeeringContainerByCloudProviderExecute(mock.Anything).Return(&admin.PaginatedCloudProviderContainer{Results: &containers, TotalCount: conversion.Pointer(len(containers))}, nil, nil)
+			}
+			err := networkcontainer.CheckContainerOverlap(t.Context(), api, "p1", "AWS", tc.containerID, tc.cidr)
+			if tc.expectedError == "" {
+				require.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, tc.expectedError)
+		})
+	}
+}
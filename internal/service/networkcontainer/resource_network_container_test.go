@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
@@ -158,6 +159,24 @@ func TestAccNetworkContainer_updateIndividualFields(t *testing.T) {
 	})
 }
 
+func TestAccNetworkContainer_invalidCIDRBlock(t *testing.T) {
+	projectID := acc.ProjectIDExecution(t)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acc.PreCheckBasic(t) },
+		ProtoV6ProviderFactories: acc.TestAccProviderV6Factories,
+		Steps: []resource.TestStep{
+			{
+				Config:      configBasic(projectID, "8.8.0.0/21", constant.AWS, "US_EAST_1"),
+				ExpectError: regexp.MustCompile("must be in one of the RFC 1918 private networks"),
+			},
+			{
+				Config:      configBasic(projectID, "10.8.1.0/21", constant.AWS, "US_EAST_1"),
+				ExpectError: regexp.MustCompile("host bits must be zero"),
+			},
+		},
+	})
+}
+
 func importStateIDFunc(resourceName string) resource.ImportStateIdFunc {
 	return func(s *terraform.State) (string, error) {
 		rs, ok := s.RootModule().Resources[resourceName]
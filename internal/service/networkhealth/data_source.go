@@ -0,0 +1,139 @@
+package networkhealth
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"go.mongodb.org/atlas-sdk/v20250312003/admin"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/conversion"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/dsschema"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/config"
+)
+
+const (
+	dataSourceName = "network_health"
+	errorRead      = "error reading data source mongodbatlas_" + dataSourceName
+)
+
+var _ datasource.DataSource = &ds{}
+var _ datasource.DataSourceWithConfigure = &ds{}
+
+func DataSource() datasource.DataSource {
+	return &ds{
+		DSCommon: config.DSCommon{
+			DataSourceName: dataSourceName,
+		},
+	}
+}
+
+type ds struct {
+	config.DSCommon
+}
+
+func (d *ds) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = DataSourceSchema()
+	conversion.UpdateSchemaDescription(&resp.Schema)
+}
+
+func (d *ds) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var tfModel TFModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &tfModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	projectID := tfModel.ProjectID.ValueString()
+	network, err := readNetwork(ctx, d.Client.AtlasV2, projectID)
+	if err != nil {
+		resp.Diagnostics.AddError(errorRead, err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, NewTFModel(projectID, network))...)
+}
+
+func readNetwork(ctx context.Context, connV2 *admin.APIClient, projectID string) (*Network, error) {
+	network := new(Network)
+	for _, providerName := range providerNames {
+		peers, err := listPeers(ctx, connV2.NetworkPeeringApi, projectID, providerName)
+		if err != nil {
+			return nil, fmt.Errorf("error listing %s network peering connections: %w", providerName, err)
+		}
+		network.Peers = append(network.Peers, peers...)
+
+		services, err := listEndpointServices(ctx, connV2.PrivateEndpointServicesApi, projectID, providerName)
+		if err != nil {
+			return nil, fmt.Errorf("error listing %s private endpoint services: %w", providerName, err)
+		}
+		network.EndpointServices = append(network.EndpointServices, services...)
+	}
+	accessList, err := listAccessList(ctx, connV2.ProjectIPAccessListApi, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("error listing access list entries: %w", err)
+	}
+	network.AccessList = accessList
+	network.Clusters, err = dsschema.AllPages(ctx, func(ctx context.Context, pageNum int) (dsschema.PaginateResponse[admin.ClusterDescription20240805], *http.Response, error) {
+		return connV2.ClustersApi.ListClustersWithParams(ctx, &admin.ListClustersApiParams{GroupId: projectID, PageNum: &pageNum}).Execute()
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error listing clusters: %w", err)
+	}
+	return network, nil
+}
+
+// listPeers sets the provider name of the connections as it's not returned for AWS.
+func listPeers(ctx context.Context, api admin.NetworkPeeringApi, projectID, providerName string) ([]admin.BaseNetworkPeeringConnectionSettings, error) {
+	peers, err := dsschema.AllPages(ctx, func(ctx context.Context, pageNum int) (dsschema.PaginateResponse[admin.BaseNetworkPeeringConnectionSettings], *http.Response, error) {
+		params := &admin.ListPeeringConnectionsApiParams{
+			GroupId:      projectID,
+			ProviderName: &providerName,
+			PageNum:      &pageNum,
+		}
+		return api.ListPeeringConnectionsWithParams(ctx, params).Execute()
+	})
+	for i := range peers {
+		peers[i].ProviderName = &providerName
+	}
+	return peers, err
+}
+
+func listEndpointServices(ctx context.Context, api admin.PrivateEndpointServicesApi, projectID, providerName string) ([]EndpointService, error) {
+	services, _, err := api.ListPrivateEndpointServices(ctx, projectID, providerName).Execute()
+	if err != nil {
+		return nil, err
+	}
+	result := make([]EndpointService, len(services))
+	for i := range services {
+		service := &services[i]
+		result[i].Service = service
+		for _, endpointID := range EndpointIDs(service) {
+			endpoint, _, err := api.GetPrivateEndpoint(ctx, projectID, providerName, endpointID, service.GetId()).Execute()
+			if err != nil {
+				return nil, fmt.Errorf("error reading endpoint %s of private endpoint service %s: %w", endpointID, service.GetId(), err)
+			}
+			result[i].Endpoints = append(result[i].Endpoints, *endpoint)
+		}
+	}
+	return result, nil
+}
+
+func listAccessList(ctx context.Context, api admin.ProjectIPAccessListApi, projectID string) ([]AccessListEntry, error) {
+	entries, err := dsschema.AllPages(ctx, func(ctx context.Context, pageNum int) (dsschema.PaginateResponse[admin.NetworkPermissionEntry], *http.Response, error) {
+		return api.ListProjectIpAccessListsWithParams(ctx, &admin.ListProjectIpAccessListsApiParams{GroupId: projectID, PageNum: &pageNum}).Execute()
+	})
+	if err != nil {
+		return nil, err
+	}
+	result := make([]AccessListEntry, len(entries))
+	for i := range entries {
+		value := AccessListEntryValue(&entries[i])
+		status, _, err := api.GetProjectIpAccessListStatus(ctx, projectID, value).Execute()
+		if err != nil {
+			return nil, fmt.Errorf("error reading status of access list entry %s: %w", value, err)
+		}
+		result[i] = AccessListEntry{Entry: value, Status: status.STATUS}
+	}
+	return result, nil
+}
@@ -0,0 +1,223 @@
+package networkhealth
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func DataSourceSchema() schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Unique 24-hexadecimal digit string that identifies your project.",
+			},
+			"healthy": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Flag that indicates whether all the network peering connections and private endpoints are `AVAILABLE` and all the access list entries are `ACTIVE`.",
+			},
+			"network_peering": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Network peering connections of the project in all cloud providers.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"peering_id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Unique 24-hexadecimal digit string that identifies the network peering connection.",
+						},
+						"container_id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Unique 24-hexadecimal digit string that identifies the network container of the connection.",
+						},
+						"provider_name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Cloud service provider of the connection.",
+						},
+						"status": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Current status of the connection, `status_name` for AWS and `status` for Azure and Google Cloud.",
+						},
+						"error_state": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Error of the connection, empty if there is no error.",
+						},
+						"healthy": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Flag that indicates whether the connection is `AVAILABLE`.",
+						},
+					},
+				},
+			},
+			"private_endpoints": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Private endpoint services of the project in all cloud providers, with their endpoints.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"endpoint_service_id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Unique 24-hexadecimal digit string that identifies the private endpoint service.",
+						},
+						"provider_name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Cloud service provider of the private endpoint service.",
+						},
+						"region": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Cloud provider region of the private endpoint service.",
+						},
+						"status": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Current status of the private endpoint service.",
+						},
+						"error_message": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Error of the private endpoint service, empty if there is no error.",
+						},
+						"healthy": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Flag that indicates whether the private endpoint service and all its endpoints are `AVAILABLE`.",
+						},
+						"endpoints": schema.ListNestedAttribute{
+							Computed:            true,
+							MarkdownDescription: "Endpoints of the private endpoint service.",
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"endpoint_id": schema.StringAttribute{
+										Computed:            true,
+										MarkdownDescription: "Identifier of the endpoint: the interface endpoint ID for AWS, the private endpoint resource ID for Azure and the endpoint group name for Google Cloud.",
+									},
+									"status": schema.StringAttribute{
+										Computed:            true,
+										MarkdownDescription: "Current status of the endpoint, `connection_status` for AWS and `status` for Azure and Google Cloud.",
+									},
+									"error_message": schema.StringAttribute{
+										Computed:            true,
+										MarkdownDescription: "Error of the endpoint, empty if there is no error.",
+									},
+									"healthy": schema.BoolAttribute{
+										Computed:            true,
+										MarkdownDescription: "Flag that indicates whether the endpoint is `AVAILABLE`.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"access_list": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Entries of the project IP access list.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"entry": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "IP address, CIDR block or AWS security group of the entry.",
+						},
+						"status": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Current status of the entry: `ACTIVE`, `PENDING` or `FAILED`.",
+						},
+						"healthy": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Flag that indicates whether the entry is `ACTIVE`.",
+						},
+					},
+				},
+			},
+			"clusters": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Clusters of the project with the private paths that can be used to reach them.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"cluster_name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Human-readable label that identifies the cluster.",
+						},
+						"reachable": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Flag that indicates whether at least one private path of the cluster is healthy.",
+						},
+						"private_paths": schema.ListNestedAttribute{
+							Computed:            true,
+							MarkdownDescription: "Private paths of the cluster: the private endpoints in its connection strings and, if the cluster has a private connection string, the network peering connections of its cloud providers.",
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"type": schema.StringAttribute{
+										Computed:            true,
+										MarkdownDescription: "Type of the private path: `NETWORK_PEERING` or `PRIVATE_ENDPOINT`.",
+									},
+									"id": schema.StringAttribute{
+										Computed:            true,
+										MarkdownDescription: "Identifier of the network peering connection or the endpoint.",
+									},
+									"provider_name": schema.StringAttribute{
+										Computed:            true,
+										MarkdownDescription: "Cloud service provider of the private path.",
+									},
+									"healthy": schema.BoolAttribute{
+										Computed:            true,
+										MarkdownDescription: "Flag that indicates whether the private path is `AVAILABLE`.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type TFModel struct {
+	ProjectID        types.String             `tfsdk:"project_id"`
+	NetworkPeering   []TFPeeringModel         `tfsdk:"network_peering"`
+	PrivateEndpoints []TFEndpointServiceModel `tfsdk:"private_endpoints"`
+	AccessList       []TFAccessListModel      `tfsdk:"access_list"`
+	Clusters         []TFClusterModel         `tfsdk:"clusters"`
+	Healthy          types.Bool               `tfsdk:"healthy"`
+}
+
+type TFPeeringModel struct {
+	PeeringID    types.String `tfsdk:"peering_id"`
+	ContainerID  types.String `tfsdk:"container_id"`
+	ProviderName types.String `tfsdk:"provider_name"`
+	Status       types.String `tfsdk:"status"`
+	ErrorState   types.String `tfsdk:"error_state"`
+	Healthy      types.Bool   `tfsdk:"healthy"`
+}
+
+type TFEndpointServiceModel struct {
+	EndpointServiceID types.String      `tfsdk:"endpoint_service_id"`
+	ProviderName      types.String      `tfsdk:"provider_name"`
+	Region            types.String      `tfsdk:"region"`
+	Status            types.String      `tfsdk:"status"`
+	ErrorMessage      types.String      `tfsdk:"error_message"`
+	Endpoints         []TFEndpointModel `tfsdk:"endpoints"`
+	Healthy           types.Bool        `tfsdk:"healthy"`
+}
+
+type TFEndpointModel struct {
+	EndpointID   types.String `tfsdk:"endpoint_id"`
+	Status       types.String `tfsdk:"status"`
+	ErrorMessage types.String `tfsdk:"error_message"`
+	Healthy      types.Bool   `tfsdk:"healthy"`
+}
+
+type TFAccessListModel struct {
+	Entry   types.String `tfsdk:"entry"`
+	Status  types.String `tfsdk:"status"`
+	Healthy types.Bool   `tfsdk:"healthy"`
+}
+
+type TFClusterModel struct {
+	ClusterName  types.String         `tfsdk:"cluster_name"`
+	PrivatePaths []TFPrivatePathModel `tfsdk:"private_paths"`
+	Reachable    types.Bool           `tfsdk:"reachable"`
+}
+
+type TFPrivatePathModel struct {
+	Type         types.String `tfsdk:"type"`
+	ID           types.String `tfsdk:"id"`
+	ProviderName types.String `tfsdk:"provider_name"`
+	Healthy      types.Bool   `tfsdk:"healthy"`
+}
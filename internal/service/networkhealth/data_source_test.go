@@ -0,0 +1,59 @@
+package networkhealth_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/testutil/acc"
+)
+
+const dataSourceName = "data.mongodbatlas_network_health.test"
+
+func TestAccNetworkHealthDS_basic(t *testing.T) {
+	var (
+		orgID       = os.Getenv("MONGODB_ATLAS_ORG_ID")
+		projectName = acc.RandomProjectName()
+		ipAddress   = acc.RandomIP(179, 154, 226)
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acc.PreCheckBasic(t) },
+		ProtoV6ProviderFactories: acc.TestAccProviderV6Factories,
+		CheckDestroy:             acc.CheckDestroyProject,
+		Steps: []resource.TestStep{
+			{
+				Config: configBasic(orgID, projectName, ipAddress),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "healthy"),
+					resource.TestCheckResourceAttr(dataSourceName, "network_peering.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "private_endpoints.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "clusters.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "access_list.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "access_list.0.entry", ipAddress),
+					resource.TestCheckResourceAttrSet(dataSourceName, "access_list.0.status"),
+				),
+			},
+		},
+	})
+}
+
+func configBasic(orgID, projectName, ipAddress string) string {
+	return fmt.Sprintf(`
+resource "mongodbatlas_project" "test" {
+  org_id = %[1]q
+  name   = %[2]q
+}
+
+resource "mongodbatlas_project_ip_access_list" "test" {
+  project_id = mongodbatlas_project.test.id
+  ip_address = %[3]q
+}
+
+data "mongodbatlas_network_health" "test" {
+  project_id = mongodbatlas_project_ip_access_list.test.project_id
+}
+`, orgID, projectName, ipAddress)
+}
@@ -0,0 +1,226 @@
+package networkhealth
+
+import (
+	"slices"
+	"strings"
+
+	"go.mongodb.org/atlas-sdk/v20250312003/admin"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	pathTypePeering         = "NETWORK_PEERING"
+	pathTypePrivateEndpoint = "PRIVATE_ENDPOINT"
+	statusAvailable         = "AVAILABLE"
+	statusActive            = "ACTIVE"
+)
+
+var providerNames = []string{"AWS", "AZURE", "GCP"}
+
+// EndpointService is a private endpoint service with its endpoints, as they are read with a different request.
+type EndpointService struct {
+	Service   *admin.EndpointService
+	Endpoints []admin.PrivateLinkEndpoint
+}
+
+// AccessListEntry is an access list entry with its status, as it's read with a different request.
+type AccessListEntry struct {
+	Entry  string
+	Status string
+}
+
+// Network contains the network connections of a project.
+type Network struct {
+	Peers            []admin.BaseNetworkPeeringConnectionSettings
+	EndpointServices []EndpointService
+	AccessList       []AccessListEntry
+	Clusters         []admin.ClusterDescription20240805
+}
+
+func NewTFModel(projectID string, network *Network) *TFModel {
+	model := &TFModel{
+		ProjectID:        types.StringValue(projectID),
+		NetworkPeering:   make([]TFPeeringModel, len(network.Peers)),
+		PrivateEndpoints: make([]TFEndpointServiceModel, len(network.EndpointServices)),
+		AccessList:       make([]TFAccessListModel, len(network.AccessList)),
+		Clusters:         make([]TFClusterModel, len(network.Clusters)),
+	}
+	healthy := true
+	for i := range network.Peers {
+		model.NetworkPeering[i] = newTFPeeringModel(&network.Peers[i])
+		healthy = healthy && model.NetworkPeering[i].Healthy.ValueBool()
+	}
+	for i := range network.EndpointServices {
+		model.PrivateEndpoints[i] = newTFEndpointServiceModel(&network.EndpointServices[i])
+		healthy = healthy && model.PrivateEndpoints[i].Healthy.ValueBool()
+	}
+	for i, entry := range network.AccessList {
+		model.AccessList[i] = TFAccessListModel{
+			Entry:   types.StringValue(entry.Entry),
+			Status:  types.StringValue(entry.Status),
+			Healthy: types.BoolValue(entry.Status == statusActive),
+		}
+		healthy = healthy && entry.Status == statusActive
+	}
+	for i := range network.Clusters {
+		model.Clusters[i] = newTFClusterModel(&network.Clusters[i], model.NetworkPeering, model.PrivateEndpoints)
+	}
+	model.Healthy = types.BoolValue(healthy)
+	return model
+}
+
+// PeerStatus returns the status name for AWS and the status for AZURE and GCP.
+func PeerStatus(peer *admin.BaseNetworkPeeringConnectionSettings) (status, errorState string) {
+	status, errorState = peer.GetStatus(), peer.GetErrorState()
+	if peer.GetStatusName() != "" {
+		status = peer.GetStatusName()
+	}
+	if peer.GetErrorStateName() != "" {
+		errorState = peer.GetErrorStateName()
+	}
+	if errorState == "" {
+		errorState = peer.GetErrorMessage()
+	}
+	return status, errorState
+}
+
+// EndpointIDs returns the IDs of the endpoints of a private endpoint service, which are stored in a different field for each provider.
+func EndpointIDs(service *admin.EndpointService) []string {
+	switch strings.ToUpper(service.CloudProvider) {
+	case "AZURE":
+		return service.GetPrivateEndpoints()
+	case "GCP":
+		return service.GetEndpointGroupNames()
+	default:
+		return service.GetInterfaceEndpoints()
+	}
+}
+
+// EndpointID returns the ID of an endpoint as it's used in the cluster connection strings.
+func EndpointID(endpoint *admin.PrivateLinkEndpoint) string {
+	switch strings.ToUpper(endpoint.CloudProvider) {
+	case "AZURE":
+		return endpoint.GetPrivateEndpointResourceId()
+	case "GCP":
+		return endpoint.GetEndpointGroupName()
+	default:
+		return endpoint.GetInterfaceEndpointId()
+	}
+}
+
+// EndpointStatus returns the connection status for AWS and the status for AZURE and GCP.
+func EndpointStatus(endpoint *admin.PrivateLinkEndpoint) string {
+	if strings.EqualFold(endpoint.CloudProvider, "AWS") {
+		return endpoint.GetConnectionStatus()
+	}
+	return endpoint.GetStatus()
+}
+
+func newTFPeeringModel(peer *admin.BaseNetworkPeeringConnectionSettings) TFPeeringModel {
+	status, errorState := PeerStatus(peer)
+	return TFPeeringModel{
+		PeeringID:    types.StringPointerValue(peer.Id),
+		ContainerID:  types.StringValue(peer.ContainerId),
+		ProviderName: types.StringValue(peer.GetProviderName()),
+		Status:       types.StringValue(status),
+		ErrorState:   types.StringValue(errorState),
+		Healthy:      types.BoolValue(status == statusAvailable),
+	}
+}
+
+func newTFEndpointServiceModel(service *EndpointService) TFEndpointServiceModel {
+	healthy := service.Service.GetStatus() == statusAvailable
+	endpoints := make([]TFEndpointModel, len(service.Endpoints))
+	for i := range service.Endpoints {
+		endpoint := &service.Endpoints[i]
+		status := EndpointStatus(endpoint)
+		endpoints[i] = TFEndpointModel{
+			EndpointID:   types.StringValue(EndpointID(endpoint)),
+			Status:       types.StringValue(status),
+			ErrorMessage: types.StringValue(endpoint.GetErrorMessage()),
+			Healthy:      types.BoolValue(status == statusAvailable),
+		}
+		healthy = healthy && status == statusAvailable
+	}
+	return TFEndpointServiceModel{
+		EndpointServiceID: types.StringPointerValue(service.Service.Id),
+		ProviderName:      types.StringValue(service.Service.CloudProvider),
+		Region:            types.StringPointerValue(service.Service.RegionName),
+		Status:            types.StringPointerValue(service.Service.Status),
+		ErrorMessage:      types.StringValue(service.Service.GetErrorMessage()),
+		Healthy:           types.BoolValue(healthy),
+		Endpoints:         endpoints,
+	}
+}
+
+// newTFClusterModel returns the private paths of a cluster: the private endpoints in its connection strings and, if it has a
+// private connection string, the peering connections of its cloud providers.
+func newTFClusterModel(cluster *admin.ClusterDescription20240805, peers []TFPeeringModel, services []TFEndpointServiceModel) TFClusterModel {
+	paths := []TFPrivatePathModel{}
+	connectionStrings := cluster.GetConnectionStrings()
+	if connectionStrings.GetPrivate() != "" || connectionStrings.GetPrivateSrv() != "" {
+		providers := clusterProviders(cluster)
+		for _, peer := range peers {
+			if slices.Contains(providers, peer.ProviderName.ValueString()) {
+				paths = append(paths, TFPrivatePathModel{
+					Type:         types.StringValue(pathTypePeering),
+					ID:           peer.PeeringID,
+					ProviderName: peer.ProviderName,
+					Healthy:      peer.Healthy,
+				})
+			}
+		}
+	}
+	for _, privateEndpoint := range connectionStrings.GetPrivateEndpoint() {
+		for _, endpoint := range privateEndpoint.GetEndpoints() {
+			paths = append(paths, TFPrivatePathModel{
+				Type:         types.StringValue(pathTypePrivateEndpoint),
+				ID:           types.StringPointerValue(endpoint.EndpointId),
+				ProviderName: types.StringPointerValue(endpoint.ProviderName),
+				Healthy:      types.BoolValue(isEndpointHealthy(services, endpoint.GetEndpointId())),
+			})
+		}
+	}
+	return TFClusterModel{
+		ClusterName:  types.StringPointerValue(cluster.Name),
+		Reachable:    types.BoolValue(slices.ContainsFunc(paths, func(path TFPrivatePathModel) bool { return path.Healthy.ValueBool() })),
+		PrivatePaths: paths,
+	}
+}
+
+func clusterProviders(cluster *admin.ClusterDescription20240805) []string {
+	var providers []string
+	for _, spec := range cluster.GetReplicationSpecs() {
+		for _, regionConfig := range spec.GetRegionConfigs() {
+			if provider := regionConfig.GetProviderName(); !slices.Contains(providers, provider) {
+				providers = append(providers, provider)
+			}
+		}
+	}
+	return providers
+}
+
+// isEndpointHealthy compares IDs case-insensitively as Azure returns endpoint resource IDs with a different case in the connection strings.
+func isEndpointHealthy(services []TFEndpointServiceModel, endpointID string) bool {
+	for _, service := range services {
+		for _, endpoint := range service.Endpoints {
+			if strings.EqualFold(endpoint.EndpointID.ValueString(), endpointID) {
+				return service.Status.ValueString() == statusAvailable && endpoint.Healthy.ValueBool()
+			}
+		}
+	}
+	return false
+}
+
+// AccessListEntryValue returns the value that identifies an access list entry, used in the Atlas API path.
+func AccessListEntryValue(entry *admin.NetworkPermissionEntry) string {
+	switch {
+	case entry.GetAwsSecurityGroup() != "":
+		return entry.GetAwsSecurityGroup()
+	case entry.GetIpAddress() != "":
+		return entry.GetIpAddress()
+	default:
+		return entry.GetCidrBlock()
+	}
+}
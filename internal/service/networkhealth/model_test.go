@@ -0,0 +1,157 @@
+package networkhealth_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/atlas-sdk/v20250312003/admin"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/conversion"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/networkhealth"
+)
+
+const (
+	projectID       = "111111111111111111111111"
+	peeringID       = "222222222222222222222222"
+	containerID     = "333333333333333333333333"
+	serviceID       = "444444444444444444444444"
+	awsEndpointID   = "vpce-123"
+	azureEndpointID = "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Network/privateEndpoints/pe"
+)
+
+func TestNewTFModel(t *testing.T) {
+	network := &networkhealth.Network{
+		Peers: []admin.BaseNetworkPeeringConnectionSettings{
+			{Id: conversion.StringPtr(peeringID), ContainerId: containerID, ProviderName: conversion.StringPtr("AWS"), StatusName: conversion.StringPtr("AVAILABLE")},
+		},
+		EndpointServices: []networkhealth.EndpointService{
+			{
+				Service: &admin.EndpointService{Id: conversion.StringPtr(serviceID), CloudProvider: "AWS", RegionName: conversion.StringPtr("US_EAST_1"), Status: conversion.StringPtr("AVAILABLE")},
+				Endpoints: []admin.PrivateLinkEndpoint{
+					{CloudProvider: "AWS", InterfaceEndpointId: conversion.StringPtr(awsEndpointID), ConnectionStatus: conversion.StringPtr("REJECTED"), ErrorMessage: conversion.StringPtr("rejected by AWS")},
+				},
+			},
+		},
+		AccessList: []networkhealth.AccessListEntry{{Entry: "10.0.0.1", Status: "ACTIVE"}},
+		Clusters: []admin.ClusterDescription20240805{
+			{
+				Name: conversion.StringPtr("cluster"),
+				ConnectionStrings: &admin.ClusterConnectionStrings{
+					Private: conversion.StringPtr("mongodb://private"),
+					PrivateEndpoint: &[]admin.ClusterDescriptionConnectionStringsPrivateEndpoint{
+						{Endpoints: &[]admin.ClusterDescriptionConnectionStringsPrivateEndpointEndpoint{
+							{EndpointId: conversion.StringPtr(awsEndpointID), ProviderName: conversion.StringPtr("AWS")},
+						}},
+					},
+				},
+				ReplicationSpecs: &[]admin.ReplicationSpec20240805{
+					{RegionConfigs: &[]admin.CloudRegionConfig20240805{{ProviderName: conversion.StringPtr("AWS")}}},
+				},
+			},
+			{Name: conversion.StringPtr("public")},
+		},
+	}
+	model := networkhealth.NewTFModel(projectID, network)
+	assert.False(t, model.Healthy.ValueBool())
+
+	require.Len(t, model.NetworkPeering, 1)
+	assert.Equal(t, "AVAILABLE", model.NetworkPeering[0].Status.ValueString())
+	assert.True(t, model.NetworkPeering[0].Healthy.ValueBool())
+
+	require.Len(t, model.PrivateEndpoints, 1)
+	assert.False(t, model.PrivateEndpoints[0].Healthy.ValueBool())
+	require.Len(t, model.PrivateEndpoints[0].Endpoints, 1)
+	assert.Equal(t, "REJECTED", model.PrivateEndpoints[0].Endpoints[0].Status.ValueString())
+	assert.Equal(t, "rejected by AWS", model.PrivateEndpoints[0].Endpoints[0].ErrorMessage.ValueString())
+
+	require.Len(t, model.AccessList, 1)
+	assert.True(t, model.AccessList[0].Healthy.ValueBool())
+
+	require.Len(t, model.Clusters, 2)
+	cluster := model.Clusters[0]
+	assert.True(t, cluster.Reachable.ValueBool())
+	require.Len(t, cluster.PrivatePaths, 2)
+	assert.Equal(t, "NETWORK_PEERING", cluster.PrivatePaths[0].Type.ValueString())
+	assert.Equal(t, peeringID, cluster.PrivatePaths[0].ID.ValueString())
+	assert.True(t, cluster.PrivatePaths[0].Healthy.ValueBool())
+	assert.Equal(t, "PRIVATE_ENDPOINT", cluster.PrivatePaths[1].Type.ValueString())
+	assert.Equal(t, awsEndpointID, cluster.PrivatePaths[1].ID.ValueString())
+	assert.False(t, cluster.PrivatePaths[1].Healthy.ValueBool())
+
+	assert.False(t, model.Clusters[1].Reachable.ValueBool())
+	assert.Empty(t, model.Clusters[1].PrivatePaths)
+}
+
+func TestNewTFModelHealthy(t *testing.T) {
+	network := &networkhealth.Network{
+		EndpointServices: []networkhealth.EndpointService{
+			{
+				Service: &admin.EndpointService{Id: conversion.StringPtr(serviceID), CloudProvider: "AZURE", Status: conversion.StringPtr("AVAILABLE")},
+				Endpoints: []admin.PrivateLinkEndpoint{
+					{CloudProvider: "AZURE", PrivateEndpointResourceId: conversion.StringPtr(azureEndpointID), Status: conversion.StringPtr("AVAILABLE")},
+				},
+			},
+		},
+		Clusters: []admin.ClusterDescription20240805{
+			{
+				Name: conversion.StringPtr("cluster"),
+				ConnectionStrings: &admin.ClusterConnectionStrings{
+					PrivateEndpoint: &[]admin.ClusterDescriptionConnectionStringsPrivateEndpoint{
+						{Endpoints: &[]admin.ClusterDescriptionConnectionStringsPrivateEndpointEndpoint{
+							{EndpointId: conversion.StringPtr("/SUBSCRIPTIONS/SUB/resourceGroups/rg/providers/Microsoft.Network/privateEndpoints/pe"), ProviderName: conversion.StringPtr("AZURE")},
+						}},
+					},
+				},
+			},
+		},
+	}
+	model := networkhealth.NewTFModel(projectID, network)
+	assert.True(t, model.Healthy.ValueBool())
+	require.Len(t, model.Clusters[0].PrivatePaths, 1)
+	assert.True(t, model.Clusters[0].PrivatePaths[0].Healthy.ValueBool(), "endpoint IDs are compared case-insensitively")
+	assert.True(t, model.Clusters[0].Reachable.ValueBool())
+}
+
+func TestPeerStatus(t *testing.T) {
+	testCases := map[string]struct {
+		peer               admin.BaseNetworkPeeringConnectionSettings
+		expectedStatus     string
+		expectedErrorState string
+	}{
+		"aws": {
+			peer:               admin.BaseNetworkPeeringConnectionSettings{StatusName: conversion.StringPtr("FAILED"), ErrorStateName: conversion.StringPtr("REJECTED")},
+			expectedStatus:     "FAILED",
+			expectedErrorState: "REJECTED",
+		},
+		"azure": {
+			peer:               admin.BaseNetworkPeeringConnectionSettings{Status: conversion.StringPtr("FAILED"), ErrorState: conversion.StringPtr("INVALID")},
+			expectedStatus:     "FAILED",
+			expectedErrorState: "INVALID",
+		},
+		"gcp": {
+			peer:               admin.BaseNetworkPeeringConnectionSettings{Status: conversion.StringPtr("FAILED"), ErrorMessage: conversion.StringPtr("network not found")},
+			expectedStatus:     "FAILED",
+			expectedErrorState: "network not found",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			status, errorState := networkhealth.PeerStatus(&tc.peer)
+			assert.Equal(t, tc.expectedStatus, status)
+			assert.Equal(t, tc.expectedErrorState, errorState)
+		})
+	}
+}
+
+func TestEndpointIDs(t *testing.T) {
+	assert.Equal(t, []string{awsEndpointID}, networkhealth.EndpointIDs(&admin.EndpointService{CloudProvider: "AWS", InterfaceEndpoints: &[]string{awsEndpointID}}))
+	assert.Equal(t, []string{azureEndpointID}, networkhealth.EndpointIDs(&admin.EndpointService{CloudProvider: "AZURE", PrivateEndpoints: &[]string{azureEndpointID}}))
+	assert.Equal(t, []string{"group"}, networkhealth.EndpointIDs(&admin.EndpointService{CloudProvider: "GCP", EndpointGroupNames: &[]string{"group"}}))
+}
+
+func TestAccessListEntryValue(t *testing.T) {
+	assert.Equal(t, "sg-1", networkhealth.AccessListEntryValue(&admin.NetworkPermissionEntry{AwsSecurityGroup: conversion.StringPtr("sg-1")}))
+	assert.Equal(t, "10.0.0.1", networkhealth.AccessListEntryValue(&admin.NetworkPermissionEntry{IpAddress: conversion.StringPtr("10.0.0.1"), CidrBlock: conversion.StringPtr("10.0.0.1/32")}))
+	assert.Equal(t, "10.0.0.0/16", networkhealth.AccessListEntryValue(&admin.NetworkPermissionEntry{CidrBlock: conversion.StringPtr("10.0.0.0/16")}))
+}
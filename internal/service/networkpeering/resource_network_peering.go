@@ -32,6 +32,7 @@ func Resource() *schema.Resource {
 		ReadContext:   resourceRead,
 		UpdateContext: resourceUpdate,
 		DeleteContext: resourceDelete,
+		CustomizeDiff: resourceCustomizeDiff,
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceImportState,
 		},
@@ -502,6 +503,51 @@ func resourceDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.
 	return nil
 }
 
+func resourceCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	if !d.NewValueKnown("route_table_cidr_block") || (d.Id() != "" && !d.HasChange("route_table_cidr_block")) {
+		return nil
+	}
+	rtCIDR := d.Get("route_table_cidr_block").(string)
+	if rtCIDR == "" {
+		return nil
+	}
+	reserved, err := validate.ReservedCIDROverlap(rtCIDR)
+	if err != nil {
+		return fmt.Errorf("invalid route_table_cidr_block: %w", err)
+	}
+	if reserved != "" {
+		return fmt.Errorf("invalid route_table_cidr_block: CIDR block %s overlaps the reserved range %s", rtCIDR, reserved)
+	}
+	if !d.NewValueKnown("project_id") || !d.NewValueKnown("container_id") {
+		return nil
+	}
+	conn := meta.(*config.MongoDBClient).AtlasV2
+	containerID := conversion.GetEncodedID(d.Get("container_id").(string), "container_id")
+	return CheckRouteTableOverlap(ctx, conn.NetworkPeeringApi, d.Get("project_id").(string), containerID, rtCIDR)
+}
+
+// CheckRouteTableOverlap returns an error if rtCIDR overlaps the Atlas CIDR block of the container, as traffic to the peered VPC
+// would never leave the Atlas VPC. The check is skipped if the container can't be read, e.g. when it's created in the same apply.
+func CheckRouteTableOverlap(ctx context.Context, api admin.NetworkPeeringApi, projectID, containerID, rtCIDR string) error {
+	container, _, err := api.GetPeeringContainer(ctx, projectID, containerID).Execute()
+	if err != nil {
+		log.Printf("[WARN] skipping route_table_cidr_block overlap check, couldn't read container %s in project %s: %s", containerID, projectID, err)
+		return nil
+	}
+	atlasCIDR := container.GetAtlasCidrBlock()
+	if atlasCIDR == "" {
+		return nil
+	}
+	overlap, err := validate.CIDRsOverlap(rtCIDR, atlasCIDR)
+	if err != nil {
+		return fmt.Errorf("invalid route_table_cidr_block: %w", err)
+	}
+	if overlap {
+		return fmt.Errorf("route_table_cidr_block %s overlaps the Atlas CIDR block %s of container %s", rtCIDR, atlasCIDR, containerID)
+	}
+	return nil
+}
+
 func resourceImportState(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
 	conn := meta.(*config.MongoDBClient).AtlasV2
 
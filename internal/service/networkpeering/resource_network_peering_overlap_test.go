@@ -0,0 +1,45 @@
+package networkpeering_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/atlas-sdk/v20250312003/admin"
+	"go.mongodb.org/atlas-sdk/v20250312003/mockadmin"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/conversion"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/networkpeering"
+)
+
+func TestCheckRouteTableOverlap(t *testing.T) {
+	testCases := map[string]struct {
+		getErr        error
+		rtCIDR        string
+		expectedError string
+	}{
+		"no overlap":                 {rtCIDR: "172.31.0.0/16"},
+		"overlap":                    {rtCIDR: "192.168.0.0/16", expectedError: "route_table_cidr_block 192.168.0.0/16 overlaps the Atlas CIDR block 192.168.208.0/21 of container c1"},
+		"read error skips the check": {rtCIDR: "192.168.0.0/16", getErr: errors.New("not found")},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			api := mockadmin.NewNetworkPeeringApi(t)
+			api.EXPECT().GetPeeringContainer(mock.Anything, "p1", "c1").Return(admin.GetPeeringContainerApiRequest{ApiService: api})
+			if tc.getErr != nil {
+				api.EXPECT().GetPeeringContainerExecute(mock.Anything).Return(nil, nil, tc.getErr)
+			} else {
+				container := &admin.CloudProviderContainer{Id: conversion.StringPtr("c1"), AtlasCidrBlock: conversion.StringPtr("192.168.208.0/21")}
+				api.EXPECT().GetPeeringContainerExecute(mock.Anything).Return(container, nil, nil)
+			}
+			err := networkpeering.CheckRouteTableOverlap(t.Context(), api, "p1", "c1", tc.rtCIDR)
+			if tc.expectedError == "" {
+				require.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, tc.expectedError)
+		})
+	}
+}
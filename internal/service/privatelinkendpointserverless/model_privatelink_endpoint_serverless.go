@@ -0,0 +1,84 @@
+package privatelinkendpointserverless
+
+import (
+	"fmt"
+	"strings"
+
+	"go.mongodb.org/atlas-sdk/v20250312003/admin"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/constant"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/conversion"
+)
+
+// ProviderNames are the cloud providers supported by serverless private endpoints.
+var ProviderNames = []string{constant.AWS, constant.AZURE}
+
+var failedStatuses = []string{"FAILED", "REJECTED"}
+
+func NewTFModel(projectID, instanceName, providerName string, endpoint *admin.ServerlessTenantEndpoint, timeout timeouts.Value) *TFModel {
+	return &TFModel{
+		ID:                           types.StringValue(EncodeStateID(projectID, instanceName, endpoint.GetId())),
+		ProjectID:                    types.StringValue(projectID),
+		InstanceName:                 types.StringValue(instanceName),
+		ProviderName:                 types.StringValue(providerName),
+		EndpointID:                   types.StringPointerValue(endpoint.Id),
+		EndpointServiceName:          types.StringValue(endpoint.GetEndpointServiceName()),
+		PrivateLinkServiceResourceID: types.StringValue(endpoint.GetPrivateLinkServiceResourceId()),
+		Status:                       types.StringValue(endpoint.GetStatus()),
+		ErrorMessage:                 types.StringValue(endpoint.GetErrorMessage()),
+		Timeouts:                     timeout,
+	}
+}
+
+// ProviderName returns the provider of an endpoint, inferred from the provider-specific attributes if Atlas doesn't return it.
+func ProviderName(endpoint *admin.ServerlessTenantEndpoint) string {
+	if providerName := endpoint.GetProviderName(); providerName != "" {
+		return providerName
+	}
+	if endpoint.GetPrivateLinkServiceResourceId() != "" {
+		return constant.AZURE
+	}
+	return constant.AWS
+}
+
+// CheckEndpointFailed returns an error if the endpoint is in a terminal failure state, including the reason given by Atlas.
+func CheckEndpointFailed(endpoint *admin.ServerlessTenantEndpoint) error {
+	status := endpoint.GetStatus()
+	for _, failedStatus := range failedStatuses {
+		if status == failedStatus {
+			reason := endpoint.GetErrorMessage()
+			if reason == "" {
+				reason = "no reason reported by Atlas"
+			}
+			return fmt.Errorf("private endpoint %s is %s: %s", endpoint.GetId(), status, reason)
+		}
+	}
+	return nil
+}
+
+func EncodeStateID(projectID, instanceName, endpointID string) string {
+	return conversion.EncodeStateID(map[string]string{
+		"project_id":    projectID,
+		"instance_name": instanceName,
+		"endpoint_id":   endpointID,
+	})
+}
+
+// SplitImportID parses the {project_id}/{instance_name}/{endpoint_id} import ID. The {project_id}--{instance_name}--{endpoint_id}
+// format is still accepted for backward compatibility.
+func SplitImportID(id string) (projectID, instanceName, endpointID string, err error) {
+	ok, projectID, instanceName, endpointID := conversion.ImportSplit3(id)
+	if !ok {
+		parts := strings.SplitN(id, "--", 3)
+		if len(parts) == 3 {
+			ok, projectID, instanceName, endpointID = true, parts[0], parts[1], parts[2]
+		}
+	}
+	if !ok || instanceName == "" || endpointID == "" {
+		return "", "", "", fmt.Errorf("import format error: to import a serverless private endpoint, use the format {project_id}/{instance_name}/{endpoint_id}, got %s", id)
+	}
+	return projectID, instanceName, endpointID, conversion.ValidateProjectID(projectID)
+}
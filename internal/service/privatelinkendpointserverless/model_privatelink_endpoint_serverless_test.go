@@ -0,0 +1,131 @@
+package privatelinkendpointserverless_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/atlas-sdk/v20250312003/admin"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/conversion"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/privatelinkendpointserverless"
+)
+
+const (
+	projectID    = "111111111111111111111111"
+	instanceName = "instance"
+	endpointID   = "222222222222222222222222"
+)
+
+func TestNewTFModel(t *testing.T) {
+	endpoint := &admin.ServerlessTenantEndpoint{
+		Id:                           conversion.StringPtr(endpointID),
+		PrivateLinkServiceResourceId: conversion.StringPtr("/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Network/privateLinkServices/pls"),
+		Status:                       conversion.StringPtr("RESERVED"),
+	}
+	timeout := timeouts.Value{}
+	expected := &privatelinkendpointserverless.TFModel{
+		ID:                           types.StringValue(privatelinkendpointserverless.EncodeStateID(projectID, instanceName, endpointID)),
+		ProjectID:                    types.StringValue(projectID),
+		InstanceName:                 types.StringValue(instanceName),
+		ProviderName:                 types.StringValue("AZURE"),
+		EndpointID:                   types.StringValue(endpointID),
+		EndpointServiceName:          types.StringValue(""),
+		PrivateLinkServiceResourceID: types.StringValue(endpoint.GetPrivateLinkServiceResourceId()),
+		Status:                       types.StringValue("RESERVED"),
+		ErrorMessage:                 types.StringValue(""),
+		Timeouts:                     timeout,
+	}
+	assert.Equal(t, expected, privatelinkendpointserverless.NewTFModel(projectID, instanceName, "AZURE", endpoint, timeout))
+}
+
+func TestProviderName(t *testing.T) {
+	testCases := map[string]struct {
+		endpoint admin.ServerlessTenantEndpoint
+		expected string
+	}{
+		"provider returned": {
+			endpoint: admin.ServerlessTenantEndpoint{ProviderName: conversion.StringPtr("AZURE")},
+			expected: "AZURE",
+		},
+		"azure inferred": {
+			endpoint: admin.ServerlessTenantEndpoint{PrivateLinkServiceResourceId: conversion.StringPtr("pls")},
+			expected: "AZURE",
+		},
+		"aws by default": {
+			endpoint: admin.ServerlessTenantEndpoint{EndpointServiceName: conversion.StringPtr("com.amazonaws.vpce")},
+			expected: "AWS",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, privatelinkendpointserverless.ProviderName(&tc.endpoint))
+		})
+	}
+}
+
+func TestCheckEndpointFailed(t *testing.T) {
+	testCases := map[string]struct {
+		status       string
+		errorMessage string
+		expectedErr  string
+	}{
+		"reserved": {
+			status: "RESERVED",
+		},
+		"failed with reason": {
+			status:       "FAILED",
+			errorMessage: "quota exceeded",
+			expectedErr:  "private endpoint " + endpointID + " is FAILED: quota exceeded",
+		},
+		"rejected without reason": {
+			status:      "REJECTED",
+			expectedErr: "private endpoint " + endpointID + " is REJECTED: no reason reported by Atlas",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			endpoint := &admin.ServerlessTenantEndpoint{
+				Id:           conversion.StringPtr(endpointID),
+				Status:       conversion.StringPtr(tc.status),
+				ErrorMessage: conversion.StringPtr(tc.errorMessage),
+			}
+			err := privatelinkendpointserverless.CheckEndpointFailed(endpoint)
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.EqualError(t, err, tc.expectedErr)
+		})
+	}
+}
+
+func TestSplitImportID(t *testing.T) {
+	testCases := map[string]struct {
+		importID    string
+		expectedErr bool
+	}{
+		"slash format":  {importID: projectID + "/" + instanceName + "/" + endpointID},
+		"legacy format": {importID: projectID + "--" + instanceName + "--" + endpointID},
+		"missing part":  {importID: projectID + "/" + instanceName, expectedErr: true},
+		"invalid project": {
+			importID:    "project/" + instanceName + "/" + endpointID,
+			expectedErr: true,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			gotProjectID, gotInstanceName, gotEndpointID, err := privatelinkendpointserverless.SplitImportID(tc.importID)
+			if tc.expectedErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, projectID, gotProjectID)
+			assert.Equal(t, instanceName, gotInstanceName)
+			assert.Equal(t, endpointID, gotEndpointID)
+		})
+	}
+}
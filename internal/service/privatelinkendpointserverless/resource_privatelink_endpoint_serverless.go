@@ -2,263 +2,177 @@ package privatelinkendpointserverless
 
 import (
 	"context"
-	"errors"
 	"fmt"
-	"log"
-	"strings"
 	"time"
 
 	"go.mongodb.org/atlas-sdk/v20250312003/admin"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
-	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/constant"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/conversion"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/validate"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/config"
-	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/privatelinkendpoint"
-	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/privatelinkendpointserviceserverless"
 )
 
 const (
+	resourceName                  = "privatelink_endpoint_serverless"
 	errorServerlessEndpointAdd    = "error adding MongoDB Serverless PrivateLink Endpoint Connection(%s): %s"
+	errorServerlessEndpointRead   = "error getting Serverless private link endpoint information(%s): %s"
 	errorServerlessEndpointDelete = "error deleting MongoDB Serverless PrivateLink Endpoint Connection(%s): %s"
+	timeoutCreateDelete           = 2 * time.Hour
+	minTimeout                    = 5 * time.Second
+	delay                         = 5 * time.Second
 )
 
-func Resource() *schema.Resource {
-	return &schema.Resource{
-		CreateContext: resourceCreate,
-		ReadContext:   resourceRead,
-		DeleteContext: resourceDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: resourceImport,
-		},
-		DeprecationMessage: fmt.Sprintf(constant.DeprecationResourceByDateWithExternalLink, "March 2025", "https://registry.terraform.io/providers/mongodb/mongodbatlas/latest/docs/guides/serverless-shared-migration-guide"),
-		Schema: map[string]*schema.Schema{
-			"project_id": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
-			},
-			"instance_name": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
-			},
-			"endpoint_id": {
-				Type:     schema.TypeString,
-				Computed: true,
-			},
-			"provider_name": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ForceNew:     true,
-				ValidateFunc: validation.StringInSlice([]string{"AWS", "AZURE"}, false),
-			},
-			"endpoint_service_name": {
-				Type:     schema.TypeString,
-				Computed: true,
-			},
-			"private_link_service_resource_id": {
-				Type:     schema.TypeString,
-				Computed: true,
-			},
-			"status": {
-				Type:     schema.TypeString,
-				Computed: true,
-			},
-		},
-		Timeouts: &schema.ResourceTimeout{
-			Create: schema.DefaultTimeout(2 * time.Hour),
-			Delete: schema.DefaultTimeout(2 * time.Hour),
+var _ resource.ResourceWithConfigure = &rs{}
+var _ resource.ResourceWithImportState = &rs{}
+
+func Resource() resource.Resource {
+	return &rs{
+		RSCommon: config.RSCommon{
+			ResourceName: resourceName,
 		},
 	}
 }
 
-func resourceCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	connV2 := meta.(*config.MongoDBClient).AtlasV2
-	projectID := d.Get("project_id").(string)
-	instanceName := d.Get("instance_name").(string)
+type rs struct {
+	config.RSCommon
+}
 
-	endPoint, _, err := connV2.ServerlessPrivateEndpointsApi.CreateServerlessPrivateEndpoint(ctx, projectID, instanceName, &admin.ServerlessTenantCreateRequest{}).Execute()
+func (r *rs) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = ResourceSchema(ctx)
+	conversion.UpdateSchemaDescription(&resp.Schema)
+}
+
+func (r *rs) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan TFModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	connV2 := r.Client.AtlasV2
+	projectID := plan.ProjectID.ValueString()
+	instanceName := plan.InstanceName.ValueString()
+	endpoint, _, err := connV2.ServerlessPrivateEndpointsApi.CreateServerlessPrivateEndpoint(ctx, projectID, instanceName, &admin.ServerlessTenantCreateRequest{}).Execute()
 	if err != nil {
-		return diag.Errorf(privatelinkendpointserviceserverless.ErrorServerlessServiceEndpointAdd, endPoint.GetCloudProviderEndpointId(), err)
+		resp.Diagnostics.AddError(fmt.Sprintf(errorServerlessEndpointAdd, instanceName, err), "")
+		return
 	}
-
-	stateConf := &retry.StateChangeConf{
-		Pending:    []string{"RESERVATION_REQUESTED", "INITIATING", "DELETING"},
-		Target:     []string{"RESERVED", "FAILED", "DELETED", "AVAILABLE"},
-		Refresh:    resourceRefreshFunc(ctx, connV2, projectID, instanceName, endPoint.GetId()),
-		Timeout:    d.Timeout(schema.TimeoutCreate),
-		MinTimeout: 5 * time.Second,
-		Delay:      5 * time.Second,
+	timeout, diags := plan.Timeouts.Create(ctx, timeoutCreateDelete)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
-
-	_, err = stateConf.WaitForStateContext(ctx)
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{"RESERVATION_REQUESTED", "INITIATING"},
+		Target:     []string{"RESERVED", "AVAILABLE"},
+		Refresh:    refreshFunc(ctx, connV2, projectID, instanceName, endpoint.GetId()),
+		Timeout:    timeout,
+		MinTimeout: minTimeout,
+		Delay:      delay,
+	}
+	result, err := stateConf.WaitForStateContext(ctx)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf(errorServerlessEndpointAdd, err, endPoint.GetId()))
+		resp.Diagnostics.AddError(fmt.Sprintf(errorServerlessEndpointAdd, endpoint.GetId(), err), "")
+		return
 	}
-
-	d.SetId(conversion.EncodeStateID(map[string]string{
-		"project_id":    projectID,
-		"instance_name": instanceName,
-		"endpoint_id":   endPoint.GetId(),
-	}))
-
-	return resourceRead(ctx, d, meta)
+	endpoint = result.(*admin.ServerlessTenantEndpoint)
+	resp.Diagnostics.Append(resp.State.Set(ctx, NewTFModel(projectID, instanceName, plan.ProviderName.ValueString(), endpoint, plan.Timeouts))...)
 }
 
-func resourceRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	connV2 := meta.(*config.MongoDBClient).AtlasV2
-	ids := conversion.DecodeStateID(d.Id())
-	projectID := ids["project_id"]
-	instanceName := ids["instance_name"]
-	endpointID := ids["endpoint_id"]
-
-	privateLinkResponse, _, err := connV2.ServerlessPrivateEndpointsApi.GetServerlessPrivateEndpoint(ctx, projectID, instanceName, endpointID).Execute()
+func (r *rs) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state TFModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ids := conversion.DecodeStateID(state.ID.ValueString())
+	projectID, instanceName, endpointID := ids["project_id"], ids["instance_name"], ids["endpoint_id"]
+	endpoint, apiResp, err := r.Client.AtlasV2.ServerlessPrivateEndpointsApi.GetServerlessPrivateEndpoint(ctx, projectID, instanceName, endpointID).Execute()
 	if err != nil {
-		// case 404/400: deleted in the backend case
-		if strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "400") {
-			d.SetId("")
-			return nil
+		if validate.StatusNotFound(apiResp) || validate.StatusBadRequest(apiResp) {
+			resp.State.RemoveResource(ctx)
+			return
 		}
-
-		return diag.Errorf("error getting Serverless private link endpoint  information: %s", err)
-	}
-
-	if err := d.Set("endpoint_id", privateLinkResponse.GetId()); err != nil {
-		return diag.Errorf("error setting `endpoint_id` for endpoint_id (%s): %s", d.Id(), err)
+		resp.Diagnostics.AddError(fmt.Sprintf(errorServerlessEndpointRead, endpointID, err), "")
+		return
 	}
-
-	if err := d.Set("instance_name", instanceName); err != nil {
-		return diag.Errorf("error setting `instance Name` for endpoint_id (%s): %s", d.Id(), err)
-	}
-
-	if err := d.Set("endpoint_service_name", privateLinkResponse.GetEndpointServiceName()); err != nil {
-		return diag.Errorf("error setting `endpoint_service_name Name` for endpoint_id (%s): %s", d.Id(), err)
-	}
-
-	if err := d.Set("private_link_service_resource_id", privateLinkResponse.GetPrivateLinkServiceResourceId()); err != nil {
-		return diag.Errorf("error setting `private_link_service_resource_id Name` for endpoint_id (%s): %s", d.Id(), err)
+	providerName := state.ProviderName.ValueString()
+	if providerName == "" {
+		providerName = ProviderName(endpoint)
 	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, NewTFModel(projectID, instanceName, providerName, endpoint, state.Timeouts))...)
+}
 
-	if err := d.Set("status", privateLinkResponse.GetStatus()); err != nil {
-		return diag.FromErr(fmt.Errorf(privatelinkendpoint.ErrorPrivateLinkEndpointsSetting, "status", d.Id(), err))
+func (r *rs) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// All attributes require replacement, only timeouts can change in place.
+	var plan TFModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
-
-	return nil
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
-func resourceDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	connV2 := meta.(*config.MongoDBClient).AtlasV2
-	ids := conversion.DecodeStateID(d.Id())
-	projectID := ids["project_id"]
-	instanceName := ids["instance_name"]
-	endpointID := ids["endpoint_id"]
-
-	_, _, err := connV2.ServerlessPrivateEndpointsApi.GetServerlessPrivateEndpoint(ctx, projectID, instanceName, endpointID).Execute()
+func (r *rs) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state TFModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	connV2 := r.Client.AtlasV2
+	ids := conversion.DecodeStateID(state.ID.ValueString())
+	projectID, instanceName, endpointID := ids["project_id"], ids["instance_name"], ids["endpoint_id"]
+	apiResp, err := connV2.ServerlessPrivateEndpointsApi.DeleteServerlessPrivateEndpoint(ctx, projectID, instanceName, endpointID).Execute()
 	if err != nil {
-		// case 404/400: deleted in the backend case
-		if strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "400") {
-			d.SetId("")
-			return nil
+		if validate.StatusNotFound(apiResp) || validate.StatusBadRequest(apiResp) {
+			return
 		}
-
-		return diag.Errorf("error getting Serverless private link endpoint  information: %s", err)
+		resp.Diagnostics.AddError(fmt.Sprintf(errorServerlessEndpointDelete, endpointID, err), "")
+		return
 	}
-
-	_, err = connV2.ServerlessPrivateEndpointsApi.DeleteServerlessPrivateEndpoint(ctx, projectID, instanceName, endpointID).Execute()
-	if err != nil {
-		return diag.Errorf("error deleting serverless private link endpoint(%s): %s", endpointID, err)
+	timeout, diags := state.Timeouts.Delete(ctx, timeoutCreateDelete)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
-
 	stateConf := &retry.StateChangeConf{
-		Pending:    []string{"DELETING"},
-		Target:     []string{"DELETED", "FAILED"},
-		Refresh:    resourceRefreshFunc(ctx, connV2, projectID, instanceName, endpointID),
-		Timeout:    d.Timeout(schema.TimeoutDelete),
-		MinTimeout: 5 * time.Second,
-		Delay:      5 * time.Second,
+		Pending:    []string{"DELETING", "RESERVED", "AVAILABLE"},
+		Target:     []string{"DELETED"},
+		Refresh:    refreshFunc(ctx, connV2, projectID, instanceName, endpointID),
+		Timeout:    timeout,
+		MinTimeout: minTimeout,
+		Delay:      delay,
 	}
-	// Wait, catching any errors
-	_, err = stateConf.WaitForStateContext(ctx)
-	if err != nil {
-		return diag.FromErr(fmt.Errorf(errorServerlessEndpointDelete, endpointID, err))
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf(errorServerlessEndpointDelete, endpointID, err), "")
 	}
-
-	return nil
 }
 
-func resourceImport(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
-	connV2 := meta.(*config.MongoDBClient).AtlasV2
-
-	parts := strings.SplitN(d.Id(), "--", 3)
-	if len(parts) != 3 {
-		return nil, errors.New("import format error: to import a search index, use the format {project_id}--{instance_name}--{endpoint_id}")
-	}
-
-	projectID := parts[0]
-	instanceName := parts[1]
-	endpointID := parts[2]
-
-	privateLinkResponse, _, err := connV2.ServerlessPrivateEndpointsApi.GetServerlessPrivateEndpoint(ctx, projectID, instanceName, endpointID).Execute()
+func (r *rs) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	projectID, instanceName, endpointID, err := SplitImportID(req.ID)
 	if err != nil {
-		return nil, fmt.Errorf("couldn't import serverless private link endpoint (%s) in projectID (%s) , error: %s", endpointID, projectID, err)
-	}
-
-	if err := d.Set("project_id", projectID); err != nil {
-		log.Printf("[WARN] Error setting project_id for (%s): %s", projectID, err)
-	}
-
-	if err := d.Set("endpoint_id", endpointID); err != nil {
-		log.Printf("[WARN] Error setting endpoint_id for (%s): %s", endpointID, err)
-	}
-	if err := d.Set("instance_name", instanceName); err != nil {
-		log.Printf("[WARN] Error setting instance_name for (%s): %s", endpointID, err)
-	}
-
-	if err := d.Set("endpoint_service_name", privateLinkResponse.GetEndpointServiceName()); err != nil {
-		log.Printf("[WARN] Error setting endpoint_service_name for (%s): %s", endpointID, err)
+		resp.Diagnostics.AddError("error splitting import ID", err.Error())
+		return
 	}
-
-	if privateLinkResponse.GetPrivateLinkServiceResourceId() != "" {
-		if err := d.Set("provider_name", "AZURE"); err != nil {
-			log.Printf("[WARN] Error setting provider_name for (%s): %s", endpointID, err)
-		}
-	} else {
-		if err := d.Set("provider_name", "AWS"); err != nil {
-			log.Printf("[WARN] Error setting provider_name for (%s): %s", endpointID, err)
-		}
-	}
-
-	d.SetId(conversion.EncodeStateID(map[string]string{
-		"project_id":    projectID,
-		"instance_name": instanceName,
-		"endpoint_id":   endpointID,
-	}))
-
-	return []*schema.ResourceData{d}, nil
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), EncodeStateID(projectID, instanceName, endpointID))...)
 }
 
-func resourceRefreshFunc(ctx context.Context, client *admin.APIClient, projectID, instanceName, privateLinkID string) retry.StateRefreshFunc {
+// refreshFunc returns the endpoint as long as it exists and fails fast if Atlas reports a terminal failure.
+func refreshFunc(ctx context.Context, client *admin.APIClient, projectID, instanceName, endpointID string) retry.StateRefreshFunc {
 	return func() (any, string, error) {
-		p, resp, err := client.ServerlessPrivateEndpointsApi.GetServerlessPrivateEndpoint(ctx, projectID, instanceName, privateLinkID).Execute()
+		endpoint, resp, err := client.ServerlessPrivateEndpointsApi.GetServerlessPrivateEndpoint(ctx, projectID, instanceName, endpointID).Execute()
 		if err != nil {
 			if validate.StatusNotFound(resp) || validate.StatusBadRequest(resp) {
 				return "", "DELETED", nil
 			}
-			return nil, "REJECTED", err
+			return nil, "", err
 		}
-
-		status := p.GetStatus()
-
-		if status != "WAITING_FOR_USER" {
-			return "", status, nil
+		if err := CheckEndpointFailed(endpoint); err != nil {
+			return nil, endpoint.GetStatus(), err
 		}
-
-		return p, status, nil
+		return endpoint, endpoint.GetStatus(), nil
 	}
 }
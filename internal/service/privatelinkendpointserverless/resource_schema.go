@@ -0,0 +1,108 @@
+package privatelinkendpointserverless
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/constant"
+)
+
+func ResourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		DeprecationMessage: fmt.Sprintf(constant.DeprecationResourceByDateWithExternalLink, "March 2025", "https://registry.terraform.io/providers/mongodb/mongodbatlas/latest/docs/guides/serverless-shared-migration-guide"),
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Unique 24-hexadecimal digit string that identifies your project.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"instance_name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Human-readable label that identifies the serverless instance.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"provider_name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Cloud provider of the private endpoint: `AWS` or `AZURE`. Google Cloud Private Service Connect is not supported for serverless instances.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(ProviderNames...),
+				},
+			},
+			"endpoint_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Unique 24-hexadecimal digit string that identifies the private endpoint.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"endpoint_service_name": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Unique string that identifies the AWS endpoint service to connect to.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"private_link_service_resource_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Root-relative path that identifies the Azure Private Link Service to connect to.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"status": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Current status of the private endpoint.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"error_message": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Error message of the private endpoint, empty if there is no error.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+type TFModel struct {
+	ID                           types.String   `tfsdk:"id"`
+	ProjectID                    types.String   `tfsdk:"project_id"`
+	InstanceName                 types.String   `tfsdk:"instance_name"`
+	ProviderName                 types.String   `tfsdk:"provider_name"`
+	EndpointID                   types.String   `tfsdk:"endpoint_id"`
+	EndpointServiceName          types.String   `tfsdk:"endpoint_service_name"`
+	PrivateLinkServiceResourceID types.String   `tfsdk:"private_link_service_resource_id"`
+	Status                       types.String   `tfsdk:"status"`
+	ErrorMessage                 types.String   `tfsdk:"error_message"`
+	Timeouts                     timeouts.Value `tfsdk:"timeouts"`
+}
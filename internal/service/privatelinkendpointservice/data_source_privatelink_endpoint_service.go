@@ -92,6 +92,21 @@ func DataSource() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"private_connection_strings": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"private_srv_connection_strings": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"private_srv_shard_optimized_connection_strings": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 		},
 	}
 }
@@ -138,6 +153,14 @@ func dataSourceRead(ctx context.Context, d *schema.ResourceData, meta any) diag.
 		}
 	}
 
+	clusters, err := listClusters(ctx, projectID, connV2.ClustersApi)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf(ErrorServiceEndpointRead, endpointServiceID, err))
+	}
+	if diags := setConnectionStrings(d, NewEndpointConnectionStrings(clusters, endpointServiceID), endpointServiceID); diags.HasError() {
+		return diags
+	}
+
 	d.SetId(conversion.EncodeStateID(map[string]string{
 		"project_id":          projectID,
 		"private_link_id":     privateLinkID,
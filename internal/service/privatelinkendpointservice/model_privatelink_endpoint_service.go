@@ -0,0 +1,103 @@
+package privatelinkendpointservice
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"go.mongodb.org/atlas-sdk/v20250312003/admin"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/dsschema"
+)
+
+var failedStatuses = []string{"REJECTED", "FAILED"}
+
+// EndpointConnectionStrings contains the private endpoint connection strings of each cluster in the project for a specific endpoint,
+// keyed by cluster name.
+type EndpointConnectionStrings struct {
+	Standard          map[string]string
+	SRV               map[string]string
+	SRVShardOptimized map[string]string
+}
+
+// EndpointStatus returns the connection status for AWS and the status for AZURE and GCP.
+func EndpointStatus(endpoint *admin.PrivateLinkEndpoint, providerName string) string {
+	if strings.EqualFold(providerName, "AWS") {
+		return endpoint.GetConnectionStatus()
+	}
+	return endpoint.GetStatus()
+}
+
+// CheckEndpointFailed returns an error if the endpoint is in a terminal failure state, including the reason given by Atlas or the cloud provider.
+func CheckEndpointFailed(endpoint *admin.PrivateLinkEndpoint, providerName string) error {
+	status := EndpointStatus(endpoint, providerName)
+	for _, failedStatus := range failedStatuses {
+		if status != failedStatus {
+			continue
+		}
+		var reasons []string
+		if msg := endpoint.GetErrorMessage(); msg != "" {
+			reasons = append(reasons, msg)
+		}
+		for _, rule := range endpoint.GetEndpoints() {
+			if rule.GetStatus() == failedStatus {
+				reasons = append(reasons, fmt.Sprintf("forwarding rule %s is %s", rule.GetEndpointName(), failedStatus))
+			}
+		}
+		reason := strings.Join(reasons, "; ")
+		if reason == "" {
+			reason = "no reason reported by " + providerName
+		}
+		return fmt.Errorf("private endpoint is %s: %s", status, reason)
+	}
+	return nil
+}
+
+// NewEndpointConnectionStrings returns the connection strings of the clusters that can be reached through endpointServiceID.
+// Azure returns endpoint resource IDs with a different case than the one used in the request, so IDs are compared case-insensitively.
+func NewEndpointConnectionStrings(clusters []admin.ClusterDescription20240805, endpointServiceID string) EndpointConnectionStrings {
+	result := EndpointConnectionStrings{
+		Standard:          map[string]string{},
+		SRV:               map[string]string{},
+		SRVShardOptimized: map[string]string{},
+	}
+	for i := range clusters {
+		cluster := &clusters[i]
+		connectionStrings := cluster.GetConnectionStrings()
+		for _, privateEndpoint := range connectionStrings.GetPrivateEndpoint() {
+			if !hasEndpoint(privateEndpoint.GetEndpoints(), endpointServiceID) {
+				continue
+			}
+			if value := privateEndpoint.GetConnectionString(); value != "" {
+				result.Standard[cluster.GetName()] = value
+			}
+			if value := privateEndpoint.GetSrvConnectionString(); value != "" {
+				result.SRV[cluster.GetName()] = value
+			}
+			if value := privateEndpoint.GetSrvShardOptimizedConnectionString(); value != "" {
+				result.SRVShardOptimized[cluster.GetName()] = value
+			}
+		}
+	}
+	return result
+}
+
+func hasEndpoint(endpoints []admin.ClusterDescriptionConnectionStringsPrivateEndpointEndpoint, endpointServiceID string) bool {
+	for _, endpoint := range endpoints {
+		if strings.EqualFold(endpoint.GetEndpointId(), endpointServiceID) {
+			return true
+		}
+	}
+	return false
+}
+
+func listClusters(ctx context.Context, projectID string, api admin.ClustersApi) ([]admin.ClusterDescription20240805, error) {
+	return dsschema.AllPages(ctx, func(ctx context.Context, pageNum int) (dsschema.PaginateResponse[admin.ClusterDescription20240805], *http.Response, error) {
+		params := &admin.ListClustersApiParams{
+			GroupId: projectID,
+			PageNum: &pageNum,
+		}
+		return api.ListClustersWithParams(ctx, params).Execute()
+	})
+}
@@ -0,0 +1,111 @@
+package privatelinkendpointservice_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/atlas-sdk/v20250312003/admin"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/conversion"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/privatelinkendpointservice"
+)
+
+func TestCheckEndpointFailed(t *testing.T) {
+	testCases := map[string]struct {
+		endpoint      admin.PrivateLinkEndpoint
+		providerName  string
+		expectedError string
+	}{
+		"aws available": {
+			endpoint:     admin.PrivateLinkEndpoint{ConnectionStatus: conversion.StringPtr("AVAILABLE")},
+			providerName: "AWS",
+		},
+		"aws rejected with reason": {
+			endpoint:      admin.PrivateLinkEndpoint{ConnectionStatus: conversion.StringPtr("REJECTED"), ErrorMessage: conversion.StringPtr("Interface endpoint vpce-1 was not found.")},
+			providerName:  "AWS",
+			expectedError: "private endpoint is REJECTED: Interface endpoint vpce-1 was not found.",
+		},
+		"aws uses connection status only": {
+			endpoint:     admin.PrivateLinkEndpoint{ConnectionStatus: conversion.StringPtr("PENDING"), Status: conversion.StringPtr("FAILED")},
+			providerName: "AWS",
+		},
+		"azure failed without reason": {
+			endpoint:      admin.PrivateLinkEndpoint{Status: conversion.StringPtr("FAILED")},
+			providerName:  "AZURE",
+			expectedError: "private endpoint is FAILED: no reason reported by AZURE",
+		},
+		"gcp failed forwarding rules": {
+			endpoint: admin.PrivateLinkEndpoint{
+				Status:       conversion.StringPtr("FAILED"),
+				ErrorMessage: conversion.StringPtr("quota exceeded"),
+				Endpoints: &[]admin.GCPConsumerForwardingRule{
+					{EndpointName: conversion.StringPtr("rule-0"), Status: conversion.StringPtr("AVAILABLE")},
+					{EndpointName: conversion.StringPtr("rule-1"), Status: conversion.StringPtr("FAILED")},
+				},
+			},
+			providerName:  "GCP",
+			expectedError: "private endpoint is FAILED: quota exceeded; forwarding rule rule-1 is FAILED",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := privatelinkendpointservice.CheckEndpointFailed(&tc.endpoint, tc.providerName)
+			if tc.expectedError == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, tc.expectedError)
+		})
+	}
+}
+
+func TestNewEndpointConnectionStrings(t *testing.T) {
+	endpointFor := func(ids ...string) *[]admin.ClusterDescriptionConnectionStringsPrivateEndpointEndpoint {
+		endpoints := make([]admin.ClusterDescriptionConnectionStringsPrivateEndpointEndpoint, 0, len(ids))
+		for _, id := range ids {
+			endpoints = append(endpoints, admin.ClusterDescriptionConnectionStringsPrivateEndpointEndpoint{EndpointId: conversion.StringPtr(id)})
+		}
+		return &endpoints
+	}
+	clusters := []admin.ClusterDescription20240805{
+		{
+			Name: conversion.StringPtr("replicaset"),
+			ConnectionStrings: &admin.ClusterConnectionStrings{
+				PrivateEndpoint: &[]admin.ClusterDescriptionConnectionStringsPrivateEndpoint{
+					{
+						ConnectionString:    conversion.StringPtr("mongodb://other-pl-0"),
+						SrvConnectionString: conversion.StringPtr("mongodb+srv://other-pl-0"),
+						Endpoints:           endpointFor("vpce-other"),
+					},
+					{
+						ConnectionString:    conversion.StringPtr("mongodb://replicaset-pl-1"),
+						SrvConnectionString: conversion.StringPtr("mongodb+srv://replicaset-pl-1"),
+						Endpoints:           endpointFor("vpce-other", "VPCE-1"),
+					},
+				},
+			},
+		},
+		{
+			Name: conversion.StringPtr("sharded"),
+			ConnectionStrings: &admin.ClusterConnectionStrings{
+				PrivateEndpoint: &[]admin.ClusterDescriptionConnectionStringsPrivateEndpoint{
+					{
+						ConnectionString:                  conversion.StringPtr("mongodb://sharded-pl-0"),
+						SrvConnectionString:               conversion.StringPtr("mongodb+srv://sharded-pl-0"),
+						SrvShardOptimizedConnectionString: conversion.StringPtr("mongodb+srv://sharded-pl-0-lb"),
+						Endpoints:                         endpointFor("vpce-1"),
+					},
+				},
+			},
+		},
+		{
+			Name: conversion.StringPtr("without-private-endpoints"),
+		},
+	}
+	expected := privatelinkendpointservice.EndpointConnectionStrings{
+		Standard:          map[string]string{"replicaset": "mongodb://replicaset-pl-1", "sharded": "mongodb://sharded-pl-0"},
+		SRV:               map[string]string{"replicaset": "mongodb+srv://replicaset-pl-1", "sharded": "mongodb+srv://sharded-pl-0"},
+		SRVShardOptimized: map[string]string{"sharded": "mongodb+srv://sharded-pl-0-lb"},
+	}
+	assert.Equal(t, expected, privatelinkendpointservice.NewEndpointConnectionStrings(clusters, "vpce-1"))
+}
@@ -126,6 +126,21 @@ func Resource() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"private_connection_strings": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"private_srv_connection_strings": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"private_srv_shard_optimized_connection_strings": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(2 * time.Hour),
@@ -178,11 +193,25 @@ func resourceCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.
 		Delay:      1 * time.Minute,
 	}
 	// Wait, catching any errors
-	_, err = stateConf.WaitForStateContext(ctx)
+	result, err := stateConf.WaitForStateContext(ctx)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf(errorServiceEndpointAdd, endpointServiceID, privateLinkID, err))
 	}
 
+	d.SetId(conversion.EncodeStateID(map[string]string{
+		"project_id":          projectID,
+		"private_link_id":     privateLinkID,
+		"endpoint_service_id": endpointServiceID,
+		"provider_name":       providerName,
+	}))
+
+	// The endpoint is kept in the state so it's deleted in Atlas when the tainted resource is replaced.
+	if endpoint, ok := result.(*admin.PrivateLinkEndpoint); ok {
+		if err := CheckEndpointFailed(endpoint, providerName); err != nil {
+			return diag.FromErr(fmt.Errorf(errorServiceEndpointAdd, endpointServiceID, privateLinkID, err))
+		}
+	}
+
 	clusterConf := &retry.StateChangeConf{
 		Pending:    []string{"REPEATING", "PENDING"},
 		Target:     []string{"IDLE", "DELETED"},
@@ -197,13 +226,6 @@ func resourceCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.
 		log.Printf(advancedcluster.ErrorAdvancedClusterListStatus, err)
 	}
 
-	d.SetId(conversion.EncodeStateID(map[string]string{
-		"project_id":          projectID,
-		"private_link_id":     privateLinkID,
-		"endpoint_service_id": endpointServiceID,
-		"provider_name":       providerName,
-	}))
-
 	return resourceRead(ctx, d, meta)
 }
 
@@ -273,12 +295,33 @@ func resourceRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Di
 		}
 	}
 
+	clusters, err := listClusters(ctx, projectID, connV2.ClustersApi)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf(ErrorServiceEndpointRead, endpointServiceID, err))
+	}
+	if diags := setConnectionStrings(d, NewEndpointConnectionStrings(clusters, endpointServiceID), endpointServiceID); diags.HasError() {
+		return diags
+	}
+
 	if privateEndpoint.GetErrorMessage() != "" {
 		return diag.FromErr(fmt.Errorf("privatelink endpoint service is in a failed state: %s", privateEndpoint.GetErrorMessage()))
 	}
 	return nil
 }
 
+func setConnectionStrings(d *schema.ResourceData, connectionStrings EndpointConnectionStrings, endpointServiceID string) diag.Diagnostics {
+	if err := d.Set("private_connection_strings", connectionStrings.Standard); err != nil {
+		return diag.FromErr(fmt.Errorf(ErrorEndpointSetting, "private_connection_strings", endpointServiceID, err))
+	}
+	if err := d.Set("private_srv_connection_strings", connectionStrings.SRV); err != nil {
+		return diag.FromErr(fmt.Errorf(ErrorEndpointSetting, "private_srv_connection_strings", endpointServiceID, err))
+	}
+	if err := d.Set("private_srv_shard_optimized_connection_strings", connectionStrings.SRVShardOptimized); err != nil {
+		return diag.FromErr(fmt.Errorf(ErrorEndpointSetting, "private_srv_shard_optimized_connection_strings", endpointServiceID, err))
+	}
+	return nil
+}
+
 func resourceDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	connV2 := meta.(*config.MongoDBClient).AtlasV2
 
@@ -382,17 +425,7 @@ func resourceRefreshFunc(ctx context.Context, client *admin.APIClient, projectID
 			return nil, "", err
 		}
 
-		if strings.EqualFold(providerName, "azure") || strings.EqualFold(providerName, "gcp") {
-			if i.GetStatus() != "AVAILABLE" {
-				return "", i.GetStatus(), nil
-			}
-			return i, *i.Status, nil
-		}
-		if i.GetConnectionStatus() != "AVAILABLE" {
-			return "", i.GetConnectionStatus(), nil
-		}
-
-		return i, *i.ConnectionStatus, nil
+		return i, EndpointStatus(i, providerName), nil
 	}
 }
 
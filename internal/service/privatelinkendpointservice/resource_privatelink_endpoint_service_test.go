@@ -36,7 +36,7 @@ func TestAccNetworkRSPrivateLinkEndpointServiceAWS_Failed(t *testing.T) {
 				Config: configFailAWS(
 					projectID, providerName, region, resourceSuffix,
 				),
-				ExpectError: regexp.MustCompile("privatelink endpoint service is in a failed state: Interface endpoint vpce-11111111111111111 was not found."),
+				ExpectError: regexp.MustCompile("private endpoint is (REJECTED|FAILED): Interface endpoint vpce-11111111111111111 was not found."),
 			},
 		},
 	})
@@ -64,6 +64,7 @@ func basicAWSTestCase(tb testing.TB) *resource.TestCase {
 	checks := []resource.TestCheckFunc{checkExists(resourceName)}
 	checks = acc.AddAttrSetChecks(resourceName, checks, checkAttrs...)
 	checks = acc.AddAttrSetChecks(datasourceName, checks, checkAttrs...)
+	checks = acc.AddAttrSetChecks(resourceName, checks, "private_connection_strings.%", "private_srv_connection_strings.%")
 
 	return &resource.TestCase{
 		PreCheck:                 func() { acc.PreCheck(tb); acc.PreCheckAwsEnvPrivateLinkEndpointService(tb) },
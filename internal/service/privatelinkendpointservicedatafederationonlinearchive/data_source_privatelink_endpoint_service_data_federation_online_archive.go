@@ -3,6 +3,8 @@ package privatelinkendpointservicedatafederationonlinearchive
 import (
 	"context"
 
+	"go.mongodb.org/atlas-sdk/v20250312003/admin"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/config"
@@ -59,3 +61,33 @@ func dataSourceRead(ctx context.Context, d *schema.ResourceData, meta any) diag.
 	}
 	return nil
 }
+
+func populateResourceData(d *schema.ResourceData, privateEndpoint *admin.PrivateNetworkEndpointIdEntry, projectID, endpointID string) error {
+	if err := d.Set("comment", privateEndpoint.GetComment()); err != nil {
+		return err
+	}
+
+	if err := d.Set("provider_name", privateEndpoint.GetProvider()); err != nil {
+		return err
+	}
+
+	if err := d.Set("type", privateEndpoint.GetType()); err != nil {
+		return err
+	}
+
+	if err := d.Set("region", privateEndpoint.GetRegion()); err != nil {
+		return err
+	}
+
+	if err := d.Set("endpoint_id", privateEndpoint.GetEndpointId()); err != nil {
+		return err
+	}
+
+	if err := d.Set("project_id", projectID); err != nil {
+		return err
+	}
+
+	d.SetId(EncodeStateID(projectID, endpointID))
+
+	return d.Set("customer_endpoint_dns_name", privateEndpoint.GetCustomerEndpointDNSName())
+}
@@ -0,0 +1,111 @@
+package privatelinkendpointservicedatafederationonlinearchive
+
+import (
+	"fmt"
+	"strings"
+
+	"go.mongodb.org/atlas-sdk/v20250312003/admin"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/constant"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/conversion"
+)
+
+const (
+	endpointType = "DATA_LAKE"
+	statusOK     = "OK"
+	statusFailed = "FAILED"
+)
+
+var providerNames = []string{constant.AWS, constant.AZURE}
+
+func NewTFModel(projectID string, entry *admin.PrivateNetworkEndpointIdEntry, timeout timeouts.Value) *TFModel {
+	model := &TFModel{
+		ID:                      types.StringValue(EncodeStateID(projectID, entry.GetEndpointId())),
+		ProjectID:               types.StringValue(projectID),
+		EndpointID:              types.StringValue(entry.GetEndpointId()),
+		ProviderName:            types.StringValue(entry.GetProvider()),
+		Comment:                 types.StringValue(entry.GetComment()),
+		Region:                  types.StringValue(entry.GetRegion()),
+		CustomerEndpointDNSName: types.StringValue(entry.GetCustomerEndpointDNSName()),
+		AzureLinkID:             types.StringValue(entry.GetAzureLinkId()),
+		Type:                    types.StringValue(entry.GetType()),
+		Status:                  types.StringValue(entry.GetStatus()),
+		ErrorMessage:            types.StringValue(entry.GetErrorMessage()),
+		Timeouts:                timeout,
+	}
+	if ipAddress := entry.GetCustomerEndpointIPAddress(); ipAddress != "" {
+		model.Azure = &TFAzureModel{
+			CustomerEndpointIPAddress: types.StringValue(ipAddress),
+		}
+	}
+	return model
+}
+
+func NewEntryReq(plan *TFModel) *admin.PrivateNetworkEndpointIdEntry {
+	entry := &admin.PrivateNetworkEndpointIdEntry{
+		EndpointId:              plan.EndpointID.ValueString(),
+		Type:                    conversion.StringPtr(endpointType),
+		Comment:                 plan.Comment.ValueStringPointer(),
+		Provider:                conversion.StringPtr(plan.ProviderName.ValueString()),
+		Region:                  conversion.NilForUnknownOrEmptyString(plan.Region),
+		CustomerEndpointDNSName: conversion.NilForUnknownOrEmptyString(plan.CustomerEndpointDNSName),
+	}
+	if plan.Azure != nil {
+		entry.CustomerEndpointIPAddress = plan.Azure.CustomerEndpointIPAddress.ValueStringPointer()
+	}
+	return entry
+}
+
+// ValidateProviderConfig checks that the provider-specific attributes match provider_name.
+func ValidateProviderConfig(cfg *TFModel) (errs map[string]string) {
+	errs = make(map[string]string)
+	providerName := cfg.ProviderName.ValueString()
+	if cfg.ProviderName.IsUnknown() || providerName == "" {
+		return errs
+	}
+	if cfg.Azure != nil && providerName != constant.AZURE {
+		errs["azure"] = fmt.Sprintf("azure can only be used when provider_name is AZURE, got %s", providerName)
+	}
+	return errs
+}
+
+// EndpointStatus returns OK when Atlas doesn't report a status as not all providers require approving the connection.
+func EndpointStatus(entry *admin.PrivateNetworkEndpointIdEntry) (string, error) {
+	switch status := entry.GetStatus(); status {
+	case "", statusOK:
+		return statusOK, nil
+	case statusFailed:
+		reason := entry.GetErrorMessage()
+		if reason == "" {
+			reason = "no reason reported by Atlas"
+		}
+		return status, fmt.Errorf("private endpoint %s is %s: %s", entry.GetEndpointId(), status, reason)
+	default:
+		return status, nil
+	}
+}
+
+func EncodeStateID(projectID, endpointID string) string {
+	return conversion.EncodeStateID(map[string]string{
+		"project_id":  projectID,
+		"endpoint_id": endpointID,
+	})
+}
+
+// SplitImportID parses the {project_id}/{endpoint_id} import ID. The {project_id}--{endpoint_id} format is still accepted for backward compatibility.
+func SplitImportID(id string) (projectID, endpointID string, err error) {
+	ok, projectID, endpointID := conversion.ImportSplit2(id)
+	if !ok {
+		parts := strings.Split(id, "--")
+		if len(parts) == 2 {
+			ok, projectID, endpointID = true, parts[0], parts[1]
+		}
+	}
+	if !ok || endpointID == "" {
+		return "", "", fmt.Errorf("import format error: to import a Data Federation private endpoint, use the format {project_id}/{endpoint_id}, got %s", id)
+	}
+	return projectID, endpointID, conversion.ValidateProjectID(projectID)
+}
@@ -0,0 +1,176 @@
+package privatelinkendpointservicedatafederationonlinearchive_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/atlas-sdk/v20250312003/admin"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/conversion"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/privatelinkendpointservicedatafederationonlinearchive"
+)
+
+const (
+	projectID  = "111111111111111111111111"
+	endpointID = "vpce-3bf78b0ddee411ba1"
+	ipAddress  = "10.0.0.4"
+)
+
+func TestNewTFModel(t *testing.T) {
+	testCases := map[string]struct {
+		entry    admin.PrivateNetworkEndpointIdEntry
+		expected privatelinkendpointservicedatafederationonlinearchive.TFModel
+	}{
+		"aws": {
+			entry: admin.PrivateNetworkEndpointIdEntry{
+				EndpointId:              endpointID,
+				Provider:                conversion.StringPtr("AWS"),
+				Type:                    conversion.StringPtr("DATA_LAKE"),
+				Region:                  conversion.StringPtr("US_EAST_1"),
+				CustomerEndpointDNSName: conversion.StringPtr("vpce.amazonaws.com"),
+			},
+			expected: privatelinkendpointservicedatafederationonlinearchive.TFModel{
+				ID:                      types.StringValue(privatelinkendpointservicedatafederationonlinearchive.EncodeStateID(projectID, endpointID)),
+				ProjectID:               types.StringValue(projectID),
+				EndpointID:              types.StringValue(endpointID),
+				ProviderName:            types.StringValue("AWS"),
+				Comment:                 types.StringValue(""),
+				Region:                  types.StringValue("US_EAST_1"),
+				CustomerEndpointDNSName: types.StringValue("vpce.amazonaws.com"),
+				AzureLinkID:             types.StringValue(""),
+				Type:                    types.StringValue("DATA_LAKE"),
+				Status:                  types.StringValue(""),
+				ErrorMessage:            types.StringValue(""),
+			},
+		},
+		"azure": {
+			entry: admin.PrivateNetworkEndpointIdEntry{
+				EndpointId:                endpointID,
+				Provider:                  conversion.StringPtr("AZURE"),
+				Type:                      conversion.StringPtr("DATA_LAKE"),
+				Comment:                   conversion.StringPtr("comment"),
+				AzureLinkId:               conversion.StringPtr("link"),
+				CustomerEndpointIPAddress: conversion.StringPtr(ipAddress),
+				Status:                    conversion.StringPtr("OK"),
+			},
+			expected: privatelinkendpointservicedatafederationonlinearchive.TFModel{
+				ID:                      types.StringValue(privatelinkendpointservicedatafederationonlinearchive.EncodeStateID(projectID, endpointID)),
+				ProjectID:               types.StringValue(projectID),
+				EndpointID:              types.StringValue(endpointID),
+				ProviderName:            types.StringValue("AZURE"),
+				Comment:                 types.StringValue("comment"),
+				Region:                  types.StringValue(""),
+				CustomerEndpointDNSName: types.StringValue(""),
+				AzureLinkID:             types.StringValue("link"),
+				Type:                    types.StringValue("DATA_LAKE"),
+				Status:                  types.StringValue("OK"),
+				ErrorMessage:            types.StringValue(""),
+				Azure: &privatelinkendpointservicedatafederationonlinearchive.TFAzureModel{
+					CustomerEndpointIPAddress: types.StringValue(ipAddress),
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, &tc.expected, privatelinkendpointservicedatafederationonlinearchive.NewTFModel(projectID, &tc.entry, timeouts.Value{}))
+		})
+	}
+}
+
+func TestNewEntryReq(t *testing.T) {
+	plan := &privatelinkendpointservicedatafederationonlinearchive.TFModel{
+		EndpointID:              types.StringValue(endpointID),
+		ProviderName:            types.StringValue("AZURE"),
+		Comment:                 types.StringValue(""),
+		Region:                  types.StringUnknown(),
+		CustomerEndpointDNSName: types.StringUnknown(),
+		Azure: &privatelinkendpointservicedatafederationonlinearchive.TFAzureModel{
+			CustomerEndpointIPAddress: types.StringValue(ipAddress),
+		},
+	}
+	expected := &admin.PrivateNetworkEndpointIdEntry{
+		EndpointId:                endpointID,
+		Type:                      conversion.StringPtr("DATA_LAKE"),
+		Comment:                   admin.PtrString(""),
+		Provider:                  conversion.StringPtr("AZURE"),
+		CustomerEndpointIPAddress: conversion.StringPtr(ipAddress),
+	}
+	assert.Equal(t, expected, privatelinkendpointservicedatafederationonlinearchive.NewEntryReq(plan))
+}
+
+func TestValidateProviderConfig(t *testing.T) {
+	azure := &privatelinkendpointservicedatafederationonlinearchive.TFAzureModel{}
+	assert.Empty(t, privatelinkendpointservicedatafederationonlinearchive.ValidateProviderConfig(&privatelinkendpointservicedatafederationonlinearchive.TFModel{
+		ProviderName: types.StringValue("AZURE"),
+		Azure:        azure,
+	}))
+	assert.Contains(t, privatelinkendpointservicedatafederationonlinearchive.ValidateProviderConfig(&privatelinkendpointservicedatafederationonlinearchive.TFModel{
+		ProviderName: types.StringValue("AWS"),
+		Azure:        azure,
+	}), "azure")
+}
+
+func TestEndpointStatus(t *testing.T) {
+	testCases := map[string]struct {
+		entry          admin.PrivateNetworkEndpointIdEntry
+		expectedStatus string
+		expectedErr    string
+	}{
+		"no status": {
+			entry:          admin.PrivateNetworkEndpointIdEntry{EndpointId: endpointID},
+			expectedStatus: "OK",
+		},
+		"pending": {
+			entry:          admin.PrivateNetworkEndpointIdEntry{EndpointId: endpointID, Status: conversion.StringPtr("PENDING")},
+			expectedStatus: "PENDING",
+		},
+		"failed": {
+			entry: admin.PrivateNetworkEndpointIdEntry{
+				EndpointId:   endpointID,
+				Status:       conversion.StringPtr("FAILED"),
+				ErrorMessage: conversion.StringPtr("connection rejected"),
+			},
+			expectedStatus: "FAILED",
+			expectedErr:    "private endpoint " + endpointID + " is FAILED: connection rejected",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			status, err := privatelinkendpointservicedatafederationonlinearchive.EndpointStatus(&tc.entry)
+			assert.Equal(t, tc.expectedStatus, status)
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.EqualError(t, err, tc.expectedErr)
+		})
+	}
+}
+
+func TestSplitImportID(t *testing.T) {
+	testCases := map[string]struct {
+		importID    string
+		expectedErr bool
+	}{
+		"slash format":    {importID: projectID + "/" + endpointID},
+		"legacy format":   {importID: projectID + "--" + endpointID},
+		"missing part":    {importID: projectID, expectedErr: true},
+		"invalid project": {importID: "project/" + endpointID, expectedErr: true},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			gotProjectID, gotEndpointID, err := privatelinkendpointservicedatafederationonlinearchive.SplitImportID(tc.importID)
+			if tc.expectedErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, projectID, gotProjectID)
+			assert.Equal(t, endpointID, gotEndpointID)
+		})
+	}
+}
@@ -2,254 +2,211 @@ package privatelinkendpointservicedatafederationonlinearchive
 
 import (
 	"context"
-	"errors"
 	"fmt"
-	"strings"
 	"time"
 
 	"go.mongodb.org/atlas-sdk/v20250312003/admin"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/conversion"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/validate"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/config"
 )
 
 const (
+	resourceName                                                 = "privatelink_endpoint_service_data_federation_online_archive"
 	errorPrivateEndpointServiceDataFederationOnlineArchiveCreate = "error creating a Private Endpoint for projectId %s: %s"
 	errorPrivateEndpointServiceDataFederationOnlineArchiveDelete = "error deleting Private Endpoint %s for projectId %s: %s"
 	errorPrivateEndpointServiceDataFederationOnlineArchiveRead   = "error reading Private Endpoint %s for projectId %s: %s"
 	errorPrivateEndpointServiceDataFederationOnlineArchiveUpdate = "error updating a Private Endpoint for projectId %s: %s"
-	errorPrivateEndpointServiceDataFederationOnlineArchiveImport = "error importing Private Endpoint %s for projectId %s: %w"
-	endpointType                                                 = "DATA_LAKE"
+	timeoutCreateDelete                                          = 2 * time.Hour
+	minTimeout                                                   = 5 * time.Second
+	delay                                                        = 5 * time.Second
 )
 
-func Resource() *schema.Resource {
-	return &schema.Resource{
-		CreateContext: resourceCreate,
-		ReadContext:   resourceRead,
-		UpdateContext: resourceUpdate,
-		DeleteContext: resourceDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: resourceImport,
-		},
-		Schema: map[string]*schema.Schema{
-			"project_id": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
-			},
-			"endpoint_id": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
-			},
-			"provider_name": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
-			},
-			"comment": {
-				Type:     schema.TypeString,
-				Optional: true,
-			},
-			"region": {
-				Type:             schema.TypeString,
-				Optional:         true,
-				ForceNew:         true,
-				ValidateDiagFunc: validate.StringIsUppercase(),
-			},
-			"customer_endpoint_dns_name": {
-				Type:     schema.TypeString,
-				Optional: true,
-				ForceNew: true,
-			},
-			"type": {
-				Type:     schema.TypeString,
-				Computed: true,
-			},
-		},
-		// Timeouts are not being used but kept to avoid breaking changes.
-		Timeouts: &schema.ResourceTimeout{
-			Create: schema.DefaultTimeout(2 * time.Hour),
-			Delete: schema.DefaultTimeout(2 * time.Hour),
+var _ resource.ResourceWithConfigure = &rs{}
+var _ resource.ResourceWithImportState = &rs{}
+var _ resource.ResourceWithValidateConfig = &rs{}
+
+func Resource() resource.Resource {
+	return &rs{
+		RSCommon: config.RSCommon{
+			ResourceName: resourceName,
 		},
 	}
 }
 
-func resourceCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	connV2 := meta.(*config.MongoDBClient).AtlasV2
-	projectID := d.Get("project_id").(string)
-	endpointID := d.Get("endpoint_id").(string)
-
-	_, _, err := connV2.DataFederationApi.CreateDataFederationPrivateEndpoint(ctx, projectID, newPrivateNetworkEndpointIDEntry(d)).Execute()
-	if err != nil {
-		return diag.FromErr(fmt.Errorf(errorPrivateEndpointServiceDataFederationOnlineArchiveCreate, projectID, err))
-	}
-
-	d.SetId(conversion.EncodeStateID(map[string]string{
-		"project_id":  projectID,
-		"endpoint_id": endpointID,
-	}))
-
-	return resourceRead(ctx, d, meta)
+type rs struct {
+	config.RSCommon
 }
 
-func resourceRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	connV2 := meta.(*config.MongoDBClient).AtlasV2
-	ids := conversion.DecodeStateID(d.Id())
-	projectID := ids["project_id"]
-	endpointID := ids["endpoint_id"]
-
-	privateEndpoint, resp, err := connV2.DataFederationApi.GetDataFederationPrivateEndpoint(ctx, projectID, endpointID).Execute()
-	if err != nil {
-		if validate.StatusNotFound(resp) {
-			d.SetId("")
-			return nil
-		}
-		return diag.Errorf(errorPrivateEndpointServiceDataFederationOnlineArchiveRead, endpointID, projectID, err)
-	}
-
-	err = populateResourceData(d, privateEndpoint, projectID, endpointID)
-	if err != nil {
-		return diag.Errorf(errorPrivateEndpointServiceDataFederationOnlineArchiveRead, endpointID, projectID, err)
-	}
-	return nil
+func (r *rs) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = ResourceSchema(ctx)
+	conversion.UpdateSchemaDescription(&resp.Schema)
 }
 
-func resourceUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	connV2 := meta.(*config.MongoDBClient).AtlasV2
-	ids := conversion.DecodeStateID(d.Id())
-	projectID := ids["project_id"]
-	endpointID := ids["endpoint_id"]
-	privateEndpoint, resp, err := connV2.DataFederationApi.GetDataFederationPrivateEndpoint(ctx, projectID, endpointID).Execute()
-	if err != nil {
-		if validate.StatusNotFound(resp) {
-			d.SetId("")
-			return nil
-		}
-		return diag.Errorf(errorPrivateEndpointServiceDataFederationOnlineArchiveRead, endpointID, projectID, err)
+func (r *rs) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var cfg TFModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &cfg)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
-
-	if d.HasChange("comment") {
-		privateEndpoint.Comment = conversion.StringPtr(d.Get("comment").(string))
-		_, _, err = connV2.DataFederationApi.CreateDataFederationPrivateEndpoint(ctx, projectID, privateEndpoint).Execute()
-		if err != nil {
-			return diag.Errorf(errorPrivateEndpointServiceDataFederationOnlineArchiveCreate, endpointID, projectID)
-		}
+	for attrName, msg := range ValidateProviderConfig(&cfg) {
+		resp.Diagnostics.AddAttributeError(path.Root(attrName), "Invalid provider-specific attribute", msg)
 	}
-	return resourceRead(ctx, d, meta)
 }
 
-func resourceDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	connV2 := meta.(*config.MongoDBClient).AtlasV2
-	ids := conversion.DecodeStateID(d.Id())
-	projectID := ids["project_id"]
-	endpointID := ids["endpoint_id"]
-
-	_, err := connV2.DataFederationApi.DeleteDataFederationPrivateEndpoint(ctx, projectID, endpointID).Execute()
-	if err != nil {
-		return diag.FromErr(fmt.Errorf(errorPrivateEndpointServiceDataFederationOnlineArchiveDelete, endpointID, projectID, err))
+func (r *rs) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan TFModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
-
-	d.SetId("")
-
-	return nil
-}
-
-func resourceImport(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
-	connV2 := meta.(*config.MongoDBClient).AtlasV2
-	projectID, endpointID, err := splitAtlasPrivatelinkEndpointServiceDataFederationOnlineArchive(d.Id())
-	if err != nil {
-		return nil, err
+	connV2 := r.Client.AtlasV2
+	projectID := plan.ProjectID.ValueString()
+	endpointID := plan.EndpointID.ValueString()
+	if _, _, err := connV2.DataFederationApi.CreateDataFederationPrivateEndpoint(ctx, projectID, NewEntryReq(&plan)).Execute(); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf(errorPrivateEndpointServiceDataFederationOnlineArchiveCreate, projectID, err), "")
+		return
 	}
-
-	privateEndpoint, _, err := connV2.DataFederationApi.GetDataFederationPrivateEndpoint(ctx, projectID, endpointID).Execute()
-	if err != nil {
-		return nil, fmt.Errorf(errorPrivateEndpointServiceDataFederationOnlineArchiveImport, endpointID, projectID, err)
+	timeout, diags := plan.Timeouts.Create(ctx, timeoutCreateDelete)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
-	err = populateResourceData(d, privateEndpoint, projectID, endpointID)
+	entry, err := waitStateTransition(ctx, connV2, projectID, endpointID, []string{statusOK}, timeout)
 	if err != nil {
-		return nil, fmt.Errorf(errorPrivateEndpointServiceDataFederationOnlineArchiveImport, endpointID, projectID, err)
-	}
-
-	return []*schema.ResourceData{d}, nil
-}
-
-func splitAtlasPrivatelinkEndpointServiceDataFederationOnlineArchive(id string) (projectID, endpointID string, err error) {
-	var parts = strings.Split(id, "--")
-
-	if len(parts) != 2 {
-		err = errors.New("import format error: to import a Data Lake, use the format {project_id}--{name}")
+		resp.Diagnostics.AddError(fmt.Sprintf(errorPrivateEndpointServiceDataFederationOnlineArchiveCreate, projectID, err), "")
 		return
 	}
-
-	projectID = parts[0]
-	endpointID = parts[1]
-
-	return
+	resp.Diagnostics.Append(resp.State.Set(ctx, NewTFModel(projectID, entry, plan.Timeouts))...)
 }
 
-func newPrivateNetworkEndpointIDEntry(d *schema.ResourceData) *admin.PrivateNetworkEndpointIdEntry {
-	endpointType := endpointType
-	out := admin.PrivateNetworkEndpointIdEntry{
-		EndpointId: d.Get("endpoint_id").(string),
-		Type:       &endpointType,
+func (r *rs) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state TFModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
-
-	if v, ok := d.GetOk("comment"); ok {
-		comment := v.(string)
-		out.Comment = &comment
+	ids := conversion.DecodeStateID(state.ID.ValueString())
+	projectID, endpointID := ids["project_id"], ids["endpoint_id"]
+	entry, apiResp, err := r.Client.AtlasV2.DataFederationApi.GetDataFederationPrivateEndpoint(ctx, projectID, endpointID).Execute()
+	if err != nil {
+		if validate.StatusNotFound(apiResp) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(fmt.Sprintf(errorPrivateEndpointServiceDataFederationOnlineArchiveRead, endpointID, projectID, err), "")
+		return
 	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, NewTFModel(projectID, entry, state.Timeouts))...)
+}
 
-	if v, ok := d.GetOk("provider_name"); ok && v != "" {
-		providerName := strings.ToUpper(v.(string))
-		out.Provider = &providerName
+func (r *rs) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state TFModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
-
-	if v, ok := d.GetOk("region"); ok && v != "" {
-		region := v.(string)
-		out.Region = &region
+	connV2 := r.Client.AtlasV2
+	ids := conversion.DecodeStateID(state.ID.ValueString())
+	projectID, endpointID := ids["project_id"], ids["endpoint_id"]
+	entry, _, err := connV2.DataFederationApi.GetDataFederationPrivateEndpoint(ctx, projectID, endpointID).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf(errorPrivateEndpointServiceDataFederationOnlineArchiveRead, endpointID, projectID, err), "")
+		return
 	}
-
-	if v, ok := d.GetOk("customer_endpoint_dns_name"); ok && v != "" {
-		customerEndpointDNSName := v.(string)
-		out.CustomerEndpointDNSName = &customerEndpointDNSName
+	// only comment can be updated in place, the create endpoint updates the existing private endpoint
+	if !plan.Comment.Equal(state.Comment) {
+		entry.Comment = plan.Comment.ValueStringPointer()
+		if _, _, err := connV2.DataFederationApi.CreateDataFederationPrivateEndpoint(ctx, projectID, entry).Execute(); err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf(errorPrivateEndpointServiceDataFederationOnlineArchiveUpdate, projectID, err), "")
+			return
+		}
+		entry, _, err = connV2.DataFederationApi.GetDataFederationPrivateEndpoint(ctx, projectID, endpointID).Execute()
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf(errorPrivateEndpointServiceDataFederationOnlineArchiveRead, endpointID, projectID, err), "")
+			return
+		}
 	}
-
-	return &out
+	resp.Diagnostics.Append(resp.State.Set(ctx, NewTFModel(projectID, entry, plan.Timeouts))...)
 }
 
-func populateResourceData(d *schema.ResourceData, privateEndpoint *admin.PrivateNetworkEndpointIdEntry, projectID, endpointID string) error {
-	if err := d.Set("comment", privateEndpoint.GetComment()); err != nil {
-		return err
+func (r *rs) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state TFModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
-
-	if err := d.Set("provider_name", privateEndpoint.GetProvider()); err != nil {
-		return err
+	connV2 := r.Client.AtlasV2
+	ids := conversion.DecodeStateID(state.ID.ValueString())
+	projectID, endpointID := ids["project_id"], ids["endpoint_id"]
+	if apiResp, err := connV2.DataFederationApi.DeleteDataFederationPrivateEndpoint(ctx, projectID, endpointID).Execute(); err != nil {
+		if validate.StatusNotFound(apiResp) {
+			return
+		}
+		resp.Diagnostics.AddError(fmt.Sprintf(errorPrivateEndpointServiceDataFederationOnlineArchiveDelete, endpointID, projectID, err), "")
+		return
 	}
-
-	if err := d.Set("type", privateEndpoint.GetType()); err != nil {
-		return err
+	timeout, diags := state.Timeouts.Delete(ctx, timeoutCreateDelete)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
-
-	if err := d.Set("region", privateEndpoint.GetRegion()); err != nil {
-		return err
+	if _, err := waitStateTransition(ctx, connV2, projectID, endpointID, []string{"DELETED"}, timeout); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf(errorPrivateEndpointServiceDataFederationOnlineArchiveDelete, endpointID, projectID, err), "")
 	}
+}
 
-	if err := d.Set("endpoint_id", privateEndpoint.GetEndpointId()); err != nil {
-		return err
+func (r *rs) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	projectID, endpointID, err := SplitImportID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("error splitting import ID", err.Error())
+		return
 	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), EncodeStateID(projectID, endpointID))...)
+}
 
-	if err := d.Set("project_id", projectID); err != nil {
-		return err
+// waitStateTransition waits until the endpoint reaches one of the target statuses, any status that is not a target is considered pending.
+func waitStateTransition(ctx context.Context, client *admin.APIClient, projectID, endpointID string, target []string, timeout time.Duration) (*admin.PrivateNetworkEndpointIdEntry, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{"PENDING"},
+		Target:     target,
+		Refresh:    refreshFunc(ctx, client, projectID, endpointID, target),
+		Timeout:    timeout,
+		MinTimeout: minTimeout,
+		Delay:      delay,
+	}
+	result, err := stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return nil, err
 	}
+	entry, _ := result.(*admin.PrivateNetworkEndpointIdEntry)
+	return entry, nil
+}
 
-	d.SetId(conversion.EncodeStateID(map[string]string{
-		"project_id":  projectID,
-		"endpoint_id": endpointID,
-	}))
-
-	return d.Set("customer_endpoint_dns_name", privateEndpoint.GetCustomerEndpointDNSName())
+func refreshFunc(ctx context.Context, client *admin.APIClient, projectID, endpointID string, target []string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		entry, resp, err := client.DataFederationApi.GetDataFederationPrivateEndpoint(ctx, projectID, endpointID).Execute()
+		if err != nil {
+			if validate.StatusNotFound(resp) {
+				return "", "DELETED", nil
+			}
+			return nil, "", err
+		}
+		status, err := EndpointStatus(entry)
+		if err != nil {
+			return nil, status, err
+		}
+		for _, targetStatus := range target {
+			if status == targetStatus {
+				return entry, status, nil
+			}
+		}
+		return entry, "PENDING", nil
+	}
 }
@@ -140,7 +140,7 @@ func importStateIDFunc(resourceName string) resource.ImportStateIdFunc {
 
 		ids := conversion.DecodeStateID(rs.Primary.ID)
 
-		return fmt.Sprintf("%s--%s", ids["project_id"], ids["endpoint_id"]), nil
+		return fmt.Sprintf("%s/%s", ids["project_id"], ids["endpoint_id"]), nil
 	}
 }
 
@@ -0,0 +1,157 @@
+package privatelinkendpointservicedatafederationonlinearchive
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/validate"
+)
+
+func ResourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Unique 24-hexadecimal digit string that identifies your project.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"endpoint_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Unique string that identifies the private endpoint's network interface.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"provider_name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Cloud provider of the private endpoint: `AWS` or `AZURE`. Google Cloud Private Service Connect is not supported for Data Federation and Online Archive.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(providerNames...),
+				},
+			},
+			"comment": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+				MarkdownDescription: "Human-readable string to associate with this private endpoint.",
+			},
+			"region": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Human-readable label to identify the region of the VPC endpoint. Must be set together with `customer_endpoint_dns_name`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile("^[^a-z]*$"), "must be uppercase"),
+					stringvalidator.AlsoRequires(path.MatchRoot("customer_endpoint_dns_name")),
+				},
+			},
+			"customer_endpoint_dns_name": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Human-readable label to identify the DNS name of the VPC endpoint. Must be set together with `region`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("region")),
+				},
+			},
+			"azure": schema.SingleNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "Azure private endpoint settings. Requires `provider_name` to be `AZURE`.",
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplace(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"customer_endpoint_ip_address": schema.StringAttribute{
+						Required:            true,
+						MarkdownDescription: "IP address used to connect to the Azure private endpoint.",
+						Validators: []validator.String{
+							validate.ValidIP(),
+						},
+					},
+				},
+			},
+			"azure_link_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Link ID that identifies the Azure private endpoint connection.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"type": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Human-readable label that identifies the resource type associated with this private endpoint.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"status": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Status of the private endpoint connection request.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"error_message": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Error message describing a failure approving the private endpoint request.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+type TFModel struct {
+	Azure                   *TFAzureModel  `tfsdk:"azure"`
+	ID                      types.String   `tfsdk:"id"`
+	ProjectID               types.String   `tfsdk:"project_id"`
+	EndpointID              types.String   `tfsdk:"endpoint_id"`
+	ProviderName            types.String   `tfsdk:"provider_name"`
+	Comment                 types.String   `tfsdk:"comment"`
+	Region                  types.String   `tfsdk:"region"`
+	CustomerEndpointDNSName types.String   `tfsdk:"customer_endpoint_dns_name"`
+	AzureLinkID             types.String   `tfsdk:"azure_link_id"`
+	Type                    types.String   `tfsdk:"type"`
+	Status                  types.String   `tfsdk:"status"`
+	ErrorMessage            types.String   `tfsdk:"error_message"`
+	Timeouts                timeouts.Value `tfsdk:"timeouts"`
+}
+
+type TFAzureModel struct {
+	CustomerEndpointIPAddress types.String `tfsdk:"customer_endpoint_ip_address"`
+}
@@ -0,0 +1,116 @@
+package privatelinkendpointserviceserverless
+
+import (
+	"context"
+	"fmt"
+
+	"go.mongodb.org/atlas-sdk/v20250312003/admin"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/constant"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/conversion"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/privatelinkendpointserverless"
+)
+
+// NewTFModel populates the provider-specific attributes only when they were used in the prior model so the flat and nested forms don't drift from each other.
+func NewTFModel(projectID, instanceName, providerName string, endpoint *admin.ServerlessTenantEndpoint, prior *TFModel) *TFModel {
+	model := &TFModel{
+		ID:                           types.StringValue(privatelinkendpointserverless.EncodeStateID(projectID, instanceName, endpoint.GetId())),
+		ProjectID:                    types.StringValue(projectID),
+		InstanceName:                 types.StringValue(instanceName),
+		EndpointID:                   types.StringPointerValue(endpoint.Id),
+		ProviderName:                 types.StringValue(providerName),
+		Comment:                      types.StringValue(endpoint.GetComment()),
+		CloudProviderEndpointID:      types.StringValue(endpoint.GetCloudProviderEndpointId()),
+		PrivateEndpointIPAddress:     types.StringValue(endpoint.GetPrivateEndpointIpAddress()),
+		PrivateLinkServiceResourceID: types.StringValue(endpoint.GetPrivateLinkServiceResourceId()),
+		Status:                       types.StringValue(endpoint.GetStatus()),
+		ErrorMessage:                 types.StringValue(endpoint.GetErrorMessage()),
+	}
+	if prior == nil {
+		return model
+	}
+	model.Timeouts = prior.Timeouts
+	if prior.AWS != nil {
+		model.AWS = &TFAWSModel{
+			VPCEndpointID: types.StringValue(endpoint.GetCloudProviderEndpointId()),
+		}
+	}
+	if prior.Azure != nil {
+		model.Azure = &TFAzureModel{
+			PrivateEndpointID:        types.StringValue(endpoint.GetCloudProviderEndpointId()),
+			PrivateEndpointIPAddress: types.StringValue(endpoint.GetPrivateEndpointIpAddress()),
+		}
+	}
+	return model
+}
+
+func NewUpdateReq(plan *TFModel) *admin.ServerlessTenantEndpointUpdate {
+	cloudProviderEndpointID, privateEndpointIPAddress := plan.CloudProviderEndpointID, plan.PrivateEndpointIPAddress
+	switch {
+	case plan.AWS != nil:
+		cloudProviderEndpointID = plan.AWS.VPCEndpointID
+	case plan.Azure != nil:
+		cloudProviderEndpointID = plan.Azure.PrivateEndpointID
+		privateEndpointIPAddress = plan.Azure.PrivateEndpointIPAddress
+	}
+	return &admin.ServerlessTenantEndpointUpdate{
+		Comment:                  plan.Comment.ValueStringPointer(),
+		ProviderName:             plan.ProviderName.ValueString(),
+		CloudProviderEndpointId:  conversion.NilForUnknownOrEmptyString(cloudProviderEndpointID),
+		PrivateEndpointIpAddress: conversion.NilForUnknownOrEmptyString(privateEndpointIPAddress),
+	}
+}
+
+// HasCloudProviderEndpoint returns true if the plan connects a cloud provider endpoint, in that case the endpoint must become AVAILABLE.
+func HasCloudProviderEndpoint(plan *TFModel) bool {
+	return plan.AWS != nil || plan.Azure != nil || conversion.NilForUnknownOrEmptyString(plan.CloudProviderEndpointID) != nil
+}
+
+// ValidateProviderConfig checks that the provider-specific attributes match provider_name.
+func ValidateProviderConfig(cfg *TFModel) (errs map[string]string) {
+	errs = make(map[string]string)
+	providerName := cfg.ProviderName.ValueString()
+	if cfg.ProviderName.IsUnknown() || providerName == "" {
+		return errs
+	}
+	if cfg.AWS != nil && providerName != constant.AWS {
+		errs["aws"] = fmt.Sprintf("aws can only be used when provider_name is AWS, got %s", providerName)
+	}
+	if cfg.Azure != nil && providerName != constant.AZURE {
+		errs["azure"] = fmt.Sprintf("azure can only be used when provider_name is AZURE, got %s", providerName)
+	}
+	if !cfg.PrivateEndpointIPAddress.IsNull() && providerName != constant.AZURE {
+		errs["private_endpoint_ip_address"] = fmt.Sprintf("private_endpoint_ip_address can only be used when provider_name is AZURE, got %s", providerName)
+	}
+	return errs
+}
+
+// requiresReplaceIfEndpointChanged avoids replacing the resource when moving from the flat attributes to the aws or azure attributes
+// as long as they refer to the same cloud provider endpoint.
+func requiresReplaceIfEndpointChanged(ctx context.Context, req planmodifier.ObjectRequest, resp *objectplanmodifier.RequiresReplaceIfFuncResponse) {
+	if req.PlanValue.IsNull() {
+		return
+	}
+	var stateEndpointID, stateIPAddress types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("cloud_provider_endpoint_id"), &stateEndpointID)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("private_endpoint_ip_address"), &stateIPAddress)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	attrs := req.PlanValue.Attributes()
+	for name, stateValue := range map[string]types.String{
+		"vpc_endpoint_id":             stateEndpointID,
+		"private_endpoint_id":         stateEndpointID,
+		"private_endpoint_ip_address": stateIPAddress,
+	} {
+		if planValue, ok := attrs[name]; ok && !planValue.Equal(stateValue) {
+			resp.RequiresReplace = true
+			return
+		}
+	}
+}
@@ -0,0 +1,178 @@
+package privatelinkendpointserviceserverless_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/atlas-sdk/v20250312003/admin"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/conversion"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/privatelinkendpointserviceserverless"
+)
+
+const (
+	projectID    = "111111111111111111111111"
+	instanceName = "instance"
+	endpointID   = "222222222222222222222222"
+	vpcEndpoint  = "vpce-123"
+	azureID      = "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Network/privateEndpoints/pe"
+	ipAddress    = "10.0.0.4"
+)
+
+func TestNewTFModel(t *testing.T) {
+	endpoint := &admin.ServerlessTenantEndpoint{
+		Id:                       conversion.StringPtr(endpointID),
+		CloudProviderEndpointId:  conversion.StringPtr(azureID),
+		PrivateEndpointIpAddress: conversion.StringPtr(ipAddress),
+		Comment:                  conversion.StringPtr("comment"),
+		Status:                   conversion.StringPtr("AVAILABLE"),
+	}
+	testCases := map[string]struct {
+		prior         *privatelinkendpointserviceserverless.TFModel
+		expectedAzure *privatelinkendpointserviceserverless.TFAzureModel
+	}{
+		"flat attributes": {
+			prior: &privatelinkendpointserviceserverless.TFModel{},
+		},
+		"azure attribute": {
+			prior: &privatelinkendpointserviceserverless.TFModel{
+				Azure: &privatelinkendpointserviceserverless.TFAzureModel{},
+			},
+			expectedAzure: &privatelinkendpointserviceserverless.TFAzureModel{
+				PrivateEndpointID:        types.StringValue(azureID),
+				PrivateEndpointIPAddress: types.StringValue(ipAddress),
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			model := privatelinkendpointserviceserverless.NewTFModel(projectID, instanceName, "AZURE", endpoint, tc.prior)
+			assert.Equal(t, tc.expectedAzure, model.Azure)
+			assert.Nil(t, model.AWS)
+			assert.Equal(t, azureID, model.CloudProviderEndpointID.ValueString())
+			assert.Equal(t, ipAddress, model.PrivateEndpointIPAddress.ValueString())
+			assert.Equal(t, "comment", model.Comment.ValueString())
+			assert.Equal(t, "AVAILABLE", model.Status.ValueString())
+		})
+	}
+}
+
+func TestNewUpdateReq(t *testing.T) {
+	testCases := map[string]struct {
+		plan     privatelinkendpointserviceserverless.TFModel
+		expected admin.ServerlessTenantEndpointUpdate
+	}{
+		"flat attributes": {
+			plan: privatelinkendpointserviceserverless.TFModel{
+				ProviderName:             types.StringValue("AWS"),
+				Comment:                  types.StringValue("comment"),
+				CloudProviderEndpointID:  types.StringValue(vpcEndpoint),
+				PrivateEndpointIPAddress: types.StringUnknown(),
+			},
+			expected: admin.ServerlessTenantEndpointUpdate{
+				ProviderName:            "AWS",
+				Comment:                 conversion.StringPtr("comment"),
+				CloudProviderEndpointId: conversion.StringPtr(vpcEndpoint),
+			},
+		},
+		"aws attribute": {
+			plan: privatelinkendpointserviceserverless.TFModel{
+				ProviderName:             types.StringValue("AWS"),
+				Comment:                  types.StringValue(""),
+				CloudProviderEndpointID:  types.StringUnknown(),
+				PrivateEndpointIPAddress: types.StringUnknown(),
+				AWS: &privatelinkendpointserviceserverless.TFAWSModel{
+					VPCEndpointID: types.StringValue(vpcEndpoint),
+				},
+			},
+			expected: admin.ServerlessTenantEndpointUpdate{
+				ProviderName:            "AWS",
+				Comment:                 admin.PtrString(""),
+				CloudProviderEndpointId: conversion.StringPtr(vpcEndpoint),
+			},
+		},
+		"azure attribute": {
+			plan: privatelinkendpointserviceserverless.TFModel{
+				ProviderName:             types.StringValue("AZURE"),
+				Comment:                  types.StringValue(""),
+				CloudProviderEndpointID:  types.StringUnknown(),
+				PrivateEndpointIPAddress: types.StringUnknown(),
+				Azure: &privatelinkendpointserviceserverless.TFAzureModel{
+					PrivateEndpointID:        types.StringValue(azureID),
+					PrivateEndpointIPAddress: types.StringValue(ipAddress),
+				},
+			},
+			expected: admin.ServerlessTenantEndpointUpdate{
+				ProviderName:             "AZURE",
+				Comment:                  admin.PtrString(""),
+				CloudProviderEndpointId:  conversion.StringPtr(azureID),
+				PrivateEndpointIpAddress: conversion.StringPtr(ipAddress),
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, &tc.expected, privatelinkendpointserviceserverless.NewUpdateReq(&tc.plan))
+		})
+	}
+}
+
+func TestHasCloudProviderEndpoint(t *testing.T) {
+	assert.False(t, privatelinkendpointserviceserverless.HasCloudProviderEndpoint(&privatelinkendpointserviceserverless.TFModel{CloudProviderEndpointID: types.StringUnknown()}))
+	assert.True(t, privatelinkendpointserviceserverless.HasCloudProviderEndpoint(&privatelinkendpointserviceserverless.TFModel{CloudProviderEndpointID: types.StringValue(vpcEndpoint)}))
+	assert.True(t, privatelinkendpointserviceserverless.HasCloudProviderEndpoint(&privatelinkendpointserviceserverless.TFModel{
+		CloudProviderEndpointID: types.StringNull(),
+		AWS:                     &privatelinkendpointserviceserverless.TFAWSModel{VPCEndpointID: types.StringValue(vpcEndpoint)},
+	}))
+}
+
+func TestValidateProviderConfig(t *testing.T) {
+	testCases := map[string]struct {
+		cfg          privatelinkendpointserviceserverless.TFModel
+		expectedErrs []string
+	}{
+		"aws with aws attribute": {
+			cfg: privatelinkendpointserviceserverless.TFModel{
+				ProviderName: types.StringValue("AWS"),
+				AWS:          &privatelinkendpointserviceserverless.TFAWSModel{},
+			},
+		},
+		"azure with azure attribute": {
+			cfg: privatelinkendpointserviceserverless.TFModel{
+				ProviderName: types.StringValue("AZURE"),
+				Azure:        &privatelinkendpointserviceserverless.TFAzureModel{},
+			},
+		},
+		"aws with azure attributes": {
+			cfg: privatelinkendpointserviceserverless.TFModel{
+				ProviderName:             types.StringValue("AWS"),
+				PrivateEndpointIPAddress: types.StringValue(ipAddress),
+				Azure:                    &privatelinkendpointserviceserverless.TFAzureModel{},
+			},
+			expectedErrs: []string{"azure", "private_endpoint_ip_address"},
+		},
+		"azure with aws attribute": {
+			cfg: privatelinkendpointserviceserverless.TFModel{
+				ProviderName: types.StringValue("AZURE"),
+				AWS:          &privatelinkendpointserviceserverless.TFAWSModel{},
+			},
+			expectedErrs: []string{"aws"},
+		},
+		"unknown provider": {
+			cfg: privatelinkendpointserviceserverless.TFModel{
+				ProviderName: types.StringUnknown(),
+				AWS:          &privatelinkendpointserviceserverless.TFAWSModel{},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			errs := privatelinkendpointserviceserverless.ValidateProviderConfig(&tc.cfg)
+			assert.Len(t, errs, len(tc.expectedErrs))
+			for _, attrName := range tc.expectedErrs {
+				assert.Contains(t, errs, attrName)
+			}
+		})
+	}
+}
@@ -2,7 +2,6 @@ package privatelinkendpointserviceserverless
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"log"
 	"strings"
@@ -10,322 +9,200 @@ import (
 
 	"go.mongodb.org/atlas-sdk/v20250312003/admin"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
-	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/constant"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/conversion"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/validate"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/config"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/privatelinkendpointserverless"
 )
 
 const (
-	ErrorServerlessServiceEndpointAdd    = "error adding MongoDB Serverless PrivateLink Endpoint Connection(%s): %s"
-	errorServerlessServiceEndpointDelete = "error deleting MongoDB Serverless PrivateLink Endpoint Connection(%s): %s"
+	resourceName                         = "privatelink_endpoint_service_serverless"
+	errorServerlessServiceEndpointAdd    = "error adding MongoDB Serverless PrivateLink Endpoint Connection(%s): %s"
+	errorServerlessServiceEndpointRead   = "error getting Serverless private link endpoint information(%s): %s"
+	errorServerlessServiceEndpointUpdate = "error updating MongoDB Serverless PrivateLink Endpoint Connection(%s): %s"
 	errorServerlessInstanceListStatus    = "error awaiting serverless instance list status IDLE: %s"
+	timeoutCreateDelete                  = 2 * time.Hour
+	minTimeout                           = 5 * time.Second
+	delay                                = 5 * time.Second
+	delayInstanceList                    = 1 * time.Minute
 )
 
-func Resource() *schema.Resource {
-	return &schema.Resource{
-		CreateWithoutTimeout: resourceCreate,
-		ReadWithoutTimeout:   resourceRead,
-		DeleteWithoutTimeout: resourceDelete,
-		UpdateWithoutTimeout: resourceUpdate,
-		Importer: &schema.ResourceImporter{
-			StateContext: resourceImport,
-		},
-		DeprecationMessage: fmt.Sprintf(constant.DeprecationResourceByDateWithExternalLink, "March 2025", "https://registry.terraform.io/providers/mongodb/mongodbatlas/latest/docs/guides/serverless-shared-migration-guide"),
-		Schema: map[string]*schema.Schema{
-			"project_id": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
-			},
-			"instance_name": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
-			},
-			"endpoint_id": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
-			},
-			"comment": {
-				Type:     schema.TypeString,
-				Optional: true,
-			},
-			"provider_name": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validation.StringInSlice([]string{"AWS", "AZURE"}, false),
-				ForceNew:     true,
-			},
-			"cloud_provider_endpoint_id": {
-				Type:     schema.TypeString,
-				Optional: true,
-				ForceNew: true,
-				Computed: true,
-			},
-			"private_link_service_resource_id": {
-				Type:     schema.TypeString,
-				Computed: true,
-			},
-			"private_endpoint_ip_address": {
-				Type:     schema.TypeString,
-				Optional: true,
-				ForceNew: true,
-				Computed: true,
-			},
-			"status": {
-				Type:     schema.TypeString,
-				Computed: true,
-			},
-		},
-		Timeouts: &schema.ResourceTimeout{
-			Create: schema.DefaultTimeout(2 * time.Hour),
-			Delete: schema.DefaultTimeout(2 * time.Hour),
+var _ resource.ResourceWithConfigure = &rs{}
+var _ resource.ResourceWithImportState = &rs{}
+var _ resource.ResourceWithValidateConfig = &rs{}
+
+func Resource() resource.Resource {
+	return &rs{
+		RSCommon: config.RSCommon{
+			ResourceName: resourceName,
 		},
 	}
 }
 
-func resourceCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	connV2 := meta.(*config.MongoDBClient).AtlasV2
-	projectID := d.Get("project_id").(string)
-	instanceName := d.Get("instance_name").(string)
-	endpointID := d.Get("endpoint_id").(string)
+type rs struct {
+	config.RSCommon
+}
 
-	_, _, err := connV2.ServerlessPrivateEndpointsApi.GetServerlessPrivateEndpoint(ctx, projectID, instanceName, endpointID).Execute()
-	if err != nil {
-		return diag.Errorf("error getting Serverless PrivateLink Endpoint Information: %s", err)
-	}
+func (r *rs) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = ResourceSchema(ctx)
+	conversion.UpdateSchemaDescription(&resp.Schema)
+}
 
-	updateRequest := admin.ServerlessTenantEndpointUpdate{
-		Comment:                  conversion.StringPtr(d.Get("comment").(string)),
-		ProviderName:             d.Get("provider_name").(string),
-		CloudProviderEndpointId:  conversion.StringPtr(d.Get("cloud_provider_endpoint_id").(string)),
-		PrivateEndpointIpAddress: conversion.StringPtr(d.Get("private_endpoint_ip_address").(string)),
+func (r *rs) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var cfg TFModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &cfg)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
-
-	endPoint, _, err := connV2.ServerlessPrivateEndpointsApi.UpdateServerlessPrivateEndpoint(ctx, projectID, instanceName, endpointID, &updateRequest).Execute()
-	if err != nil {
-		return diag.Errorf(ErrorServerlessServiceEndpointAdd, endpointID, err)
+	for attrName, msg := range ValidateProviderConfig(&cfg) {
+		resp.Diagnostics.AddAttributeError(path.Root(attrName), "Invalid provider-specific attribute", msg)
 	}
+}
 
-	stateConf := &retry.StateChangeConf{
-		Pending:    []string{"RESERVATION_REQUESTED", "INITIATING", "DELETING"},
-		Target:     []string{"RESERVED", "FAILED", "DELETED", "AVAILABLE"},
-		Refresh:    resourceRefreshFunc(ctx, connV2, projectID, instanceName, endpointID),
-		Timeout:    d.Timeout(schema.TimeoutCreate),
-		MinTimeout: 5 * time.Second,
-		Delay:      5 * time.Minute,
+func (r *rs) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan TFModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	connV2 := r.Client.AtlasV2
+	projectID := plan.ProjectID.ValueString()
+	instanceName := plan.InstanceName.ValueString()
+	endpointID := plan.EndpointID.ValueString()
+	if _, _, err := connV2.ServerlessPrivateEndpointsApi.GetServerlessPrivateEndpoint(ctx, projectID, instanceName, endpointID).Execute(); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf(errorServerlessServiceEndpointRead, endpointID, err), "")
+		return
+	}
+	if _, _, err := connV2.ServerlessPrivateEndpointsApi.UpdateServerlessPrivateEndpoint(ctx, projectID, instanceName, endpointID, NewUpdateReq(&plan)).Execute(); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf(errorServerlessServiceEndpointAdd, endpointID, err), "")
+		return
+	}
+	timeout, diags := plan.Timeouts.Create(ctx, timeoutCreateDelete)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// Without a cloud provider endpoint the Atlas endpoint stays RESERVED.
+	pending, target := []string{"RESERVATION_REQUESTED", "INITIATING", "RESERVED"}, []string{"AVAILABLE"}
+	if !HasCloudProviderEndpoint(&plan) {
+		pending, target = []string{"RESERVATION_REQUESTED", "INITIATING"}, []string{"RESERVED", "AVAILABLE"}
 	}
-
-	_, err = stateConf.WaitForStateContext(ctx)
+	stateConf := &retry.StateChangeConf{
+		Pending:    pending,
+		Target:     target,
+		Refresh:    refreshFunc(ctx, connV2, projectID, instanceName, endpointID),
+		Timeout:    timeout,
+		MinTimeout: minTimeout,
+		Delay:      delay,
+	}
+	result, err := stateConf.WaitForStateContext(ctx)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf(ErrorServerlessServiceEndpointAdd, endpointID, err))
+		resp.Diagnostics.AddError(fmt.Sprintf(errorServerlessServiceEndpointAdd, endpointID, err), "")
+		return
 	}
-
-	clusterConf := &retry.StateChangeConf{
+	instanceListConf := &retry.StateChangeConf{
 		Pending:    []string{"REPEATING", "PENDING"},
 		Target:     []string{"IDLE", "DELETED"},
-		Refresh:    resourceListRefreshFunc(ctx, projectID, connV2),
-		Timeout:    d.Timeout(schema.TimeoutCreate),
-		MinTimeout: 5 * time.Second,
-		Delay:      5 * time.Minute,
+		Refresh:    instanceListRefreshFunc(ctx, projectID, connV2),
+		Timeout:    timeout,
+		MinTimeout: minTimeout,
+		Delay:      delayInstanceList,
 	}
-
-	if _, err = clusterConf.WaitForStateContext(ctx); err != nil {
+	if _, err := instanceListConf.WaitForStateContext(ctx); err != nil {
 		// error awaiting serverless instances to IDLE should not result in failure to apply changes to this resource
 		log.Printf(errorServerlessInstanceListStatus, err)
 	}
-
-	d.SetId(conversion.EncodeStateID(map[string]string{
-		"project_id":    projectID,
-		"instance_name": instanceName,
-		"endpoint_id":   endPoint.GetId(),
-	}))
-
-	return resourceRead(ctx, d, meta)
+	endpoint := result.(*admin.ServerlessTenantEndpoint)
+	resp.Diagnostics.Append(resp.State.Set(ctx, NewTFModel(projectID, instanceName, plan.ProviderName.ValueString(), endpoint, &plan))...)
 }
 
-func resourceUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	if !d.HasChange("comment") {
-		return resourceRead(ctx, d, meta)
+func (r *rs) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state TFModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
-
-	connV2 := meta.(*config.MongoDBClient).AtlasV2
-	projectID := d.Get("project_id").(string)
-	instanceName := d.Get("instance_name").(string)
-	endpointID := d.Get("endpoint_id").(string)
-
-	// only "comment" attribute update is supported, updating other attributes forces replacement of this resource
-	updateRequest := admin.ServerlessTenantEndpointUpdate{
-		Comment:      conversion.StringPtr(d.Get("comment").(string)),
-		ProviderName: d.Get("provider_name").(string),
-	}
-
-	endPoint, _, err := connV2.ServerlessPrivateEndpointsApi.UpdateServerlessPrivateEndpoint(ctx, projectID, instanceName, endpointID, &updateRequest).Execute()
+	ids := conversion.DecodeStateID(state.ID.ValueString())
+	projectID, instanceName, endpointID := ids["project_id"], ids["instance_name"], ids["endpoint_id"]
+	endpoint, apiResp, err := r.Client.AtlasV2.ServerlessPrivateEndpointsApi.GetServerlessPrivateEndpoint(ctx, projectID, instanceName, endpointID).Execute()
 	if err != nil {
-		return diag.Errorf(ErrorServerlessServiceEndpointAdd, endpointID, err)
-	}
-
-	d.SetId(conversion.EncodeStateID(map[string]string{
-		"project_id":    projectID,
-		"instance_name": instanceName,
-		"endpoint_id":   endPoint.GetId(),
-	}))
-
-	return resourceRead(ctx, d, meta)
-}
-
-func resourceRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	connV2 := meta.(*config.MongoDBClient).AtlasV2
-	ids := conversion.DecodeStateID(d.Id())
-	projectID := ids["project_id"]
-	instanceName := ids["instance_name"]
-	endpointID := ids["endpoint_id"]
-
-	privateLinkResponse, _, err := connV2.ServerlessPrivateEndpointsApi.GetServerlessPrivateEndpoint(ctx, projectID, instanceName, endpointID).Execute()
-	if err != nil {
-		// case 404: deleted in the backend case
-		if strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "400") {
-			d.SetId("")
-			return nil
+		if validate.StatusNotFound(apiResp) || validate.StatusBadRequest(apiResp) {
+			resp.State.RemoveResource(ctx)
+			return
 		}
-		return diag.Errorf("error getting Serverless private link endpoint  information: %s", err)
-	}
-
-	privateLinkResponse.ProviderName = conversion.StringPtr(d.Get("provider_name").(string))
-
-	if err := d.Set("endpoint_id", privateLinkResponse.GetId()); err != nil {
-		return diag.Errorf("error setting `endpoint_id` for endpoint_id (%s): %s", d.Id(), err)
-	}
-
-	if err := d.Set("instance_name", instanceName); err != nil {
-		return diag.Errorf("error setting `instance Name` for endpoint_id (%s): %s", d.Id(), err)
-	}
-
-	if err := d.Set("comment", privateLinkResponse.GetComment()); err != nil {
-		return diag.Errorf("error setting `comment` for endpoint_id (%s): %s", d.Id(), err)
+		resp.Diagnostics.AddError(fmt.Sprintf(errorServerlessServiceEndpointRead, endpointID, err), "")
+		return
 	}
-
-	if err := d.Set("provider_name", privateLinkResponse.GetProviderName()); err != nil {
-		return diag.Errorf("error setting `provider_name` for endpoint_id (%s): %s", d.Id(), err)
-	}
-
-	if err := d.Set("status", privateLinkResponse.GetStatus()); err != nil {
-		return diag.Errorf("error setting `status` for endpoint_id (%s): %s", d.Id(), err)
-	}
-
-	if err := d.Set("cloud_provider_endpoint_id", privateLinkResponse.GetCloudProviderEndpointId()); err != nil {
-		return diag.Errorf("error setting `cloud_provider_endpoint_id` for endpoint_id (%s): %s", d.Id(), err)
-	}
-
-	if err := d.Set("private_link_service_resource_id", privateLinkResponse.GetPrivateLinkServiceResourceId()); err != nil {
-		return diag.Errorf("error setting `private_link_service_resource_id` for endpoint_id (%s): %s", d.Id(), err)
-	}
-
-	if err := d.Set("private_endpoint_ip_address", privateLinkResponse.GetPrivateEndpointIpAddress()); err != nil {
-		return diag.Errorf("error setting `private_endpoint_ip_address` for endpoint_id (%s): %s", d.Id(), err)
+	providerName := state.ProviderName.ValueString()
+	if providerName == "" {
+		providerName = privatelinkendpointserverless.ProviderName(endpoint)
 	}
-
-	return nil
+	resp.Diagnostics.Append(resp.State.Set(ctx, NewTFModel(projectID, instanceName, providerName, endpoint, &state))...)
 }
 
-func resourceDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	connV2 := meta.(*config.MongoDBClient).AtlasV2
-	ids := conversion.DecodeStateID(d.Id())
-	projectID := ids["project_id"]
-	instanceName := ids["instance_name"]
-	endpointID := ids["endpoint_id"]
-
-	_, _, err := connV2.ServerlessPrivateEndpointsApi.GetServerlessPrivateEndpoint(ctx, projectID, instanceName, endpointID).Execute()
+func (r *rs) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state TFModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ids := conversion.DecodeStateID(state.ID.ValueString())
+	projectID, instanceName, endpointID := ids["project_id"], ids["instance_name"], ids["endpoint_id"]
+	endpoint, _, err := r.Client.AtlasV2.ServerlessPrivateEndpointsApi.GetServerlessPrivateEndpoint(ctx, projectID, instanceName, endpointID).Execute()
 	if err != nil {
-		// case 404: deleted in the backend case
-		if strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "400") {
-			d.SetId("")
-			return nil
+		resp.Diagnostics.AddError(fmt.Sprintf(errorServerlessServiceEndpointRead, endpointID, err), "")
+		return
+	}
+	// only comment can be updated in place, changing the endpoint forces replacement of this resource
+	if !plan.Comment.Equal(state.Comment) {
+		updateReq := &admin.ServerlessTenantEndpointUpdate{
+			Comment:      plan.Comment.ValueStringPointer(),
+			ProviderName: plan.ProviderName.ValueString(),
+		}
+		endpoint, _, err = r.Client.AtlasV2.ServerlessPrivateEndpointsApi.UpdateServerlessPrivateEndpoint(ctx, projectID, instanceName, endpointID, updateReq).Execute()
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf(errorServerlessServiceEndpointUpdate, endpointID, err), "")
+			return
 		}
-		return diag.Errorf(errorServerlessServiceEndpointDelete, endpointID, err)
 	}
-
-	d.SetId("") // Set to null as linked resource will delete servless endpoint
-
-	return nil
+	resp.Diagnostics.Append(resp.State.Set(ctx, NewTFModel(projectID, instanceName, plan.ProviderName.ValueString(), endpoint, &plan))...)
 }
 
-func resourceImport(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
-	connV2 := meta.(*config.MongoDBClient).AtlasV2
-
-	parts := strings.SplitN(d.Id(), "--", 3)
-	if len(parts) != 3 {
-		return nil, errors.New("import format error: to import a search index, use the format {project_id}--{instance_name}--{endpoint_id}")
-	}
-
-	projectID := parts[0]
-	instanceName := parts[1]
-	endpointID := parts[2]
+// Delete only removes the resource from the state, the endpoint is deleted by mongodbatlas_privatelink_endpoint_serverless.
+func (r *rs) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+}
 
-	privateLinkResponse, _, err := connV2.ServerlessPrivateEndpointsApi.GetServerlessPrivateEndpoint(ctx, projectID, instanceName, endpointID).Execute()
+func (r *rs) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	projectID, instanceName, endpointID, err := privatelinkendpointserverless.SplitImportID(req.ID)
 	if err != nil {
-		return nil, fmt.Errorf("couldn't import serverless private link endpoint (%s) in projectID (%s) , error: %s", endpointID, projectID, err)
+		resp.Diagnostics.AddError("error splitting import ID", err.Error())
+		return
 	}
-
-	if err := d.Set("project_id", projectID); err != nil {
-		log.Printf("[WARN] Error setting project_id for (%s): %s", projectID, err)
-	}
-
-	if err := d.Set("endpoint_id", endpointID); err != nil {
-		log.Printf("[WARN] Error setting endpoint_id for (%s): %s", endpointID, err)
-	}
-
-	if err := d.Set("instance_name", instanceName); err != nil {
-		log.Printf("[WARN] Error setting instance_name for (%s): %s", endpointID, err)
-	}
-
-	if privateLinkResponse.GetPrivateLinkServiceResourceId() != "" {
-		if err := d.Set("provider_name", "AZURE"); err != nil {
-			log.Printf("[WARN] Error setting provider_name for (%s): %s", endpointID, err)
-		}
-	} else {
-		if err := d.Set("provider_name", "AWS"); err != nil {
-			log.Printf("[WARN] Error setting provider_name for (%s): %s", endpointID, err)
-		}
-	}
-
-	d.SetId(conversion.EncodeStateID(map[string]string{
-		"project_id":    projectID,
-		"instance_name": instanceName,
-		"endpoint_id":   endpointID,
-	}))
-
-	return []*schema.ResourceData{d}, nil
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), privatelinkendpointserverless.EncodeStateID(projectID, instanceName, endpointID))...)
 }
 
-func resourceRefreshFunc(ctx context.Context, client *admin.APIClient, projectID, instanceName, endpointServiceID string) retry.StateRefreshFunc {
+func refreshFunc(ctx context.Context, client *admin.APIClient, projectID, instanceName, endpointID string) retry.StateRefreshFunc {
 	return func() (any, string, error) {
-		serverlessTenantEndpoint, resp, err := client.ServerlessPrivateEndpointsApi.GetServerlessPrivateEndpoint(ctx, projectID, instanceName, endpointServiceID).Execute()
+		endpoint, resp, err := client.ServerlessPrivateEndpointsApi.GetServerlessPrivateEndpoint(ctx, projectID, instanceName, endpointID).Execute()
 		if err != nil {
 			if validate.StatusNotFound(resp) || validate.StatusBadRequest(resp) {
 				return "", "DELETED", nil
 			}
-
 			return nil, "", err
 		}
-
-		if serverlessTenantEndpoint.GetStatus() != "AVAILABLE" {
-			return "", serverlessTenantEndpoint.GetStatus(), nil
+		if err := privatelinkendpointserverless.CheckEndpointFailed(endpoint); err != nil {
+			return nil, endpoint.GetStatus(), err
 		}
-		resultStatus := serverlessTenantEndpoint.GetStatus()
-
-		return serverlessTenantEndpoint, resultStatus, nil
+		return endpoint, endpoint.GetStatus(), nil
 	}
 }
 
-func resourceListRefreshFunc(ctx context.Context, projectID string, client *admin.APIClient) retry.StateRefreshFunc {
+func instanceListRefreshFunc(ctx context.Context, projectID string, client *admin.APIClient) retry.StateRefreshFunc {
 	return func() (any, string, error) {
 		serverlessInstances, resp, err := client.ServerlessInstancesApi.ListServerlessInstances(ctx, projectID).Execute()
 
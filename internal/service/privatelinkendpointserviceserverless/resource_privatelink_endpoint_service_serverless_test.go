@@ -330,6 +330,6 @@ func importStateIDFunc(resourceName string) resource.ImportStateIdFunc {
 			return "", fmt.Errorf("not found: %s", resourceName)
 		}
 		ids := conversion.DecodeStateID(rs.Primary.ID)
-		return fmt.Sprintf("%s--%s--%s", ids["project_id"], ids["instance_name"], ids["endpoint_id"]), nil
+		return fmt.Sprintf("%s/%s/%s", ids["project_id"], ids["instance_name"], ids["endpoint_id"]), nil
 	}
 }
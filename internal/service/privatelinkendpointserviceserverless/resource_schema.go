@@ -0,0 +1,187 @@
+package privatelinkendpointserviceserverless
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/constant"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/validate"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/privatelinkendpointserverless"
+)
+
+func ResourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		DeprecationMessage: fmt.Sprintf(constant.DeprecationResourceByDateWithExternalLink, "March 2025", "https://registry.terraform.io/providers/mongodb/mongodbatlas/latest/docs/guides/serverless-shared-migration-guide"),
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Unique 24-hexadecimal digit string that identifies your project.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"instance_name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Human-readable label that identifies the serverless instance.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"endpoint_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Unique 24-hexadecimal digit string that identifies the private endpoint.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"provider_name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Cloud provider of the private endpoint: `AWS` or `AZURE`. Google Cloud Private Service Connect is not supported for serverless instances.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(privatelinkendpointserverless.ProviderNames...),
+				},
+			},
+			"comment": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+				MarkdownDescription: "Human-readable string to associate with this private endpoint.",
+			},
+			"cloud_provider_endpoint_id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Unique string that identifies the private endpoint's network interface. Prefer the `aws` or `azure` attributes.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("aws"), path.MatchRoot("azure")),
+				},
+			},
+			"private_endpoint_ip_address": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "IPv4 address of the Azure private endpoint. Prefer the `azure` attribute.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+				Validators: []validator.String{
+					validate.ValidIP(),
+					stringvalidator.ConflictsWith(path.MatchRoot("aws"), path.MatchRoot("azure")),
+				},
+			},
+			"aws": schema.SingleNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "AWS interface endpoint to connect to the serverless instance. Requires `provider_name` to be `AWS`.",
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplaceIf(requiresReplaceIfEndpointChanged, "Changing the AWS endpoint requires replacement.", "Changing the AWS endpoint requires replacement."),
+				},
+				Validators: []validator.Object{
+					objectvalidator.ConflictsWith(path.MatchRoot("azure")),
+				},
+				Attributes: map[string]schema.Attribute{
+					"vpc_endpoint_id": schema.StringAttribute{
+						Required:            true,
+						MarkdownDescription: "Unique string that identifies the AWS VPC interface endpoint.",
+					},
+				},
+			},
+			"azure": schema.SingleNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "Azure private endpoint to connect to the serverless instance. Requires `provider_name` to be `AZURE`.",
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplaceIf(requiresReplaceIfEndpointChanged, "Changing the Azure endpoint requires replacement.", "Changing the Azure endpoint requires replacement."),
+				},
+				Attributes: map[string]schema.Attribute{
+					"private_endpoint_id": schema.StringAttribute{
+						Required:            true,
+						MarkdownDescription: "Unique string that identifies the Azure private endpoint.",
+					},
+					"private_endpoint_ip_address": schema.StringAttribute{
+						Required:            true,
+						MarkdownDescription: "IPv4 address of the Azure private endpoint.",
+						Validators: []validator.String{
+							validate.ValidIP(),
+						},
+					},
+				},
+			},
+			"private_link_service_resource_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Root-relative path that identifies the Azure Private Link Service.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"status": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Current status of the private endpoint.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"error_message": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Error message of the private endpoint, empty if there is no error.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+type TFModel struct {
+	AWS                          *TFAWSModel    `tfsdk:"aws"`
+	Azure                        *TFAzureModel  `tfsdk:"azure"`
+	ID                           types.String   `tfsdk:"id"`
+	ProjectID                    types.String   `tfsdk:"project_id"`
+	InstanceName                 types.String   `tfsdk:"instance_name"`
+	EndpointID                   types.String   `tfsdk:"endpoint_id"`
+	ProviderName                 types.String   `tfsdk:"provider_name"`
+	Comment                      types.String   `tfsdk:"comment"`
+	CloudProviderEndpointID      types.String   `tfsdk:"cloud_provider_endpoint_id"`
+	PrivateEndpointIPAddress     types.String   `tfsdk:"private_endpoint_ip_address"`
+	PrivateLinkServiceResourceID types.String   `tfsdk:"private_link_service_resource_id"`
+	Status                       types.String   `tfsdk:"status"`
+	ErrorMessage                 types.String   `tfsdk:"error_message"`
+	Timeouts                     timeouts.Value `tfsdk:"timeouts"`
+}
+
+type TFAWSModel struct {
+	VPCEndpointID types.String `tfsdk:"vpc_endpoint_id"`
+}
+
+type TFAzureModel struct {
+	PrivateEndpointID        types.String `tfsdk:"private_endpoint_id"`
+	PrivateEndpointIPAddress types.String `tfsdk:"private_endpoint_ip_address"`
+}
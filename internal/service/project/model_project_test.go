@@ -550,3 +550,10 @@ func TestUpdateProjectBool(t *testing.T) {
 		})
 	}
 }
+
+func TestIsManagedSeparately(t *testing.T) {
+	managedSeparately := types.SetValueMust(types.StringType, []attr.Value{types.StringValue(project.ManagedSeparatelyTeams)})
+	assert.True(t, project.IsManagedSeparately(managedSeparately, project.ManagedSeparatelyTeams))
+	assert.False(t, project.IsManagedSeparately(managedSeparately, project.ManagedSeparatelySettings))
+	assert.False(t, project.IsManagedSeparately(types.SetNull(types.StringType), project.ManagedSeparatelyTeams))
+}
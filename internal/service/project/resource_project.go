@@ -123,33 +123,35 @@ func (r *projectRS) Create(ctx context.Context, req resource.CreateRequest, resp
 		}
 	}
 
-	// add settings
-	projectSettings, _, err := connV2.ProjectsApi.GetProjectSettings(ctx, *project.Id).Execute()
-	if err != nil {
-		errd := deleteProject(ctx, connV2.ClustersApi, connV2.ProjectsApi, project.GetId())
-		if errd != nil {
-			resp.Diagnostics.AddError("error during project deletion when getting project settings", fmt.Sprintf(errorProjectDelete, project.GetId(), err.Error()))
+	// add settings, unless they are managed by mongodbatlas_project_settings
+	if !IsManagedSeparately(projectPlan.ManagedSeparately, ManagedSeparatelySettings) {
+		projectSettings, _, err := connV2.ProjectsApi.GetProjectSettings(ctx, *project.Id).Execute()
+		if err != nil {
+			errd := deleteProject(ctx, connV2.ClustersApi, connV2.ProjectsApi, project.GetId())
+			if errd != nil {
+				resp.Diagnostics.AddError("error during project deletion when getting project settings", fmt.Sprintf(errorProjectDelete, project.GetId(), err.Error()))
+				return
+			}
+			resp.Diagnostics.AddError(fmt.Sprintf("error getting project's settings assigned (%s):", project.GetId()), err.Error())
 			return
 		}
-		resp.Diagnostics.AddError(fmt.Sprintf("error getting project's settings assigned (%s):", project.GetId()), err.Error())
-		return
-	}
 
-	SetProjectBool(projectPlan.IsCollectDatabaseSpecificsStatisticsEnabled, &projectSettings.IsCollectDatabaseSpecificsStatisticsEnabled)
-	SetProjectBool(projectPlan.IsDataExplorerEnabled, &projectSettings.IsDataExplorerEnabled)
-	SetProjectBool(projectPlan.IsExtendedStorageSizesEnabled, &projectSettings.IsExtendedStorageSizesEnabled)
-	SetProjectBool(projectPlan.IsPerformanceAdvisorEnabled, &projectSettings.IsPerformanceAdvisorEnabled)
-	SetProjectBool(projectPlan.IsRealtimePerformancePanelEnabled, &projectSettings.IsRealtimePerformancePanelEnabled)
-	SetProjectBool(projectPlan.IsSchemaAdvisorEnabled, &projectSettings.IsSchemaAdvisorEnabled)
+		SetProjectBool(projectPlan.IsCollectDatabaseSpecificsStatisticsEnabled, &projectSettings.IsCollectDatabaseSpecificsStatisticsEnabled)
+		SetProjectBool(projectPlan.IsDataExplorerEnabled, &projectSettings.IsDataExplorerEnabled)
+		SetProjectBool(projectPlan.IsExtendedStorageSizesEnabled, &projectSettings.IsExtendedStorageSizesEnabled)
+		SetProjectBool(projectPlan.IsPerformanceAdvisorEnabled, &projectSettings.IsPerformanceAdvisorEnabled)
+		SetProjectBool(projectPlan.IsRealtimePerformancePanelEnabled, &projectSettings.IsRealtimePerformancePanelEnabled)
+		SetProjectBool(projectPlan.IsSchemaAdvisorEnabled, &projectSettings.IsSchemaAdvisorEnabled)
 
-	if _, _, err = connV2.ProjectsApi.UpdateProjectSettings(ctx, project.GetId(), projectSettings).Execute(); err != nil {
-		errd := deleteProject(ctx, connV2.ClustersApi, connV2.ProjectsApi, project.GetId())
-		if errd != nil {
-			resp.Diagnostics.AddError("error during project deletion when updating project settings", fmt.Sprintf(errorProjectDelete, project.GetId(), err.Error()))
+		if _, _, err = connV2.ProjectsApi.UpdateProjectSettings(ctx, project.GetId(), projectSettings).Execute(); err != nil {
+			errd := deleteProject(ctx, connV2.ClustersApi, connV2.ProjectsApi, project.GetId())
+			if errd != nil {
+				resp.Diagnostics.AddError("error during project deletion when updating project settings", fmt.Sprintf(errorProjectDelete, project.GetId(), err.Error()))
+				return
+			}
+			resp.Diagnostics.AddError(fmt.Sprintf("error updating project's settings assigned (%s):", project.GetId()), err.Error())
 			return
 		}
-		resp.Diagnostics.AddError(fmt.Sprintf("error updating project's settings assigned (%s):", project.GetId()), err.Error())
-		return
 	}
 
 	projectID := project.GetId()
@@ -263,10 +265,12 @@ func (r *projectRS) Update(ctx context.Context, req resource.UpdateRequest, resp
 		return
 	}
 
-	err = UpdateProjectTeams(ctx, connV2.TeamsApi, &projectState, &projectPlan)
-	if err != nil {
-		resp.Diagnostics.AddError("error in project teams update", fmt.Sprintf(errorProjectUpdate, projectID, err.Error()))
-		return
+	if !IsManagedSeparately(projectPlan.ManagedSeparately, ManagedSeparatelyTeams) {
+		err = UpdateProjectTeams(ctx, connV2.TeamsApi, &projectState, &projectPlan)
+		if err != nil {
+			resp.Diagnostics.AddError("error in project teams update", fmt.Sprintf(errorProjectUpdate, projectID, err.Error()))
+			return
+		}
 	}
 
 	err = UpdateProjectLimits(ctx, connV2.ProjectsApi, &projectState, &projectPlan)
@@ -275,10 +279,12 @@ func (r *projectRS) Update(ctx context.Context, req resource.UpdateRequest, resp
 		return
 	}
 
-	err = updateProjectSettings(ctx, connV2.ProjectsApi, connV2.PerformanceAdvisorApi, &projectState, &projectPlan)
-	if err != nil {
-		resp.Diagnostics.AddError("error in project settings update", fmt.Sprintf(errorProjectUpdate, projectID, err.Error()))
-		return
+	if !IsManagedSeparately(projectPlan.ManagedSeparately, ManagedSeparatelySettings) {
+		err = updateProjectSettings(ctx, connV2.ProjectsApi, connV2.PerformanceAdvisorApi, &projectState, &projectPlan)
+		if err != nil {
+			resp.Diagnostics.AddError("error in project settings update", fmt.Sprintf(errorProjectUpdate, projectID, err.Error()))
+			return
+		}
 	}
 
 	projectRes, _, err := connV2.ProjectsApi.GetProject(ctx, projectID).Execute()
@@ -340,6 +346,7 @@ func updatePlanFromConfig(projectPlanNewPtr, projectPlan *TFProjectRSModel) {
 	if projectPlan.Tags.IsNull() && len(projectPlanNewPtr.Tags.Elements()) == 0 {
 		projectPlanNewPtr.Tags = types.MapNull(types.StringType)
 	}
+	ignoreManagedSeparately(projectPlanNewPtr, projectPlan)
 }
 
 func FilterUserDefinedLimits(allAtlasLimits []admin.DataFederationLimit, tflimits []TFLimitModel) []admin.DataFederationLimit {
@@ -0,0 +1,96 @@
+package project
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	ManagedSeparatelyTeams    = "teams"
+	ManagedSeparatelySettings = "settings"
+)
+
+// settingsAttributes are ignored when settings are managed by mongodbatlas_project_settings.
+var settingsAttributes = []string{
+	"is_collect_database_specifics_statistics_enabled",
+	"is_data_explorer_enabled",
+	"is_extended_storage_sizes_enabled",
+	"is_performance_advisor_enabled",
+	"is_realtime_performance_panel_enabled",
+	"is_schema_advisor_enabled",
+	"is_slow_operation_thresholding_enabled",
+}
+
+var _ resource.ResourceWithValidateConfig = &projectRS{}
+var _ resource.ResourceWithModifyPlan = &projectRS{}
+
+// IsManagedSeparately returns true if field is in managed_separately, meaning that it's managed by another resource and ignored by the project.
+func IsManagedSeparately(managedSeparately types.Set, field string) bool {
+	for _, elm := range managedSeparately.Elements() {
+		if value, ok := elm.(types.String); ok && value.ValueString() == field {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *projectRS) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config TFProjectRSModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if IsManagedSeparately(config.ManagedSeparately, ManagedSeparatelyTeams) && len(config.Teams.Elements()) > 0 {
+		resp.Diagnostics.AddAttributeError(path.Root("teams"), "Invalid project configuration",
+			fmt.Sprintf("teams can't be defined when managed_separately contains %q, use mongodbatlas_team_project_assignment instead", ManagedSeparatelyTeams))
+	}
+	if !IsManagedSeparately(config.ManagedSeparately, ManagedSeparatelySettings) {
+		return
+	}
+	for _, attr := range settingsAttributes {
+		var value types.Bool
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(attr), &value)...)
+		if !value.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root(attr), "Invalid project configuration",
+				fmt.Sprintf("%s can't be defined when managed_separately contains %q, use mongodbatlas_project_settings instead", attr, ManagedSeparatelySettings))
+		}
+	}
+}
+
+// ModifyPlan removes the settings from the plan when they are managed separately, so the values previously in the state don't
+// cause inconsistent results.
+func (r *projectRS) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	var managedSeparately types.Set
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("managed_separately"), &managedSeparately)...)
+	if resp.Diagnostics.HasError() || !IsManagedSeparately(managedSeparately, ManagedSeparatelySettings) {
+		return
+	}
+	for _, attr := range settingsAttributes {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root(attr), types.BoolNull())...)
+	}
+}
+
+// ignoreManagedSeparately keeps the teams from prev and removes the settings when they are managed by other resources,
+// so changes done by those resources are not shown as drift in the project.
+func ignoreManagedSeparately(model, prev *TFProjectRSModel) {
+	model.ManagedSeparately = prev.ManagedSeparately
+	if IsManagedSeparately(prev.ManagedSeparately, ManagedSeparatelyTeams) {
+		model.Teams = prev.Teams
+	}
+	if IsManagedSeparately(prev.ManagedSeparately, ManagedSeparatelySettings) {
+		model.IsCollectDatabaseSpecificsStatisticsEnabled = types.BoolNull()
+		model.IsDataExplorerEnabled = types.BoolNull()
+		model.IsExtendedStorageSizesEnabled = types.BoolNull()
+		model.IsPerformanceAdvisorEnabled = types.BoolNull()
+		model.IsRealtimePerformancePanelEnabled = types.BoolNull()
+		model.IsSchemaAdvisorEnabled = types.BoolNull()
+		model.IsSlowOperationThresholdingEnabled = types.BoolNull()
+	}
+}
@@ -0,0 +1,194 @@
+package projectipaccesslist
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+
+	"go.mongodb.org/atlas-sdk/v20250312003/admin"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// key identifies the entry in Atlas. Single IP addresses are stored as a CIDR block with a full mask,
+// so ip_address = "10.0.0.1" and cidr_block = "10.0.0.1/32" refer to the same entry.
+func (e *TFAccessListSetEntryModel) key() string {
+	return accessListEntryKey(e.CIDRBlock.ValueString(), e.IPAddress.ValueString(), e.AWSSecurityGroup.ValueString())
+}
+
+// entryValue is the value used to identify the entry in the Atlas API path.
+func (e *TFAccessListSetEntryModel) entryValue() string {
+	switch {
+	case e.AWSSecurityGroup.ValueString() != "":
+		return e.AWSSecurityGroup.ValueString()
+	case e.CIDRBlock.ValueString() != "":
+		return e.CIDRBlock.ValueString()
+	default:
+		return e.IPAddress.ValueString()
+	}
+}
+
+func accessListEntryKey(cidrBlock, ipAddress, awsSecurityGroup string) string {
+	switch {
+	case awsSecurityGroup != "":
+		return awsSecurityGroup
+	case cidrBlock != "":
+		if prefix, err := netip.ParsePrefix(cidrBlock); err == nil {
+			return prefix.String()
+		}
+		return cidrBlock
+	case ipAddress != "":
+		if addr, err := netip.ParseAddr(ipAddress); err == nil {
+			return netip.PrefixFrom(addr, addr.BitLen()).String()
+		}
+		return ipAddress
+	}
+	return ""
+}
+
+func apiEntryKey(entry *admin.NetworkPermissionEntry) string {
+	return accessListEntryKey(entry.GetCidrBlock(), entry.GetIpAddress(), entry.GetAwsSecurityGroup())
+}
+
+func apiEntryValue(entry *admin.NetworkPermissionEntry) string {
+	switch {
+	case entry.GetAwsSecurityGroup() != "":
+		return entry.GetAwsSecurityGroup()
+	case entry.GetCidrBlock() != "":
+		return entry.GetCidrBlock()
+	default:
+		return entry.GetIpAddress()
+	}
+}
+
+// ValidateAccessListSetEntries checks that every entry defines exactly one of cidr_block, ip_address or aws_security_group,
+// and that no entry is defined twice.
+func ValidateAccessListSetEntries(entries []TFAccessListSetEntryModel) []error {
+	var errs []error
+	keys := make(map[string]bool, len(entries))
+	for i := range entries {
+		entry := &entries[i]
+		if entry.CIDRBlock.IsUnknown() || entry.IPAddress.IsUnknown() || entry.AWSSecurityGroup.IsUnknown() {
+			continue
+		}
+		defined := 0
+		for _, value := range []types.String{entry.CIDRBlock, entry.IPAddress, entry.AWSSecurityGroup} {
+			if value.ValueString() != "" {
+				defined++
+			}
+		}
+		if defined != 1 {
+			errs = append(errs, fmt.Errorf("each entry must define exactly one of cidr_block, ip_address or aws_security_group, found %d in entry %q", defined, entry.entryValue()))
+			continue
+		}
+		key := entry.key()
+		if keys[key] {
+			errs = append(errs, fmt.Errorf("entry %q is defined more than once", key))
+		}
+		keys[key] = true
+	}
+	return errs
+}
+
+func NewAtlasAccessListSetEntries(entries []TFAccessListSetEntryModel) []admin.NetworkPermissionEntry {
+	result := make([]admin.NetworkPermissionEntry, 0, len(entries))
+	for i := range entries {
+		result = append(result, admin.NetworkPermissionEntry{
+			CidrBlock:        entries[i].CIDRBlock.ValueStringPointer(),
+			IpAddress:        entries[i].IPAddress.ValueStringPointer(),
+			AwsSecurityGroup: entries[i].AWSSecurityGroup.ValueStringPointer(),
+			Comment:          entries[i].Comment.ValueStringPointer(),
+		})
+	}
+	return result
+}
+
+// NewTFAccessListSetEntries converts the entries returned by Atlas, keeping the format (ip_address or cidr_block) used in the prior state.
+// Entries not in the prior state are only included if includeUnmanaged is true.
+func NewTFAccessListSetEntries(ctx context.Context, stateEntries []TFAccessListSetEntryModel, apiEntries []admin.NetworkPermissionEntry, includeUnmanaged bool) (types.Set, diag.Diagnostics) {
+	stateByKey := make(map[string]*TFAccessListSetEntryModel, len(stateEntries))
+	for i := range stateEntries {
+		stateByKey[stateEntries[i].key()] = &stateEntries[i]
+	}
+	entries := make([]TFAccessListSetEntryModel, 0, len(apiEntries))
+	for i := range apiEntries {
+		apiEntry := &apiEntries[i]
+		stateEntry, managed := stateByKey[apiEntryKey(apiEntry)]
+		switch {
+		case managed:
+			entries = append(entries, TFAccessListSetEntryModel{
+				CIDRBlock:        stateEntry.CIDRBlock,
+				IPAddress:        stateEntry.IPAddress,
+				AWSSecurityGroup: stateEntry.AWSSecurityGroup,
+				Comment:          newCommentValue(stateEntry.Comment, apiEntry.GetComment()),
+			})
+		case includeUnmanaged:
+			entries = append(entries, newTFAccessListSetEntry(apiEntry))
+		}
+	}
+	return types.SetValueFrom(ctx, AccessListSetEntryObjType, entries)
+}
+
+func newTFAccessListSetEntry(apiEntry *admin.NetworkPermissionEntry) TFAccessListSetEntryModel {
+	entry := TFAccessListSetEntryModel{
+		CIDRBlock:        types.StringNull(),
+		IPAddress:        types.StringNull(),
+		AWSSecurityGroup: types.StringNull(),
+		Comment:          newCommentValue(types.StringNull(), apiEntry.GetComment()),
+	}
+	switch {
+	case apiEntry.GetAwsSecurityGroup() != "":
+		entry.AWSSecurityGroup = types.StringValue(apiEntry.GetAwsSecurityGroup())
+	case apiEntry.GetIpAddress() != "":
+		entry.IPAddress = types.StringValue(apiEntry.GetIpAddress())
+	default:
+		entry.CIDRBlock = types.StringValue(apiEntry.GetCidrBlock())
+	}
+	return entry
+}
+
+func newCommentValue(prevComment types.String, comment string) types.String {
+	if comment == "" && prevComment.IsNull() {
+		return types.StringNull()
+	}
+	return types.StringValue(comment)
+}
+
+// DiffAccessListSetEntries returns the entries that must be added and deleted to go from state to plan.
+// Entries whose comment changed are added again as Atlas updates the comment of existing entries.
+func DiffAccessListSetEntries(stateEntries, planEntries []TFAccessListSetEntryModel) (toAdd, toDelete []TFAccessListSetEntryModel) {
+	stateByKey := make(map[string]*TFAccessListSetEntryModel, len(stateEntries))
+	for i := range stateEntries {
+		stateByKey[stateEntries[i].key()] = &stateEntries[i]
+	}
+	planKeys := make(map[string]bool, len(planEntries))
+	for i := range planEntries {
+		planKeys[planEntries[i].key()] = true
+		stateEntry, found := stateByKey[planEntries[i].key()]
+		if !found || stateEntry.Comment.ValueString() != planEntries[i].Comment.ValueString() {
+			toAdd = append(toAdd, planEntries[i])
+		}
+	}
+	for i := range stateEntries {
+		if !planKeys[stateEntries[i].key()] {
+			toDelete = append(toDelete, stateEntries[i])
+		}
+	}
+	return toAdd, toDelete
+}
+
+// UnmanagedAccessListEntries returns the Atlas entries that are not defined in entries.
+func UnmanagedAccessListEntries(apiEntries []admin.NetworkPermissionEntry, entries []TFAccessListSetEntryModel) []admin.NetworkPermissionEntry {
+	keys := make(map[string]bool, len(entries))
+	for i := range entries {
+		keys[entries[i].key()] = true
+	}
+	var unmanaged []admin.NetworkPermissionEntry
+	for i := range apiEntries {
+		if !keys[apiEntryKey(&apiEntries[i])] {
+			unmanaged = append(unmanaged, apiEntries[i])
+		}
+	}
+	return unmanaged
+}
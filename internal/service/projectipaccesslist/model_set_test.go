@@ -0,0 +1,153 @@
+package projectipaccesslist_test
+
+import (
+	"testing"
+
+	"go.mongodb.org/atlas-sdk/v20250312003/admin"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/projectipaccesslist"
+)
+
+func ipEntry(ip, comment string) projectipaccesslist.TFAccessListSetEntryModel {
+	return projectipaccesslist.TFAccessListSetEntryModel{
+		IPAddress:        types.StringValue(ip),
+		CIDRBlock:        types.StringNull(),
+		AWSSecurityGroup: types.StringNull(),
+		Comment:          commentValue(comment),
+	}
+}
+
+func cidrEntry(cidr, comment string) projectipaccesslist.TFAccessListSetEntryModel {
+	return projectipaccesslist.TFAccessListSetEntryModel{
+		IPAddress:        types.StringNull(),
+		CIDRBlock:        types.StringValue(cidr),
+		AWSSecurityGroup: types.StringNull(),
+		Comment:          commentValue(comment),
+	}
+}
+
+func commentValue(comment string) types.String {
+	if comment == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(comment)
+}
+
+func TestValidateAccessListSetEntries(t *testing.T) {
+	testCases := map[string]struct {
+		entries     []projectipaccesslist.TFAccessListSetEntryModel
+		expectedErr []string
+	}{
+		"valid entries": {
+			entries: []projectipaccesslist.TFAccessListSetEntryModel{ipEntry("10.0.0.1", ""), cidrEntry("10.0.1.0/24", "")},
+		},
+		"no value": {
+			entries:     []projectipaccesslist.TFAccessListSetEntryModel{ipEntry("", "")},
+			expectedErr: []string{"each entry must define exactly one of cidr_block, ip_address or aws_security_group, found 0"},
+		},
+		"two values": {
+			entries: []projectipaccesslist.TFAccessListSetEntryModel{{
+				IPAddress: types.StringValue("10.0.0.1"),
+				CIDRBlock: types.StringValue("10.0.0.1/32"),
+			}},
+			expectedErr: []string{"found 2"},
+		},
+		"same entry as ip_address and cidr_block": {
+			entries:     []projectipaccesslist.TFAccessListSetEntryModel{ipEntry("10.0.0.1", ""), cidrEntry("10.0.0.1/32", "")},
+			expectedErr: []string{"entry \"10.0.0.1/32\" is defined more than once"},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			errs := projectipaccesslist.ValidateAccessListSetEntries(tc.entries)
+			require.Len(t, errs, len(tc.expectedErr))
+			for i := range errs {
+				assert.ErrorContains(t, errs[i], tc.expectedErr[i])
+			}
+		})
+	}
+}
+
+func TestDiffAccessListSetEntries(t *testing.T) {
+	state := []projectipaccesslist.TFAccessListSetEntryModel{
+		ipEntry("10.0.0.1", "unchanged"),
+		ipEntry("10.0.0.2", "old comment"),
+		cidrEntry("10.0.1.0/24", ""),
+		cidrEntry("10.0.2.0/24", ""),
+	}
+	plan := []projectipaccesslist.TFAccessListSetEntryModel{
+		ipEntry("10.0.0.1", "unchanged"),
+		ipEntry("10.0.0.2", "new comment"),
+		ipEntry("10.0.3.1", ""),
+		cidrEntry("10.0.2.0/24", ""),
+	}
+	toAdd, toDelete := projectipaccesslist.DiffAccessListSetEntries(state, plan)
+	assert.Equal(t, []projectipaccesslist.TFAccessListSetEntryModel{ipEntry("10.0.0.2", "new comment"), ipEntry("10.0.3.1", "")}, toAdd)
+	assert.Equal(t, []projectipaccesslist.TFAccessListSetEntryModel{cidrEntry("10.0.1.0/24", "")}, toDelete)
+}
+
+func TestDiffAccessListSetEntriesFormatChange(t *testing.T) {
+	toAdd, toDelete := projectipaccesslist.DiffAccessListSetEntries(
+		[]projectipaccesslist.TFAccessListSetEntryModel{ipEntry("10.0.0.1", "")},
+		[]projectipaccesslist.TFAccessListSetEntryModel{cidrEntry("10.0.0.1/32", "")},
+	)
+	assert.Empty(t, toAdd)
+	assert.Empty(t, toDelete)
+}
+
+func TestNewTFAccessListSetEntries(t *testing.T) {
+	apiEntries := []admin.NetworkPermissionEntry{
+		{IpAddress: admin.PtrString("10.0.0.1"), CidrBlock: admin.PtrString("10.0.0.1/32"), Comment: admin.PtrString("managed")},
+		{CidrBlock: admin.PtrString("10.0.1.0/24")},
+		{IpAddress: admin.PtrString("10.0.0.9"), CidrBlock: admin.PtrString("10.0.0.9/32"), Comment: admin.PtrString("unmanaged")},
+		{AwsSecurityGroup: admin.PtrString("sg-12345")},
+	}
+	state := []projectipaccesslist.TFAccessListSetEntryModel{
+		ipEntry("10.0.0.1", "old comment"),
+		cidrEntry("10.0.1.0/24", ""),
+	}
+	testCases := map[string]struct {
+		expected         []projectipaccesslist.TFAccessListSetEntryModel
+		includeUnmanaged bool
+	}{
+		"only managed entries": {
+			expected: []projectipaccesslist.TFAccessListSetEntryModel{ipEntry("10.0.0.1", "managed"), cidrEntry("10.0.1.0/24", "")},
+		},
+		"all entries": {
+			includeUnmanaged: true,
+			expected: []projectipaccesslist.TFAccessListSetEntryModel{
+				ipEntry("10.0.0.1", "managed"),
+				cidrEntry("10.0.1.0/24", ""),
+				ipEntry("10.0.0.9", "unmanaged"),
+				{
+					IPAddress:        types.StringNull(),
+					CIDRBlock:        types.StringNull(),
+					AWSSecurityGroup: types.StringValue("sg-12345"),
+					Comment:          types.StringNull(),
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			entries, diags := projectipaccesslist.NewTFAccessListSetEntries(t.Context(), state, apiEntries, tc.includeUnmanaged)
+			require.False(t, diags.HasError())
+			var result []projectipaccesslist.TFAccessListSetEntryModel
+			require.False(t, entries.ElementsAs(t.Context(), &result, false).HasError())
+			assert.ElementsMatch(t, tc.expected, result)
+		})
+	}
+}
+
+func TestUnmanagedAccessListEntries(t *testing.T) {
+	apiEntries := []admin.NetworkPermissionEntry{
+		{IpAddress: admin.PtrString("10.0.0.1"), CidrBlock: admin.PtrString("10.0.0.1/32")},
+		{CidrBlock: admin.PtrString("10.0.1.0/24")},
+	}
+	unmanaged := projectipaccesslist.UnmanagedAccessListEntries(apiEntries, []projectipaccesslist.TFAccessListSetEntryModel{cidrEntry("10.0.0.1/32", "")})
+	assert.Equal(t, []admin.NetworkPermissionEntry{apiEntries[1]}, unmanaged)
+}
@@ -0,0 +1,304 @@
+package projectipaccesslist
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"go.mongodb.org/atlas-sdk/v20250312003/admin"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/conversion"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/dsschema"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/validate"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/config"
+)
+
+const (
+	projectIPAccessListSet   = "project_ip_access_list_set"
+	errorAccessListSetCreate = "error creating Project IP Access List entries"
+	errorAccessListSetRead   = "error getting Project IP Access List entries"
+	errorAccessListSetUpdate = "error updating Project IP Access List entries"
+	errorAccessListSetDelete = "error deleting Project IP Access List entries"
+	// entriesPerRequest limits the number of entries sent in a single POST request.
+	entriesPerRequest = 100
+)
+
+type projectIPAccessListSetRS struct {
+	config.RSCommon
+}
+
+func SetResource() resource.Resource {
+	return &projectIPAccessListSetRS{
+		RSCommon: config.RSCommon{
+			ResourceName: projectIPAccessListSet,
+		},
+	}
+}
+
+var _ resource.ResourceWithConfigure = &projectIPAccessListSetRS{}
+var _ resource.ResourceWithImportState = &projectIPAccessListSetRS{}
+var _ resource.ResourceWithValidateConfig = &projectIPAccessListSetRS{}
+
+func (r *projectIPAccessListSetRS) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = ResourceSetSchema(ctx)
+	conversion.UpdateSchemaDescription(&resp.Schema)
+}
+
+func (r *projectIPAccessListSetRS) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var entriesSet types.Set
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("entries"), &entriesSet)...)
+	if resp.Diagnostics.HasError() || entriesSet.IsNull() || entriesSet.IsUnknown() {
+		return
+	}
+	var entries []TFAccessListSetEntryModel
+	if diags := entriesSet.ElementsAs(ctx, &entries, false); diags.HasError() {
+		return // unknown entries are validated during apply
+	}
+	for _, err := range ValidateAccessListSetEntries(entries) {
+		resp.Diagnostics.AddAttributeError(path.Root("entries"), "Invalid access list entry", err.Error())
+	}
+}
+
+func (r *projectIPAccessListSetRS) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan TFAccessListSetModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	planEntries := getEntries(ctx, plan.Entries, &resp.Diagnostics)
+	timeout, diags := plan.Timeouts.Create(ctx, timeoutCreateDelete)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID := plan.ProjectID.ValueString()
+	if err := r.apply(ctx, projectID, planEntries, planEntries, nil, plan.Exclusive.ValueBool(), timeout); err != nil {
+		resp.Diagnostics.AddError(errorAccessListSetCreate, err.Error())
+		return
+	}
+	plan.ID = types.StringValue(projectID)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *projectIPAccessListSetRS) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state TFAccessListSetModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	imported := state.Entries.IsNull()
+	stateEntries := getEntries(ctx, state.Entries, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID := state.ProjectID.ValueString()
+	apiEntries, httpResp, err := listAccessListEntries(ctx, r.Client.AtlasV2.ProjectIPAccessListApi, projectID)
+	if err != nil {
+		if validate.StatusNotFound(httpResp) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(errorAccessListSetRead, err.Error())
+		return
+	}
+	if imported {
+		state.Exclusive = types.BoolValue(false)
+	}
+	entries, diags := NewTFAccessListSetEntries(ctx, stateEntries, apiEntries, imported || state.Exclusive.ValueBool())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.ID = types.StringValue(projectID)
+	state.Entries = entries
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *projectIPAccessListSetRS) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state TFAccessListSetModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	planEntries := getEntries(ctx, plan.Entries, &resp.Diagnostics)
+	stateEntries := getEntries(ctx, state.Entries, &resp.Diagnostics)
+	timeout, diags := plan.Timeouts.Update(ctx, timeoutCreateDelete)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	toAdd, toDelete := DiffAccessListSetEntries(stateEntries, planEntries)
+	if err := r.apply(ctx, plan.ProjectID.ValueString(), planEntries, toAdd, toDelete, plan.Exclusive.ValueBool(), timeout); err != nil {
+		resp.Diagnostics.AddError(errorAccessListSetUpdate, err.Error())
+		return
+	}
+	plan.ID = state.ID
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete only removes the entries managed by the resource, even if exclusive is true.
+func (r *projectIPAccessListSetRS) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state TFAccessListSetModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	stateEntries := getEntries(ctx, state.Entries, &resp.Diagnostics)
+	timeout, diags := state.Timeouts.Delete(ctx, timeoutCreateDelete)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.apply(ctx, state.ProjectID.ValueString(), nil, nil, stateEntries, false, timeout); err != nil {
+		resp.Diagnostics.AddError(errorAccessListSetDelete, err.Error())
+	}
+}
+
+func (r *projectIPAccessListSetRS) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), req.ID)...)
+}
+
+// apply adds and deletes entries, deleting also the entries not in planEntries if exclusive is true, and waits until the access list contains planEntries.
+func (r *projectIPAccessListSetRS) apply(ctx context.Context, projectID string, planEntries, toAdd, toDelete []TFAccessListSetEntryModel, exclusive bool, timeout time.Duration) error {
+	if errs := ValidateAccessListSetEntries(planEntries); len(errs) > 0 {
+		return errors.Join(errs...)
+	}
+	client := r.Client.AtlasV2.ProjectIPAccessListApi
+	// POST requests must be sent sequentially as the endpoint doesn't support concurrent requests.
+	apiEntries := NewAtlasAccessListSetEntries(toAdd)
+	for start := 0; start < len(apiEntries); start += entriesPerRequest {
+		batch := apiEntries[start:min(start+entriesPerRequest, len(apiEntries))]
+		err := retryOnServerError(ctx, timeout, func() (*http.Response, error) {
+			_, httpResp, err := client.CreateProjectIpAccessList(ctx, projectID, &batch).Execute()
+			return httpResp, err
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	deletedKeys := make([]string, 0, len(toDelete))
+	for i := range toDelete {
+		if err := deleteAccessListEntry(ctx, client, projectID, toDelete[i].entryValue(), timeout); err != nil {
+			return err
+		}
+		deletedKeys = append(deletedKeys, toDelete[i].key())
+	}
+	if exclusive {
+		currentEntries, _, err := listAccessListEntries(ctx, client, projectID)
+		if err != nil {
+			return err
+		}
+		unmanaged := UnmanagedAccessListEntries(currentEntries, planEntries)
+		for i := range unmanaged {
+			if err := deleteAccessListEntry(ctx, client, projectID, apiEntryValue(&unmanaged[i]), timeout); err != nil {
+				return err
+			}
+			deletedKeys = append(deletedKeys, apiEntryKey(&unmanaged[i]))
+		}
+	}
+
+	expectedKeys := make([]string, 0, len(planEntries))
+	for i := range planEntries {
+		expectedKeys = append(expectedKeys, planEntries[i].key())
+	}
+	return waitForAccessListEntries(ctx, client, projectID, expectedKeys, deletedKeys, timeout)
+}
+
+func deleteAccessListEntry(ctx context.Context, client admin.ProjectIPAccessListApi, projectID, entryValue string, timeout time.Duration) error {
+	return retryOnServerError(ctx, timeout, func() (*http.Response, error) {
+		httpResp, err := client.DeleteProjectIpAccessList(ctx, projectID, entryValue).Execute()
+		if validate.StatusNotFound(httpResp) {
+			return httpResp, nil
+		}
+		return httpResp, err
+	})
+}
+
+// waitForAccessListEntries waits until all the present entries exist and none of the absent entries exist, polling the whole list instead of each entry.
+func waitForAccessListEntries(ctx context.Context, client admin.ProjectIPAccessListApi, projectID string, present, absent []string, timeout time.Duration) error {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{"pending"},
+		Target:  []string{"applied"},
+		Refresh: func() (any, string, error) {
+			entries, httpResp, err := listAccessListEntries(ctx, client, projectID)
+			if err != nil {
+				if validate.StatusInternalServerError(httpResp) {
+					return nil, "pending", nil
+				}
+				return nil, "", err
+			}
+			current := make(map[string]bool, len(entries))
+			for i := range entries {
+				current[apiEntryKey(&entries[i])] = true
+			}
+			for _, key := range present {
+				if !current[key] {
+					return entries, "pending", nil
+				}
+			}
+			for _, key := range absent {
+				if current[key] {
+					return entries, "pending", nil
+				}
+			}
+			return entries, "applied", nil
+		},
+		Timeout:    timeout,
+		MinTimeout: minTimeoutCreate,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("error waiting for access list entries: %w", err)
+	}
+	return nil
+}
+
+func listAccessListEntries(ctx context.Context, client admin.ProjectIPAccessListApi, projectID string) ([]admin.NetworkPermissionEntry, *http.Response, error) {
+	var lastResp *http.Response
+	entries, err := dsschema.AllPages(ctx, func(ctx context.Context, pageNum int) (dsschema.PaginateResponse[admin.NetworkPermissionEntry], *http.Response, error) {
+		page, httpResp, err := client.ListProjectIpAccessLists(ctx, projectID).PageNum(pageNum).Execute()
+		lastResp = httpResp
+		return page, httpResp, err
+	})
+	return entries, lastResp, err
+}
+
+func retryOnServerError(ctx context.Context, timeout time.Duration, call func() (*http.Response, error)) error {
+	return retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+		httpResp, err := call()
+		if err == nil {
+			return nil
+		}
+		if validate.StatusInternalServerError(httpResp) {
+			return retry.RetryableError(err)
+		}
+		return retry.NonRetryableError(err)
+	})
+}
+
+func getEntries(ctx context.Context, entriesSet types.Set, diags *diag.Diagnostics) []TFAccessListSetEntryModel {
+	var entries []TFAccessListSetEntryModel
+	if entriesSet.IsNull() {
+		return entries
+	}
+	if entriesSet.IsUnknown() {
+		diags.AddError("Invalid entries", "entries must be known during apply")
+		return nil
+	}
+	diags.Append(entriesSet.ElementsAs(ctx, &entries, false)...)
+	return entries
+}
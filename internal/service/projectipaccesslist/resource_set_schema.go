@@ -0,0 +1,99 @@
+package projectipaccesslist
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/validate"
+)
+
+func ResourceSetSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Unique 24-hexadecimal digit string that identifies your project.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"exclusive": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Flag that indicates whether this resource owns the whole access list. If `true`, entries that are not defined in `entries`, including entries added outside of Terraform, are deleted. If `false`, only entries defined in `entries` are managed. Defaults to `false`.",
+			},
+			"entries": schema.SetNestedAttribute{
+				Required:            true,
+				MarkdownDescription: "Set of access list entries. Each entry must define exactly one of `cidr_block`, `ip_address` or `aws_security_group`.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"cidr_block": schema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: "Range of IP addresses in Classless Inter-Domain Routing (CIDR) notation.",
+							Validators: []validator.String{
+								validate.ValidCIDR(),
+							},
+						},
+						"ip_address": schema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: "Single IP address.",
+							Validators: []validator.String{
+								validate.ValidIP(),
+							},
+						},
+						"aws_security_group": schema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: "Unique identifier of the AWS security group. The project must have a VPC peering connection to the AWS VPC of the security group.",
+						},
+						"comment": schema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: "Remark that explains the purpose or scope of the entry.",
+						},
+					},
+				},
+			},
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+type TFAccessListSetModel struct {
+	ID        types.String   `tfsdk:"id"`
+	ProjectID types.String   `tfsdk:"project_id"`
+	Exclusive types.Bool     `tfsdk:"exclusive"`
+	Entries   types.Set      `tfsdk:"entries"`
+	Timeouts  timeouts.Value `tfsdk:"timeouts"`
+}
+
+type TFAccessListSetEntryModel struct {
+	CIDRBlock        types.String `tfsdk:"cidr_block"`
+	IPAddress        types.String `tfsdk:"ip_address"`
+	AWSSecurityGroup types.String `tfsdk:"aws_security_group"`
+	Comment          types.String `tfsdk:"comment"`
+}
+
+var AccessListSetEntryObjType = types.ObjectType{AttrTypes: map[string]attr.Type{
+	"cidr_block":         types.StringType,
+	"ip_address":         types.StringType,
+	"aws_security_group": types.StringType,
+	"comment":            types.StringType,
+}}
@@ -0,0 +1,136 @@
+package projectipaccesslist_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"go.mongodb.org/atlas-sdk/v20250312003/admin"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/testutil/acc"
+)
+
+const resourceSetName = "mongodbatlas_project_ip_access_list_set.test"
+
+func TestAccProjectIPAccessListSet_basic(t *testing.T) {
+	var (
+		orgID       = os.Getenv("MONGODB_ATLAS_ORG_ID")
+		projectName = acc.RandomProjectName()
+		ipAddress   = acc.RandomIP(179, 154, 226)
+		cidrBlock   = acc.RandomIP(179, 154, 227) + "/32"
+		newCIDR     = acc.RandomIP(179, 154, 228) + "/32"
+		unmanagedIP = acc.RandomIP(179, 154, 229)
+		initial     = []map[string]string{
+			{"ip_address": ipAddress, "comment": "ip entry"},
+			{"cidr_block": cidrBlock, "comment": "cidr entry"},
+		}
+		updated = []map[string]string{
+			{"ip_address": ipAddress, "comment": "ip entry updated"},
+			{"cidr_block": newCIDR},
+		}
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acc.PreCheckBasic(t) },
+		ProtoV6ProviderFactories: acc.TestAccProviderV6Factories,
+		CheckDestroy:             acc.CheckDestroyProject,
+		Steps: []resource.TestStep{
+			{
+				Config: configSet(orgID, projectName, initial, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceSetName, "entries.#", "2"),
+					resource.TestCheckResourceAttr(resourceSetName, "exclusive", "false"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceSetName, "entries.*", initial[0]),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceSetName, "entries.*", initial[1]),
+				),
+			},
+			{
+				Config: configSet(orgID, projectName, updated, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceSetName, "entries.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceSetName, "entries.*", updated[0]),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceSetName, "entries.*", updated[1]),
+					checkEntryExists(resourceSetName, cidrBlock, false),
+				),
+			},
+			{
+				PreConfig: func() { addUnmanagedEntry(t, projectName, unmanagedIP) },
+				Config:    configSet(orgID, projectName, updated, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceSetName, "entries.#", "2"),
+					resource.TestCheckResourceAttr(resourceSetName, "exclusive", "true"),
+					checkEntryExists(resourceSetName, unmanagedIP, false),
+					checkEntryExists(resourceSetName, ipAddress, true),
+				),
+			},
+			{
+				ResourceName:            resourceSetName,
+				ImportStateIdFunc:       acc.ImportStateProjectIDFunc(resourceSetName),
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"exclusive"},
+			},
+		},
+	})
+}
+
+func configSet(orgID, projectName string, entries []map[string]string, exclusive bool) string {
+	var entriesStr strings.Builder
+	for _, entry := range entries {
+		entriesStr.WriteString("{\n")
+		for _, attr := range []string{"ip_address", "cidr_block", "comment"} {
+			if value, ok := entry[attr]; ok {
+				entriesStr.WriteString(fmt.Sprintf("%s = %q\n", attr, value))
+			}
+		}
+		entriesStr.WriteString("},\n")
+	}
+	return fmt.Sprintf(`
+		resource "mongodbatlas_project" "test" {
+			org_id = %[1]q
+			name   = %[2]q
+		}
+
+		resource "mongodbatlas_project_ip_access_list_set" "test" {
+			project_id = mongodbatlas_project.test.id
+			exclusive  = %[3]t
+			entries = [
+				%[4]s
+			]
+		}
+	`, orgID, projectName, exclusive, entriesStr.String())
+}
+
+func addUnmanagedEntry(t *testing.T, projectName, ipAddress string) {
+	t.Helper()
+	project, _, err := acc.ConnV2().ProjectsApi.GetProjectByName(context.Background(), projectName).Execute()
+	if err != nil {
+		t.Fatalf("failed to get project %s: %s", projectName, err)
+	}
+	entries := []admin.NetworkPermissionEntry{{IpAddress: admin.PtrString(ipAddress)}}
+	if _, _, err := acc.ConnV2().ProjectIPAccessListApi.CreateProjectIpAccessList(context.Background(), project.GetId(), &entries).Execute(); err != nil {
+		t.Fatalf("failed to add unmanaged entry %s: %s", ipAddress, err)
+	}
+}
+
+func checkEntryExists(resourceName, entry string, shouldExist bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+		_, _, err := acc.ConnV2().ProjectIPAccessListApi.GetProjectIpList(context.Background(), rs.Primary.Attributes["project_id"], entry).Execute()
+		if shouldExist && err != nil {
+			return fmt.Errorf("project ip access list entry (%s) does not exist", entry)
+		}
+		if !shouldExist && err == nil {
+			return fmt.Errorf("project ip access list entry (%s) still exists", entry)
+		}
+		return nil
+	}
+}
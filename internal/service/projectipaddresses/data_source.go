@@ -50,5 +50,12 @@ func (d *projectIPAddressesDS) Read(ctx context.Context, req datasource.ReadRequ
 		resp.Diagnostics.Append(diags...)
 		return
 	}
+	newProjectIPAddresses.Direction = databaseDSUserConfig.Direction
+	newProjectIPAddresses.ClusterNames = databaseDSUserConfig.ClusterNames
+	newProjectIPAddresses.IncludeFuture = databaseDSUserConfig.IncludeFuture
+	resp.Diagnostics.Append(newProjectIPAddresses.SetCIDRBlocks(ctx, projectIPAddresses)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, newProjectIPAddresses)...)
 }
@@ -3,8 +3,10 @@ package projectipaddresses
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -53,13 +55,52 @@ func DataSourceSchema(ctx context.Context) schema.Schema {
 				Computed:            true,
 				MarkdownDescription: "List of IP addresses in a project categorized by services.",
 			},
+			"direction": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Direction of the IP addresses included in `cidr_blocks`, `cidr_blocks_by_cluster` and `aggregated_cidr_blocks`. Valid values are `inbound` and `outbound`. All directions are included if not set.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(DirectionInbound, DirectionOutbound),
+				},
+			},
+			"cluster_names": schema.SetAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "Clusters of the IP addresses included in `cidr_blocks`, `cidr_blocks_by_cluster` and `aggregated_cidr_blocks`. All clusters are included if not set.",
+			},
+			"include_future": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Flag that indicates whether `cidr_blocks`, `cidr_blocks_by_cluster` and `aggregated_cidr_blocks` include the future IP addresses of the clusters. Defaults to `true` so firewall rules keep working when Atlas moves the clusters to the future IP addresses.",
+			},
+			"cidr_blocks": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Computed:            true,
+				MarkdownDescription: "Deduplicated CIDR blocks of the cluster IP addresses matching `direction`, `cluster_names` and `include_future`, sorted by address.",
+			},
+			"cidr_blocks_by_cluster": schema.MapAttribute{
+				ElementType: types.ListType{
+					ElemType: types.StringType,
+				},
+				Computed:            true,
+				MarkdownDescription: "Deduplicated CIDR blocks of the cluster IP addresses matching `direction`, `cluster_names` and `include_future`, by cluster name.",
+			},
+			"aggregated_cidr_blocks": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Computed:            true,
+				MarkdownDescription: "Minimal list of CIDR blocks covering exactly the addresses in `cidr_blocks`, adjacent blocks are merged. Use it to keep firewall rules short.",
+			},
 		},
 	}
 }
 
 type TFProjectIpAddressesModel struct {
-	ProjectId types.String `tfsdk:"project_id"`
-	Services  types.Object `tfsdk:"services"`
+	ProjectId            types.String `tfsdk:"project_id"`
+	Services             types.Object `tfsdk:"services"`
+	Direction            types.String `tfsdk:"direction"`
+	ClusterNames         types.Set    `tfsdk:"cluster_names"`
+	IncludeFuture        types.Bool   `tfsdk:"include_future"`
+	CidrBlocks           types.List   `tfsdk:"cidr_blocks"`
+	CidrBlocksByCluster  types.Map    `tfsdk:"cidr_blocks_by_cluster"`
+	AggregatedCidrBlocks types.List   `tfsdk:"aggregated_cidr_blocks"`
 }
 
 type TFServicesModel struct {
@@ -75,8 +116,14 @@ type TFClusterValueModel struct {
 }
 
 var IPAddressesObjectType = types.ObjectType{AttrTypes: map[string]attr.Type{
-	"project_id": types.StringType,
-	"services":   ServicesObjectType,
+	"project_id":             types.StringType,
+	"services":               ServicesObjectType,
+	"direction":              types.StringType,
+	"cluster_names":          types.SetType{ElemType: types.StringType},
+	"include_future":         types.BoolType,
+	"cidr_blocks":            types.ListType{ElemType: types.StringType},
+	"cidr_blocks_by_cluster": types.MapType{ElemType: types.ListType{ElemType: types.StringType}},
+	"aggregated_cidr_blocks": types.ListType{ElemType: types.StringType},
 }}
 
 var ServicesObjectType = types.ObjectType{AttrTypes: map[string]attr.Type{
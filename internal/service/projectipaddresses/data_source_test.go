@@ -22,6 +22,9 @@ func TestAccProjectIPAddressesDS_basic(t *testing.T) {
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttrSet(dataSourceName, "project_id"),
 					resource.TestCheckResourceAttr(dataSourceName, "services.clusters.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "cidr_blocks.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "cidr_blocks_by_cluster.%", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "aggregated_cidr_blocks.#", "0"),
 				),
 			},
 		},
@@ -2,11 +2,20 @@ package projectipaddresses
 
 import (
 	"context"
+	"fmt"
+	"slices"
 
 	"go.mongodb.org/atlas-sdk/v20250312003/admin"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/conversion"
+)
+
+const (
+	DirectionInbound  = "inbound"
+	DirectionOutbound = "outbound"
 )
 
 func NewTFProjectIPAddresses(ctx context.Context, ipAddresses *admin.GroupIPAddresses) (*TFProjectIpAddressesModel, diag.Diagnostics) {
@@ -39,3 +48,77 @@ func NewTFProjectIPAddresses(ctx context.Context, ipAddresses *admin.GroupIPAddr
 		Services:  servicesObj,
 	}, nil
 }
+
+// Filter selects the cluster IP addresses included in the CIDR block attributes, empty fields don't filter.
+type Filter struct {
+	Direction     string
+	ClusterNames  []string
+	IncludeFuture bool
+}
+
+// CIDRBlocksByCluster returns the deduplicated CIDR blocks of the IP addresses matching the filter, by cluster name.
+func CIDRBlocksByCluster(ipAddresses *admin.GroupIPAddresses, filter Filter) (map[string][]string, error) {
+	result := make(map[string][]string)
+	for _, cluster := range ipAddresses.Services.GetClusters() {
+		name := cluster.GetClusterName()
+		if len(filter.ClusterNames) > 0 && !slices.Contains(filter.ClusterNames, name) {
+			continue
+		}
+		var addresses []string
+		if filter.Direction != DirectionOutbound {
+			addresses = append(addresses, cluster.GetInbound()...)
+			if filter.IncludeFuture {
+				addresses = append(addresses, cluster.GetFutureInbound()...)
+			}
+		}
+		if filter.Direction != DirectionInbound {
+			addresses = append(addresses, cluster.GetOutbound()...)
+			if filter.IncludeFuture {
+				addresses = append(addresses, cluster.GetFutureOutbound()...)
+			}
+		}
+		cidrs, err := conversion.NormalizeCIDRs(addresses)
+		if err != nil {
+			return nil, fmt.Errorf("cluster %s: %w", name, err)
+		}
+		result[name] = cidrs
+	}
+	return result, nil
+}
+
+// SetCIDRBlocks sets the CIDR block attributes using the direction, cluster_names and include_future attributes of the model as filter.
+func (m *TFProjectIpAddressesModel) SetCIDRBlocks(ctx context.Context, ipAddresses *admin.GroupIPAddresses) diag.Diagnostics {
+	var diags diag.Diagnostics
+	filter := Filter{
+		Direction:     m.Direction.ValueString(),
+		ClusterNames:  conversion.TypesSetToString(ctx, m.ClusterNames),
+		IncludeFuture: m.IncludeFuture.IsNull() || m.IncludeFuture.ValueBool(),
+	}
+	byCluster, err := CIDRBlocksByCluster(ipAddresses, filter)
+	if err != nil {
+		diags.AddError("error parsing project's IP addresses", err.Error())
+		return diags
+	}
+	var all []string
+	for _, cidrs := range byCluster {
+		all = append(all, cidrs...)
+	}
+	cidrs, err := conversion.NormalizeCIDRs(all)
+	if err != nil {
+		diags.AddError("error parsing project's IP addresses", err.Error())
+		return diags
+	}
+	aggregated, err := conversion.AggregateCIDRs(all)
+	if err != nil {
+		diags.AddError("error aggregating project's IP addresses", err.Error())
+		return diags
+	}
+	var localDiags diag.Diagnostics
+	m.CidrBlocks, localDiags = types.ListValueFrom(ctx, types.StringType, cidrs)
+	diags.Append(localDiags...)
+	m.CidrBlocksByCluster, localDiags = conversion.ToTFMapOfSlices(ctx, byCluster)
+	diags.Append(localDiags...)
+	m.AggregatedCidrBlocks, localDiags = types.ListValueFrom(ctx, types.StringType, aggregated)
+	diags.Append(localDiags...)
+	return diags
+}
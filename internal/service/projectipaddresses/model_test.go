@@ -8,7 +8,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/conversion"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/projectipaddresses"
 )
 
@@ -149,3 +151,91 @@ func createExpectedServices(t *testing.T, clusters []projectipaddresses.TFCluste
 
 	return servicesObj
 }
+
+func TestCIDRBlocksByCluster(t *testing.T) {
+	ipAddresses := &admin.GroupIPAddresses{
+		GroupId: admin.PtrString(dummyProjectID),
+		Services: &admin.GroupService{
+			Clusters: &[]admin.ClusterIPAddresses{
+				{
+					ClusterName:    admin.PtrString("cluster1"),
+					Inbound:        &[]string{"192.168.1.1", "192.168.1.0"},
+					Outbound:       &[]string{"10.0.0.1"},
+					FutureInbound:  &[]string{"192.168.1.1", "192.168.1.3"},
+					FutureOutbound: &[]string{"10.0.0.2"},
+				},
+				{
+					ClusterName: admin.PtrString("cluster2"),
+					Inbound:     &[]string{"192.168.2.1"},
+				},
+			},
+		},
+	}
+	testCases := map[string]struct {
+		expected map[string][]string
+		filter   projectipaddresses.Filter
+	}{
+		"all directions with future": {
+			filter: projectipaddresses.Filter{IncludeFuture: true},
+			expected: map[string][]string{
+				"cluster1": {"10.0.0.1/32", "10.0.0.2/32", "192.168.1.0/32", "192.168.1.1/32", "192.168.1.3/32"},
+				"cluster2": {"192.168.2.1/32"},
+			},
+		},
+		"inbound without future": {
+			filter: projectipaddresses.Filter{Direction: projectipaddresses.DirectionInbound},
+			expected: map[string][]string{
+				"cluster1": {"192.168.1.0/32", "192.168.1.1/32"},
+				"cluster2": {"192.168.2.1/32"},
+			},
+		},
+		"outbound of one cluster": {
+			filter: projectipaddresses.Filter{Direction: projectipaddresses.DirectionOutbound, ClusterNames: []string{"cluster1"}, IncludeFuture: true},
+			expected: map[string][]string{
+				"cluster1": {"10.0.0.1/32", "10.0.0.2/32"},
+			},
+		},
+		"cluster without addresses in direction": {
+			filter: projectipaddresses.Filter{Direction: projectipaddresses.DirectionOutbound, ClusterNames: []string{"cluster2"}},
+			expected: map[string][]string{
+				"cluster2": {},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			result, err := projectipaddresses.CIDRBlocksByCluster(ipAddresses, tc.filter)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, result)
+		})
+	}
+}
+
+func TestSetCIDRBlocks(t *testing.T) {
+	ipAddresses := &admin.GroupIPAddresses{
+		GroupId: admin.PtrString(dummyProjectID),
+		Services: &admin.GroupService{
+			Clusters: &[]admin.ClusterIPAddresses{
+				{
+					ClusterName:   admin.PtrString("cluster1"),
+					Inbound:       &[]string{"192.168.1.0", "192.168.1.1"},
+					FutureInbound: &[]string{"192.168.1.2", "192.168.1.3"},
+				},
+			},
+		},
+	}
+	model := projectipaddresses.TFProjectIpAddressesModel{
+		Direction:     types.StringNull(),
+		ClusterNames:  types.SetNull(types.StringType),
+		IncludeFuture: types.BoolNull(),
+	}
+	diags := model.SetCIDRBlocks(t.Context(), ipAddresses)
+	require.False(t, diags.HasError())
+	assert.Equal(t, []string{"192.168.1.0/32", "192.168.1.1/32", "192.168.1.2/32", "192.168.1.3/32"}, conversion.TypesListToString(t.Context(), model.CidrBlocks))
+	assert.Equal(t, []string{"192.168.1.0/30"}, conversion.TypesListToString(t.Context(), model.AggregatedCidrBlocks), "future addresses are included by default")
+
+	model.IncludeFuture = types.BoolValue(false)
+	diags = model.SetCIDRBlocks(t.Context(), ipAddresses)
+	require.False(t, diags.HasError())
+	assert.Equal(t, []string{"192.168.1.0/31"}, conversion.TypesListToString(t.Context(), model.AggregatedCidrBlocks))
+}
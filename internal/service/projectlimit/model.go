@@ -0,0 +1,90 @@
+package projectlimit
+
+import (
+	"fmt"
+	"strings"
+
+	"go.mongodb.org/atlas-sdk/v20250312003/admin"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/validate"
+)
+
+func NewTFModel(projectID string, limit *admin.DataFederationLimit) *TFModel {
+	return &TFModel{
+		ProjectID:    types.StringValue(projectID),
+		LimitName:    types.StringValue(limit.Name),
+		Value:        types.Int64Value(limit.Value),
+		CurrentUsage: types.Int64PointerValue(limit.CurrentUsage),
+		DefaultLimit: types.Int64PointerValue(limit.DefaultLimit),
+		MaximumLimit: types.Int64PointerValue(limit.MaximumLimit),
+	}
+}
+
+func NewTFLimitsDSModel(projectID string, limits []admin.DataFederationLimit) *TFLimitsDSModel {
+	results := make([]TFLimitDSModel, len(limits))
+	for i := range limits {
+		results[i] = TFLimitDSModel{
+			Name:         types.StringValue(limits[i].Name),
+			Value:        types.Int64Value(limits[i].Value),
+			CurrentUsage: types.Int64PointerValue(limits[i].CurrentUsage),
+			DefaultLimit: types.Int64PointerValue(limits[i].DefaultLimit),
+			MaximumLimit: types.Int64PointerValue(limits[i].MaximumLimit),
+		}
+	}
+	return &TFLimitsDSModel{
+		ProjectID: types.StringValue(projectID),
+		Results:   results,
+	}
+}
+
+func NewLimitReq(plan *TFModel) *admin.DataFederationLimit {
+	return &admin.DataFederationLimit{
+		Name:  plan.LimitName.ValueString(),
+		Value: plan.Value.ValueInt64(),
+	}
+}
+
+// FindLimit returns nil if name is not one of the limits of the project.
+func FindLimit(limits []admin.DataFederationLimit, name string) *admin.DataFederationLimit {
+	for i := range limits {
+		if limits[i].Name == name {
+			return &limits[i]
+		}
+	}
+	return nil
+}
+
+// UnknownLimitDetail returns a hint for a limit name that is not one of the limits of the project, suggesting the closest name.
+func UnknownLimitDetail(limits []admin.DataFederationLimit, name string) string {
+	names := make([]string, len(limits))
+	for i := range limits {
+		names[i] = limits[i].Name
+	}
+	detail := fmt.Sprintf("%s is not a known project limit, Atlas may reject it.", name)
+	if suggestion := validate.ClosestMatch(name, names); suggestion != "" {
+		if strings.EqualFold(suggestion, name) {
+			return fmt.Sprintf("%s is not a valid project limit, names are case sensitive. Did you mean %s?", name, suggestion)
+		}
+		detail += fmt.Sprintf(" Did you mean %s?", suggestion)
+	}
+	return detail
+}
+
+// CheckLimitValue returns an error if value is greater than the maximum of limit. Limits without a maximum are not checked.
+func CheckLimitValue(limit *admin.DataFederationLimit, value int64) error {
+	if limit.MaximumLimit == nil || value <= *limit.MaximumLimit {
+		return nil
+	}
+	return fmt.Errorf("value %d of limit %s is greater than its maximum_limit %d, the default is %d", value, limit.Name, *limit.MaximumLimit, limit.GetDefaultLimit())
+}
+
+// SplitImportID parses the {project_id}-{limit_name} import ID. Project IDs don't contain dashes so the first one is the separator.
+func SplitImportID(id string) (projectID, limitName string, err error) {
+	projectID, limitName, found := strings.Cut(id, "-")
+	if !found || projectID == "" || limitName == "" {
+		return "", "", fmt.Errorf("import format error: to import a project limit use the format {project_id}-{limit_name}, got %s", id)
+	}
+	return projectID, limitName, nil
+}
@@ -0,0 +1,129 @@
+package projectlimit_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/atlas-sdk/v20250312003/admin"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/conversion"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/projectlimit"
+)
+
+const (
+	projectID    = "111111111111111111111111"
+	clustersName = "atlas.project.deployment.clusters"
+	nodesName    = "atlas.project.deployment.nodesPerPrivateLinkRegion"
+)
+
+var limits = []admin.DataFederationLimit{
+	{
+		Name:         clustersName,
+		Value:        25,
+		CurrentUsage: conversion.Pointer(int64(3)),
+		DefaultLimit: conversion.Pointer(int64(25)),
+		MaximumLimit: conversion.Pointer(int64(90)),
+	},
+	{
+		Name:  nodesName,
+		Value: 50,
+	},
+}
+
+func TestNewTFModel(t *testing.T) {
+	model := projectlimit.NewTFModel(projectID, &limits[0])
+	assert.Equal(t, &projectlimit.TFModel{
+		ProjectID:    types.StringValue(projectID),
+		LimitName:    types.StringValue(clustersName),
+		Value:        types.Int64Value(25),
+		CurrentUsage: types.Int64Value(3),
+		DefaultLimit: types.Int64Value(25),
+		MaximumLimit: types.Int64Value(90),
+	}, model)
+
+	model = projectlimit.NewTFModel(projectID, &limits[1])
+	assert.True(t, model.CurrentUsage.IsNull())
+	assert.True(t, model.MaximumLimit.IsNull())
+}
+
+func TestNewTFLimitsDSModel(t *testing.T) {
+	model := projectlimit.NewTFLimitsDSModel(projectID, limits)
+	require.Len(t, model.Results, 2)
+	assert.Equal(t, projectID, model.ProjectID.ValueString())
+	assert.Equal(t, clustersName, model.Results[0].Name.ValueString())
+	assert.Equal(t, int64(3), model.Results[0].CurrentUsage.ValueInt64())
+	assert.Equal(t, int64(90), model.Results[0].MaximumLimit.ValueInt64())
+	assert.Equal(t, nodesName, model.Results[1].Name.ValueString())
+	assert.True(t, model.Results[1].DefaultLimit.IsNull())
+}
+
+func TestCheckLimitValue(t *testing.T) {
+	testCases := map[string]struct {
+		limit       *admin.DataFederationLimit
+		value       int64
+		expectedErr string
+	}{
+		"below maximum": {limit: &limits[0], value: 50},
+		"at maximum":    {limit: &limits[0], value: 90},
+		"above maximum": {
+			limit:       &limits[0],
+			value:       91,
+			expectedErr: "value 91 of limit atlas.project.deployment.clusters is greater than its maximum_limit 90, the default is 25",
+		},
+		"no maximum": {limit: &limits[1], value: 1000},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := projectlimit.CheckLimitValue(tc.limit, tc.value)
+			if tc.expectedErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, tc.expectedErr)
+		})
+	}
+}
+
+func TestFindLimit(t *testing.T) {
+	assert.Equal(t, &limits[1], projectlimit.FindLimit(limits, nodesName))
+	assert.Nil(t, projectlimit.FindLimit(limits, "atlas.project.unknown"))
+}
+
+func TestUnknownLimitDetail(t *testing.T) {
+	testCases := map[string]struct {
+		name     string
+		expected string
+	}{
+		"typo": {
+			name:     "atlas.project.deployment.cluster",
+			expected: "atlas.project.deployment.cluster is not a known project limit, Atlas may reject it. Did you mean atlas.project.deployment.clusters?",
+		},
+		"case": {
+			name:     "atlas.project.deployment.Clusters",
+			expected: "atlas.project.deployment.Clusters is not a valid project limit, names are case sensitive. Did you mean atlas.project.deployment.clusters?",
+		},
+		"no suggestion": {
+			name:     "dataFederation.bytesProcessed.query",
+			expected: "dataFederation.bytesProcessed.query is not a known project limit, Atlas may reject it.",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, projectlimit.UnknownLimitDetail(limits, tc.name))
+		})
+	}
+}
+
+func TestSplitImportID(t *testing.T) {
+	gotProjectID, gotName, err := projectlimit.SplitImportID(projectID + "-" + clustersName)
+	require.NoError(t, err)
+	assert.Equal(t, projectID, gotProjectID)
+	assert.Equal(t, clustersName, gotName)
+
+	for _, id := range []string{projectID, projectID + "-", "-" + clustersName} {
+		_, _, err := projectlimit.SplitImportID(id)
+		assert.Error(t, err, id)
+	}
+}
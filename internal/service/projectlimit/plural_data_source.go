@@ -0,0 +1,98 @@
+package projectlimit
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/conversion"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/config"
+)
+
+const errorReadPlural = "error reading data source mongodbatlas_project_limits"
+
+var _ datasource.DataSource = &pluralDS{}
+var _ datasource.DataSourceWithConfigure = &pluralDS{}
+
+func PluralDataSource() datasource.DataSource {
+	return &pluralDS{
+		DSCommon: config.DSCommon{
+			DataSourceName: fmt.Sprintf("%ss", resourceName),
+		},
+	}
+}
+
+type pluralDS struct {
+	config.DSCommon
+}
+
+type TFLimitsDSModel struct {
+	ProjectID types.String     `tfsdk:"project_id"`
+	Results   []TFLimitDSModel `tfsdk:"results"`
+}
+
+type TFLimitDSModel struct {
+	Name         types.String `tfsdk:"name"`
+	Value        types.Int64  `tfsdk:"value"`
+	CurrentUsage types.Int64  `tfsdk:"current_usage"`
+	DefaultLimit types.Int64  `tfsdk:"default_limit"`
+	MaximumLimit types.Int64  `tfsdk:"maximum_limit"`
+}
+
+func (d *pluralDS) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Unique 24-hexadecimal digit string that identifies your project.",
+			},
+			"results": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Limits of the project, including the ones that are not modified.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Human-readable label that identifies the limit.",
+						},
+						"value": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Current value of the limit.",
+						},
+						"current_usage": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Amount that indicates the current usage of the limit.",
+						},
+						"default_limit": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Default value of the limit.",
+						},
+						"maximum_limit": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Maximum value that the limit can be set to.",
+						},
+					},
+				},
+			},
+		},
+	}
+	conversion.UpdateSchemaDescription(&resp.Schema)
+}
+
+func (d *pluralDS) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var tfModel TFLimitsDSModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &tfModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	projectID := tfModel.ProjectID.ValueString()
+	limits, _, err := d.Client.AtlasV2.ProjectsApi.ListProjectLimits(ctx, projectID).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(errorReadPlural, err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, NewTFLimitsDSModel(projectID, limits))...)
+}
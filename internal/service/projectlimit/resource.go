@@ -0,0 +1,183 @@
+package projectlimit
+
+import (
+	"context"
+	"log"
+
+	"go.mongodb.org/atlas-sdk/v20250312003/admin"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/conversion"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/validate"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/config"
+)
+
+const (
+	resourceName     = "project_limit"
+	fullResourceName = "mongodbatlas_" + resourceName
+	errorCreate      = "error creating resource " + fullResourceName
+	errorRead        = "error reading resource " + fullResourceName
+	errorUpdate      = "error updating resource " + fullResourceName
+	errorDelete      = "error deleting resource " + fullResourceName
+	errorImport      = "error importing resource " + fullResourceName
+	errorInvalid     = "Invalid " + fullResourceName
+	warningUnknown   = "Unknown project limit"
+)
+
+var _ resource.ResourceWithConfigure = &rs{}
+var _ resource.ResourceWithImportState = &rs{}
+var _ resource.ResourceWithModifyPlan = &rs{}
+
+func Resource() resource.Resource {
+	return &rs{
+		RSCommon: config.RSCommon{
+			ResourceName: resourceName,
+		},
+	}
+}
+
+type rs struct {
+	config.RSCommon
+}
+
+func (r *rs) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = ResourceSchema(ctx)
+	conversion.UpdateSchemaDescription(&resp.Schema)
+}
+
+// ModifyPlan rejects values above the maximum of the limit and plans its default and maximum. The check is skipped if the project
+// doesn't exist yet, and in that case it's done before setting the limit.
+func (r *rs) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	var plan TFModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.ProjectID.IsUnknown() || plan.LimitName.IsUnknown() || plan.Value.IsUnknown() {
+		return
+	}
+	projectID := plan.ProjectID.ValueString()
+	limits, _, err := r.Client.AtlasV2.ProjectsApi.ListProjectLimits(ctx, projectID).Execute()
+	if err != nil {
+		log.Printf("[WARN] skipping %s plan validation, unable to list limits of project %s: %s", fullResourceName, projectID, err)
+		return
+	}
+	limit := checkPlanLimit(limits, &plan, &resp.Diagnostics)
+	if limit == nil || resp.Diagnostics.HasError() {
+		return
+	}
+	if plan.DefaultLimit.IsUnknown() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("default_limit"), types.Int64PointerValue(limit.DefaultLimit))...)
+	}
+	if plan.MaximumLimit.IsUnknown() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("maximum_limit"), types.Int64PointerValue(limit.MaximumLimit))...)
+	}
+}
+
+func (r *rs) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan TFModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	api := r.Client.AtlasV2.ProjectsApi
+	projectID := plan.ProjectID.ValueString()
+	limits, _, err := api.ListProjectLimits(ctx, projectID).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(errorCreate, err.Error())
+		return
+	}
+	if checkPlanLimit(limits, &plan, &resp.Diagnostics); resp.Diagnostics.HasError() {
+		return
+	}
+	limit, err := setLimit(ctx, api, &plan)
+	if err != nil {
+		resp.Diagnostics.AddError(errorCreate, err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, NewTFModel(projectID, limit))...)
+}
+
+func (r *rs) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state TFModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	projectID := state.ProjectID.ValueString()
+	limit, getResp, err := r.Client.AtlasV2.ProjectsApi.GetProjectLimit(ctx, state.LimitName.ValueString(), projectID).Execute()
+	if err != nil {
+		if validate.StatusNotFound(getResp) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(errorRead, err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, NewTFModel(projectID, limit))...)
+}
+
+func (r *rs) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan TFModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	limit, err := setLimit(ctx, r.Client.AtlasV2.ProjectsApi, &plan)
+	if err != nil {
+		resp.Diagnostics.AddError(errorUpdate, err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, NewTFModel(plan.ProjectID.ValueString(), limit))...)
+}
+
+// Delete resets the limit to its default value.
+func (r *rs) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state TFModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	httpResp, err := r.Client.AtlasV2.ProjectsApi.DeleteProjectLimit(ctx, state.LimitName.ValueString(), state.ProjectID.ValueString()).Execute()
+	if err != nil && !validate.StatusNotFound(httpResp) {
+		resp.Diagnostics.AddError(errorDelete, err.Error())
+	}
+}
+
+func (r *rs) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	projectID, limitName, err := SplitImportID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(errorImport, err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), projectID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("limit_name"), limitName)...)
+}
+
+// checkPlanLimit returns the limit of the plan, adding an error if the value is above its maximum and a warning if the limit is unknown.
+func checkPlanLimit(limits []admin.DataFederationLimit, plan *TFModel, diags *diag.Diagnostics) *admin.DataFederationLimit {
+	name := plan.LimitName.ValueString()
+	limit := FindLimit(limits, name)
+	if limit == nil {
+		diags.AddAttributeWarning(path.Root("limit_name"), warningUnknown, UnknownLimitDetail(limits, name))
+		return nil
+	}
+	if err := CheckLimitValue(limit, plan.Value.ValueInt64()); err != nil {
+		diags.AddAttributeError(path.Root("value"), errorInvalid, err.Error())
+	}
+	return limit
+}
+
+// setLimit reads the limit after setting it as the response doesn't include the current usage.
+func setLimit(ctx context.Context, api admin.ProjectsApi, plan *TFModel) (*admin.DataFederationLimit, error) {
+	projectID, name := plan.ProjectID.ValueString(), plan.LimitName.ValueString()
+	if _, _, err := api.SetProjectLimit(ctx, name, projectID, NewLimitReq(plan)).Execute(); err != nil {
+		return nil, err
+	}
+	limit, _, err := api.GetProjectLimit(ctx, name, projectID).Execute()
+	return limit, err
+}
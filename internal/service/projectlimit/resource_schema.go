@@ -0,0 +1,68 @@
+package projectlimit
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func ResourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Unique 24-hexadecimal digit string that identifies your project.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"limit_name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Human-readable label that identifies the limit, for example `atlas.project.deployment.clusters`. See the [Atlas documentation](https://www.mongodb.com/docs/atlas/reference/api-resources-spec/v2/#tag/Projects/operation/setProjectLimit) for the limits that can be modified.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"value": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "Amount to set the limit to. It can't be greater than `maximum_limit`.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"current_usage": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Amount that indicates the current usage of the limit.",
+			},
+			"default_limit": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Default value of the limit. The limit is reset to this value when the resource is deleted.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"maximum_limit": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Maximum value that the limit can be set to.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+type TFModel struct {
+	ProjectID    types.String `tfsdk:"project_id"`
+	LimitName    types.String `tfsdk:"limit_name"`
+	Value        types.Int64  `tfsdk:"value"`
+	CurrentUsage types.Int64  `tfsdk:"current_usage"`
+	DefaultLimit types.Int64  `tfsdk:"default_limit"`
+	MaximumLimit types.Int64  `tfsdk:"maximum_limit"`
+}
@@ -0,0 +1,131 @@
+package projectlimit_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/testutil/acc"
+)
+
+const (
+	resourceName         = "mongodbatlas_project_limit.test"
+	dataSourcePluralName = "data.mongodbatlas_project_limits.test"
+)
+
+func TestAccProjectLimit_basic(t *testing.T) {
+	var (
+		orgID       = os.Getenv("MONGODB_ATLAS_ORG_ID")
+		projectName = acc.RandomProjectName()
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acc.PreCheckBasic(t) },
+		ProtoV6ProviderFactories: acc.TestAccProviderV6Factories,
+		CheckDestroy:             checkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      configBasic(orgID, projectName, clustersName, 100000),
+				ExpectError: regexp.MustCompile("is greater than its maximum_limit"),
+			},
+			{
+				Config: configBasic(orgID, projectName, clustersName, 26),
+				Check:  checkLimit(26),
+			},
+			{
+				Config: configBasic(orgID, projectName, clustersName, 30),
+				Check:  checkLimit(30),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportStateIdFunc:                    importStateIDFunc(resourceName),
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "limit_name",
+			},
+		},
+	})
+}
+
+func configBasic(orgID, projectName, limitName string, value int) string {
+	return fmt.Sprintf(`
+resource "mongodbatlas_project" "test" {
+  org_id = %[1]q
+  name   = %[2]q
+}
+
+resource "mongodbatlas_project_limit" "test" {
+  project_id = mongodbatlas_project.test.id
+  limit_name = %[3]q
+  value      = %[4]d
+}
+
+data "mongodbatlas_project_limits" "test" {
+  project_id = mongodbatlas_project_limit.test.project_id
+}
+`, orgID, projectName, limitName, value)
+}
+
+func checkLimit(value int) resource.TestCheckFunc {
+	return resource.ComposeAggregateTestCheckFunc(
+		checkExists(resourceName),
+		resource.TestCheckResourceAttr(resourceName, "limit_name", clustersName),
+		resource.TestCheckResourceAttr(resourceName, "value", strconv.Itoa(value)),
+		resource.TestCheckResourceAttrSet(resourceName, "current_usage"),
+		resource.TestCheckResourceAttrSet(resourceName, "default_limit"),
+		resource.TestCheckResourceAttrSet(resourceName, "maximum_limit"),
+		resource.TestCheckTypeSetElemNestedAttrs(dataSourcePluralName, "results.*", map[string]string{
+			"name":  clustersName,
+			"value": strconv.Itoa(value),
+		}),
+	)
+}
+
+func checkExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+		attrs := rs.Primary.Attributes
+		limit, _, err := acc.ConnV2().ProjectsApi.GetProjectLimit(context.Background(), attrs["limit_name"], attrs["project_id"]).Execute()
+		if err != nil {
+			return fmt.Errorf("project limit (%s) does not exist: %w", attrs["limit_name"], err)
+		}
+		if strconv.FormatInt(limit.Value, 10) != attrs["value"] {
+			return fmt.Errorf("project limit (%s) has value %d, expected %s", attrs["limit_name"], limit.Value, attrs["value"])
+		}
+		return nil
+	}
+}
+
+// checkDestroy passes if the project was deleted or the limit was reset to its default.
+func checkDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "mongodbatlas_project_limit" {
+			continue
+		}
+		attrs := rs.Primary.Attributes
+		limit, _, err := acc.ConnV2().ProjectsApi.GetProjectLimit(context.Background(), attrs["limit_name"], attrs["project_id"]).Execute()
+		if err == nil && limit.DefaultLimit != nil && limit.Value != *limit.DefaultLimit {
+			return fmt.Errorf("project limit (%s) was not reset to its default", attrs["limit_name"])
+		}
+	}
+	return nil
+}
+
+func importStateIDFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", resourceName)
+		}
+		return fmt.Sprintf("%s-%s", rs.Primary.Attributes["project_id"], rs.Primary.Attributes["limit_name"]), nil
+	}
+}
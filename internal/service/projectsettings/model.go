@@ -0,0 +1,34 @@
+package projectsettings
+
+import (
+	"go.mongodb.org/atlas-sdk/v20250312003/admin"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/project"
+)
+
+func NewTFModel(projectID string, settings *admin.GroupSettings, isSlowOperationThresholdingEnabled bool) *TFModel {
+	return &TFModel{
+		ProjectID: types.StringValue(projectID),
+		IsCollectDatabaseSpecificsStatisticsEnabled: types.BoolPointerValue(settings.IsCollectDatabaseSpecificsStatisticsEnabled),
+		IsDataExplorerEnabled:                       types.BoolPointerValue(settings.IsDataExplorerEnabled),
+		IsExtendedStorageSizesEnabled:               types.BoolPointerValue(settings.IsExtendedStorageSizesEnabled),
+		IsPerformanceAdvisorEnabled:                 types.BoolPointerValue(settings.IsPerformanceAdvisorEnabled),
+		IsRealtimePerformancePanelEnabled:           types.BoolPointerValue(settings.IsRealtimePerformancePanelEnabled),
+		IsSchemaAdvisorEnabled:                      types.BoolPointerValue(settings.IsSchemaAdvisorEnabled),
+		IsSlowOperationThresholdingEnabled:          types.BoolValue(isSlowOperationThresholdingEnabled),
+	}
+}
+
+// NewSettingsReq returns the settings to update, only the ones known in the plan are sent so the rest keep their value in Atlas.
+func NewSettingsReq(plan *TFModel) *admin.GroupSettings {
+	settings := new(admin.GroupSettings)
+	project.SetProjectBool(plan.IsCollectDatabaseSpecificsStatisticsEnabled, &settings.IsCollectDatabaseSpecificsStatisticsEnabled)
+	project.SetProjectBool(plan.IsDataExplorerEnabled, &settings.IsDataExplorerEnabled)
+	project.SetProjectBool(plan.IsExtendedStorageSizesEnabled, &settings.IsExtendedStorageSizesEnabled)
+	project.SetProjectBool(plan.IsPerformanceAdvisorEnabled, &settings.IsPerformanceAdvisorEnabled)
+	project.SetProjectBool(plan.IsRealtimePerformancePanelEnabled, &settings.IsRealtimePerformancePanelEnabled)
+	project.SetProjectBool(plan.IsSchemaAdvisorEnabled, &settings.IsSchemaAdvisorEnabled)
+	return settings
+}
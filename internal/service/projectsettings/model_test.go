@@ -0,0 +1,49 @@
+package projectsettings_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/atlas-sdk/v20250312003/admin"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/conversion"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/projectsettings"
+)
+
+const projectID = "111111111111111111111111"
+
+func TestNewTFModel(t *testing.T) {
+	settings := &admin.GroupSettings{
+		IsCollectDatabaseSpecificsStatisticsEnabled: conversion.Pointer(true),
+		IsDataExplorerEnabled:                       conversion.Pointer(false),
+		IsExtendedStorageSizesEnabled:               conversion.Pointer(true),
+		IsPerformanceAdvisorEnabled:                 conversion.Pointer(false),
+		IsRealtimePerformancePanelEnabled:           conversion.Pointer(true),
+		IsSchemaAdvisorEnabled:                      conversion.Pointer(false),
+	}
+	assert.Equal(t, &projectsettings.TFModel{
+		ProjectID: types.StringValue(projectID),
+		IsCollectDatabaseSpecificsStatisticsEnabled: types.BoolValue(true),
+		IsDataExplorerEnabled:                       types.BoolValue(false),
+		IsExtendedStorageSizesEnabled:               types.BoolValue(true),
+		IsPerformanceAdvisorEnabled:                 types.BoolValue(false),
+		IsRealtimePerformancePanelEnabled:           types.BoolValue(true),
+		IsSchemaAdvisorEnabled:                      types.BoolValue(false),
+		IsSlowOperationThresholdingEnabled:          types.BoolValue(true),
+	}, projectsettings.NewTFModel(projectID, settings, true))
+}
+
+func TestNewSettingsReq(t *testing.T) {
+	plan := &projectsettings.TFModel{
+		ProjectID:                         types.StringValue(projectID),
+		IsDataExplorerEnabled:             types.BoolValue(false),
+		IsPerformanceAdvisorEnabled:       types.BoolValue(true),
+		IsSchemaAdvisorEnabled:            types.BoolUnknown(),
+		IsRealtimePerformancePanelEnabled: types.BoolNull(),
+	}
+	assert.Equal(t, &admin.GroupSettings{
+		IsDataExplorerEnabled:       conversion.Pointer(false),
+		IsPerformanceAdvisorEnabled: conversion.Pointer(true),
+	}, projectsettings.NewSettingsReq(plan))
+}
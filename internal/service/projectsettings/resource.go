@@ -0,0 +1,125 @@
+package projectsettings
+
+import (
+	"context"
+
+	"go.mongodb.org/atlas-sdk/v20250312003/admin"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/conversion"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/validate"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/config"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/project"
+)
+
+const (
+	resourceName     = "project_settings"
+	fullResourceName = "mongodbatlas_" + resourceName
+	errorCreate      = "error creating resource " + fullResourceName
+	errorRead        = "error reading resource " + fullResourceName
+	errorUpdate      = "error updating resource " + fullResourceName
+)
+
+var _ resource.ResourceWithConfigure = &rs{}
+var _ resource.ResourceWithImportState = &rs{}
+
+func Resource() resource.Resource {
+	return &rs{
+		RSCommon: config.RSCommon{
+			ResourceName: resourceName,
+		},
+	}
+}
+
+type rs struct {
+	config.RSCommon
+}
+
+func (r *rs) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = ResourceSchema(ctx)
+	conversion.UpdateSchemaDescription(&resp.Schema)
+}
+
+func (r *rs) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan TFModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	model, err := r.setSettings(ctx, &plan, &resp.Diagnostics)
+	if err != nil {
+		resp.Diagnostics.AddError(errorCreate, err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+}
+
+func (r *rs) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state TFModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	projectID := state.ProjectID.ValueString()
+	settings, getResp, err := r.Client.AtlasV2.ProjectsApi.GetProjectSettings(ctx, projectID).Execute()
+	if err != nil {
+		if validate.StatusNotFound(getResp) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(errorRead, err.Error())
+		return
+	}
+	model, err := r.readModel(ctx, projectID, settings, &resp.Diagnostics)
+	if err != nil {
+		resp.Diagnostics.AddError(errorRead, err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+}
+
+func (r *rs) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan TFModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	model, err := r.setSettings(ctx, &plan, &resp.Diagnostics)
+	if err != nil {
+		resp.Diagnostics.AddError(errorUpdate, err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+}
+
+// Delete only removes the resource from the state, the settings keep their current values as a project always has settings.
+func (r *rs) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+}
+
+func (r *rs) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("project_id"), req, resp)
+}
+
+func (r *rs) setSettings(ctx context.Context, plan *TFModel, warnings *diag.Diagnostics) (*TFModel, error) {
+	connV2 := r.Client.AtlasV2
+	projectID := plan.ProjectID.ValueString()
+	settings, _, err := connV2.ProjectsApi.UpdateProjectSettings(ctx, projectID, NewSettingsReq(plan)).Execute()
+	if err != nil {
+		return nil, err
+	}
+	if err := project.SetSlowOperationThresholding(ctx, connV2.PerformanceAdvisorApi, projectID, plan.IsSlowOperationThresholdingEnabled); err != nil {
+		return nil, err
+	}
+	return r.readModel(ctx, projectID, settings, warnings)
+}
+
+func (r *rs) readModel(ctx context.Context, projectID string, settings *admin.GroupSettings, warnings *diag.Diagnostics) (*TFModel, error) {
+	isSlowOperationThresholdingEnabled, err := project.ReadIsSlowMsThresholdingEnabled(ctx, r.Client.AtlasV2.PerformanceAdvisorApi, projectID, warnings)
+	if err != nil {
+		return nil, err
+	}
+	return NewTFModel(projectID, settings, isSlowOperationThresholdingEnabled), nil
+}
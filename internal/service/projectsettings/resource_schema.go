@@ -0,0 +1,54 @@
+package projectsettings
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func ResourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Unique 24-hexadecimal digit string that identifies your project.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"is_collect_database_specifics_statistics_enabled": settingAttribute("Flag that indicates whether to collect database-specific metrics for the project."),
+			"is_data_explorer_enabled":                         settingAttribute("Flag that indicates whether to enable the Data Explorer for the project."),
+			"is_extended_storage_sizes_enabled":                settingAttribute("Flag that indicates whether to enable extended storage sizes for the project."),
+			"is_performance_advisor_enabled":                   settingAttribute("Flag that indicates whether to enable the Performance Advisor and Profiler for the project."),
+			"is_realtime_performance_panel_enabled":            settingAttribute("Flag that indicates whether to enable the Real Time Performance Panel for the project."),
+			"is_schema_advisor_enabled":                        settingAttribute("Flag that indicates whether to enable the Schema Advisor for the project."),
+			"is_slow_operation_thresholding_enabled":           settingAttribute("Flag that indicates whether to enable the slow operation thresholding of the Performance Advisor for the project."),
+		},
+	}
+}
+
+func settingAttribute(description string) schema.BoolAttribute {
+	return schema.BoolAttribute{
+		Optional:            true,
+		Computed:            true,
+		MarkdownDescription: description + " If omitted, the current value in Atlas is kept.",
+		PlanModifiers: []planmodifier.Bool{
+			boolplanmodifier.UseStateForUnknown(),
+		},
+	}
+}
+
+type TFModel struct {
+	ProjectID                                   types.String `tfsdk:"project_id"`
+	IsCollectDatabaseSpecificsStatisticsEnabled types.Bool   `tfsdk:"is_collect_database_specifics_statistics_enabled"`
+	IsDataExplorerEnabled                       types.Bool   `tfsdk:"is_data_explorer_enabled"`
+	IsExtendedStorageSizesEnabled               types.Bool   `tfsdk:"is_extended_storage_sizes_enabled"`
+	IsPerformanceAdvisorEnabled                 types.Bool   `tfsdk:"is_performance_advisor_enabled"`
+	IsRealtimePerformancePanelEnabled           types.Bool   `tfsdk:"is_realtime_performance_panel_enabled"`
+	IsSchemaAdvisorEnabled                      types.Bool   `tfsdk:"is_schema_advisor_enabled"`
+	IsSlowOperationThresholdingEnabled          types.Bool   `tfsdk:"is_slow_operation_thresholding_enabled"`
+}
@@ -0,0 +1,104 @@
+package projectsettings_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/testutil/acc"
+)
+
+const resourceName = "mongodbatlas_project_settings.test"
+
+func TestAccProjectSettings_basic(t *testing.T) {
+	var (
+		orgID       = os.Getenv("MONGODB_ATLAS_ORG_ID")
+		projectName = acc.RandomProjectName()
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acc.PreCheckBasic(t) },
+		ProtoV6ProviderFactories: acc.TestAccProviderV6Factories,
+		CheckDestroy:             acc.CheckDestroyProject,
+		Steps: []resource.TestStep{
+			{
+				Config: configBasic(orgID, projectName, false),
+				Check:  checkSettings(false),
+			},
+			{
+				Config: configBasic(orgID, projectName, true),
+				Check:  checkSettings(true),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportStateIdFunc:                    importStateIDFunc(resourceName),
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "project_id",
+			},
+		},
+	})
+}
+
+func configBasic(orgID, projectName string, enabled bool) string {
+	return fmt.Sprintf(`
+resource "mongodbatlas_project" "test" {
+  org_id             = %[1]q
+  name               = %[2]q
+  managed_separately = ["settings"]
+}
+
+resource "mongodbatlas_project_settings" "test" {
+  project_id                            = mongodbatlas_project.test.id
+  is_data_explorer_enabled              = %[3]t
+  is_schema_advisor_enabled             = %[3]t
+  is_realtime_performance_panel_enabled = %[3]t
+}
+`, orgID, projectName, enabled)
+}
+
+func checkSettings(enabled bool) resource.TestCheckFunc {
+	value := strconv.FormatBool(enabled)
+	return resource.ComposeAggregateTestCheckFunc(
+		checkExists(resourceName, enabled),
+		resource.TestCheckResourceAttr(resourceName, "is_data_explorer_enabled", value),
+		resource.TestCheckResourceAttr(resourceName, "is_schema_advisor_enabled", value),
+		resource.TestCheckResourceAttr(resourceName, "is_realtime_performance_panel_enabled", value),
+		resource.TestCheckResourceAttrSet(resourceName, "is_performance_advisor_enabled"),
+		resource.TestCheckResourceAttrSet(resourceName, "is_slow_operation_thresholding_enabled"),
+		resource.TestCheckNoResourceAttr("mongodbatlas_project.test", "is_data_explorer_enabled"),
+	)
+}
+
+func checkExists(resourceName string, enabled bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+		projectID := rs.Primary.Attributes["project_id"]
+		settings, _, err := acc.ConnV2().ProjectsApi.GetProjectSettings(context.Background(), projectID).Execute()
+		if err != nil {
+			return fmt.Errorf("project settings (%s) could not be read: %w", projectID, err)
+		}
+		if settings.GetIsDataExplorerEnabled() != enabled {
+			return fmt.Errorf("project settings (%s) has is_data_explorer_enabled %t, expected %t", projectID, settings.GetIsDataExplorerEnabled(), enabled)
+		}
+		return nil
+	}
+}
+
+func importStateIDFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", resourceName)
+		}
+		return rs.Primary.Attributes["project_id"], nil
+	}
+}
@@ -0,0 +1,175 @@
+package streamconnection
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+const (
+	networkingAccessPrivateLink    = "PRIVATE_LINK"
+	networkingAccessTransitGateway = "TRANSIT_GATEWAY"
+)
+
+// typeAttributes are the type-specific attributes that can be set for each connection type. Connection types not listed here are not
+// validated so new Atlas connection types can be used before the provider knows them.
+var typeAttributes = map[string][]string{
+	"Cluster":   {"cluster_name", "db_role_to_execute"},
+	"Kafka":     {"authentication", "bootstrap_servers", "config", "security", "networking"},
+	"Sample":    {},
+	"AWSLambda": {"aws"},
+	"Https":     {"url", "headers"},
+}
+
+// networkingAccessAttributes are the attributes of networking.access that can only be set for a networking access type.
+var networkingAccessAttributes = map[string][]string{
+	"connection_id": {networkingAccessPrivateLink},
+	"name":          {networkingAccessPrivateLink},
+	"tgw_id":        {networkingAccessTransitGateway},
+	"vpc_cidr":      {networkingAccessTransitGateway},
+}
+
+func networkingAccessTypes() []string {
+	return []string{"PUBLIC", "VPC", networkingAccessPrivateLink, networkingAccessTransitGateway}
+}
+
+// ValidateConnectionConfig returns an error for every type-specific attribute set in the config that is not valid for the connection
+// type, and for authentication and networking attributes that are not valid together. Unknown values are not validated.
+func ValidateConnectionConfig(ctx context.Context, cfg *TFStreamConnectionModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	connectionType := cfg.Type.ValueString()
+	allowed, known := typeAttributes[connectionType]
+	if cfg.Type.IsUnknown() || !known {
+		return nil
+	}
+	values := typeSpecificValues(cfg)
+	names := make([]string, 0, len(values))
+	for name := range values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if isSet(values[name]) && !slices.Contains(allowed, name) {
+			diags.AddAttributeError(path.Root(name), "Invalid stream connection attribute",
+				fmt.Sprintf("%s can't be set for %s connections, it can only be set when type is %s.", name, connectionType, joinTypes(typesAllowing(name))))
+		}
+	}
+	if diags.HasError() {
+		return diags
+	}
+	diags.Append(validateAuthentication(ctx, cfg)...)
+	diags.Append(validateNetworkingAccess(ctx, cfg)...)
+	return diags
+}
+
+func typeSpecificValues(cfg *TFStreamConnectionModel) map[string]attr.Value {
+	return map[string]attr.Value{
+		"cluster_name":       cfg.ClusterName,
+		"db_role_to_execute": cfg.DBRoleToExecute,
+		"authentication":     cfg.Authentication,
+		"bootstrap_servers":  cfg.BootstrapServers,
+		"config":             cfg.Config,
+		"security":           cfg.Security,
+		"networking":         cfg.Networking,
+		"aws":                cfg.AWS,
+		"url":                cfg.URL,
+		"headers":            cfg.Headers,
+	}
+}
+
+// validateAuthentication checks that mutual TLS sets both the client certificate and its key.
+func validateAuthentication(ctx context.Context, cfg *TFStreamConnectionModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if cfg.Authentication.IsNull() || cfg.Authentication.IsUnknown() {
+		return nil
+	}
+	auth := &TFConnectionAuthenticationModel{}
+	if diags := cfg.Authentication.As(ctx, auth, basetypes.ObjectAsOptions{}); diags.HasError() {
+		return diags
+	}
+	authPath := path.Root("authentication")
+	hasCertificate := !auth.SSLCertificate.IsNull()
+	hasKey := !auth.SSLKey.IsNull() || !auth.SSLKeyWO.IsNull()
+	hasKeyPassword := !auth.SSLKeyPassword.IsNull() || !auth.SSLKeyPasswordWO.IsNull()
+	if hasCertificate && !hasKey {
+		diags.AddAttributeError(authPath.AtName("ssl_certificate"), "Invalid stream connection attribute",
+			"ssl_key or ssl_key_wo is required when ssl_certificate is set.")
+	}
+	if hasKey && !hasCertificate {
+		diags.AddAttributeError(authPath.AtName("ssl_key"), "Invalid stream connection attribute",
+			"ssl_certificate is required when ssl_key or ssl_key_wo is set.")
+	}
+	if hasKeyPassword && !hasKey {
+		diags.AddAttributeError(authPath.AtName("ssl_key_password"), "Invalid stream connection attribute",
+			"ssl_key or ssl_key_wo is required when ssl_key_password or ssl_key_password_wo is set.")
+	}
+	return diags
+}
+
+// validateNetworkingAccess checks that the attributes of networking.access are valid for the networking access type.
+func validateNetworkingAccess(ctx context.Context, cfg *TFStreamConnectionModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if cfg.Networking.IsNull() || cfg.Networking.IsUnknown() {
+		return nil
+	}
+	networking := &TFNetworkingModel{}
+	if diags := cfg.Networking.As(ctx, networking, basetypes.ObjectAsOptions{}); diags.HasError() {
+		return diags
+	}
+	if networking.Access.IsNull() || networking.Access.IsUnknown() {
+		return nil
+	}
+	access := &TFNetworkingAccessModel{}
+	if diags := networking.Access.As(ctx, access, basetypes.ObjectAsOptions{}); diags.HasError() {
+		return diags
+	}
+	if access.Type.IsUnknown() {
+		return nil
+	}
+	accessType := access.Type.ValueString()
+	values := map[string]attr.Value{
+		"connection_id": access.ConnectionID,
+		"name":          access.Name,
+		"tgw_id":        access.TgwID,
+		"vpc_cidr":      access.VpcCIDR,
+	}
+	names := make([]string, 0, len(values))
+	for name := range values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		allowed := networkingAccessAttributes[name]
+		if isSet(values[name]) && !slices.Contains(allowed, accessType) {
+			diags.AddAttributeError(path.Root("networking").AtName("access").AtName(name), "Invalid stream connection attribute",
+				fmt.Sprintf("networking.access.%s can't be set for %s networking, it can only be set when networking.access.type is %s.", name, accessType, joinTypes(allowed)))
+		}
+	}
+	return diags
+}
+
+func typesAllowing(name string) []string {
+	var result []string
+	for connectionType, names := range typeAttributes {
+		if slices.Contains(names, name) {
+			result = append(result, connectionType)
+		}
+	}
+	sort.Strings(result)
+	return result
+}
+
+func joinTypes(names []string) string {
+	return strings.Join(names, " or ")
+}
+
+func isSet(value attr.Value) bool {
+	return !value.IsNull() && !value.IsUnknown()
+}
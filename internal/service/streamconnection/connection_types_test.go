@@ -0,0 +1,102 @@
+package streamconnection_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/streamconnection"
+)
+
+func TestValidateConnectionConfig(t *testing.T) {
+	testCases := map[string]struct {
+		cfg           streamconnection.TFStreamConnectionModel
+		expectedError string
+	}{
+		"valid cluster connection": {
+			cfg: streamconnection.TFStreamConnectionModel{
+				Type:            types.StringValue("Cluster"),
+				ClusterName:     types.StringValue(clusterName),
+				DBRoleToExecute: tfDBRoleToExecuteObject(t, dbRole, dbRoleType),
+			},
+		},
+		"kafka attribute in cluster connection": {
+			cfg: streamconnection.TFStreamConnectionModel{
+				Type:             types.StringValue("Cluster"),
+				ClusterName:      types.StringValue(clusterName),
+				BootstrapServers: types.StringValue(bootstrapServers),
+			},
+			expectedError: "bootstrap_servers can't be set for Cluster connections, it can only be set when type is Kafka.",
+		},
+		"https attribute in sample connection": {
+			cfg: streamconnection.TFStreamConnectionModel{
+				Type:    types.StringValue("Sample"),
+				Headers: tfConfigMap(t, headersMap),
+			},
+			expectedError: "headers can't be set for Sample connections, it can only be set when type is Https.",
+		},
+		"aws attribute in https connection": {
+			cfg: streamconnection.TFStreamConnectionModel{
+				Type: types.StringValue("Https"),
+				URL:  types.StringValue(httpsURL),
+				AWS:  tfAWSLambdaConfigObject(t, sampleRoleArn),
+			},
+			expectedError: "aws can't be set for Https connections, it can only be set when type is AWSLambda.",
+		},
+		"unknown values are not validated": {
+			cfg: streamconnection.TFStreamConnectionModel{
+				Type:        types.StringValue("Kafka"),
+				ClusterName: types.StringUnknown(),
+			},
+		},
+		"unknown connection types are not validated": {
+			cfg: streamconnection.TFStreamConnectionModel{
+				Type:        types.StringValue("NewType"),
+				ClusterName: types.StringValue(clusterName),
+			},
+		},
+		"valid mutual TLS": {
+			cfg: streamconnection.TFStreamConnectionModel{
+				Type:           types.StringValue("Kafka"),
+				Authentication: tfMutualTLSObject(t, streamconnection.TFConnectionAuthenticationModel{SSLKeyWO: types.StringValue("key")}),
+			},
+		},
+		"client certificate without key": {
+			cfg: streamconnection.TFStreamConnectionModel{
+				Type:           types.StringValue("Kafka"),
+				Authentication: tfMutualTLSObject(t, streamconnection.TFConnectionAuthenticationModel{}),
+			},
+			expectedError: "ssl_key or ssl_key_wo is required when ssl_certificate is set.",
+		},
+		"valid transit gateway": {
+			cfg: streamconnection.TFStreamConnectionModel{
+				Type: types.StringValue("Kafka"),
+				Networking: tfNetworkingAccessObject(t, streamconnection.TFNetworkingAccessModel{
+					Type: types.StringValue(transitGatewayNetworkingType), TgwID: types.StringValue(tgwID), VpcCIDR: types.StringValue(vpcCIDR),
+				}),
+			},
+		},
+		"private link attribute in transit gateway": {
+			cfg: streamconnection.TFStreamConnectionModel{
+				Type: types.StringValue("Kafka"),
+				Networking: tfNetworkingAccessObject(t, streamconnection.TFNetworkingAccessModel{
+					Type: types.StringValue(transitGatewayNetworkingType), TgwID: types.StringValue(tgwID), ConnectionID: types.StringValue("id"),
+				}),
+			},
+			expectedError: "networking.access.connection_id can't be set for TRANSIT_GATEWAY networking, it can only be set when networking.access.type is PRIVATE_LINK.",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			diags := streamconnection.ValidateConnectionConfig(t.Context(), &tc.cfg)
+			if tc.expectedError == "" {
+				assert.False(t, diags.HasError(), "unexpected errors: %v", diags)
+				return
+			}
+			if assert.Len(t, diags.Errors(), 1) {
+				assert.Equal(t, tc.expectedError, diags.Errors()[0].Detail())
+			}
+		})
+	}
+}
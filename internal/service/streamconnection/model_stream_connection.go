@@ -26,9 +26,12 @@ func NewStreamConnectionReq(ctx context.Context, plan *TFStreamConnectionModel)
 			return nil, diags
 		}
 		streamConnection.Authentication = &admin.StreamsKafkaAuthentication{
-			Mechanism: authenticationModel.Mechanism.ValueStringPointer(),
-			Password:  authenticationModel.Password.ValueStringPointer(),
-			Username:  authenticationModel.Username.ValueStringPointer(),
+			Mechanism:      authenticationModel.Mechanism.ValueStringPointer(),
+			Password:       secretValue(authenticationModel.Password, authenticationModel.PasswordWO),
+			Username:       authenticationModel.Username.ValueStringPointer(),
+			SslCertificate: authenticationModel.SSLCertificate.ValueStringPointer(),
+			SslKey:         secretValue(authenticationModel.SSLKey, authenticationModel.SSLKeyWO),
+			SslKeyPassword: secretValue(authenticationModel.SSLKeyPassword, authenticationModel.SSLKeyPasswordWO),
 		}
 	}
 	if !plan.Security.IsNull() {
@@ -74,6 +77,9 @@ func NewStreamConnectionReq(ctx context.Context, plan *TFStreamConnectionModel)
 			Access: &admin.StreamsKafkaNetworkingAccess{
 				Type:         networkingAccessModel.Type.ValueStringPointer(),
 				ConnectionId: networkingAccessModel.ConnectionID.ValueStringPointer(),
+				Name:         networkingAccessModel.Name.ValueStringPointer(),
+				TgwId:        networkingAccessModel.TgwID.ValueStringPointer(),
+				VpcCIDR:      networkingAccessModel.VpcCIDR.ValueStringPointer(),
 			},
 		}
 	}
@@ -173,6 +179,9 @@ func NewTFStreamConnection(ctx context.Context, projID, instanceName string, cur
 		networkingAccessModel, diags := types.ObjectValueFrom(ctx, NetworkingAccessObjectType.AttrTypes, TFNetworkingAccessModel{
 			Type:         types.StringPointerValue(apiResp.Networking.Access.Type),
 			ConnectionID: types.StringPointerValue(apiResp.Networking.Access.ConnectionId),
+			Name:         types.StringPointerValue(apiResp.Networking.Access.Name),
+			TgwID:        types.StringPointerValue(apiResp.Networking.Access.TgwId),
+			VpcCIDR:      types.StringPointerValue(apiResp.Networking.Access.VpcCIDR),
 		})
 		if diags.HasError() {
 			return nil, diags
@@ -213,16 +222,24 @@ func NewTFStreamConnection(ctx context.Context, projID, instanceName string, cur
 func newTFConnectionAuthenticationModel(ctx context.Context, currAuthConfig *types.Object, authResp *admin.StreamsKafkaAuthentication) (*types.Object, diag.Diagnostics) {
 	if authResp != nil {
 		resultAuthModel := TFConnectionAuthenticationModel{
-			Mechanism: types.StringPointerValue(authResp.Mechanism),
-			Username:  types.StringPointerValue(authResp.Username),
+			Mechanism:      types.StringPointerValue(authResp.Mechanism),
+			Username:       types.StringPointerValue(authResp.Username),
+			SSLCertificate: types.StringPointerValue(authResp.SslCertificate),
 		}
 
-		if currAuthConfig != nil && !currAuthConfig.IsNull() { // if config is available (create & update of resource) password value is set in new state
+		// if config is available (create & update of resource) secret values are set in new state, write-only values are always null
+		if currAuthConfig != nil && !currAuthConfig.IsNull() {
 			configAuthModel := &TFConnectionAuthenticationModel{}
 			if diags := currAuthConfig.As(ctx, configAuthModel, basetypes.ObjectAsOptions{}); diags.HasError() {
 				return nil, diags
 			}
 			resultAuthModel.Password = configAuthModel.Password
+			resultAuthModel.SSLKey = configAuthModel.SSLKey
+			resultAuthModel.SSLKeyPassword = configAuthModel.SSLKeyPassword
+			resultAuthModel.SecretsVersion = configAuthModel.SecretsVersion
+			if authResp.SslCertificate == nil {
+				resultAuthModel.SSLCertificate = configAuthModel.SSLCertificate
+			}
 		}
 
 		resultObject, diags := types.ObjectValueFrom(ctx, ConnectionAuthenticationObjectType.AttrTypes, resultAuthModel)
@@ -235,6 +252,38 @@ func newTFConnectionAuthenticationModel(ctx context.Context, currAuthConfig *typ
 	return &nullValue, nil
 }
 
+// CopyWriteOnly copies the write-only attributes of authentication from the config, they are always null in plan and state.
+func CopyWriteOnly(ctx context.Context, plan, config *TFStreamConnectionModel) diag.Diagnostics {
+	if plan.Authentication.IsNull() || plan.Authentication.IsUnknown() || config.Authentication.IsNull() || config.Authentication.IsUnknown() {
+		return nil
+	}
+	planAuth := &TFConnectionAuthenticationModel{}
+	if diags := plan.Authentication.As(ctx, planAuth, basetypes.ObjectAsOptions{}); diags.HasError() {
+		return diags
+	}
+	configAuth := &TFConnectionAuthenticationModel{}
+	if diags := config.Authentication.As(ctx, configAuth, basetypes.ObjectAsOptions{}); diags.HasError() {
+		return diags
+	}
+	planAuth.PasswordWO = configAuth.PasswordWO
+	planAuth.SSLKeyWO = configAuth.SSLKeyWO
+	planAuth.SSLKeyPasswordWO = configAuth.SSLKeyPasswordWO
+	authentication, diags := types.ObjectValueFrom(ctx, ConnectionAuthenticationObjectType.AttrTypes, planAuth)
+	if diags.HasError() {
+		return diags
+	}
+	plan.Authentication = authentication
+	return nil
+}
+
+// secretValue returns the value of a secret attribute or its write-only alternative, they can't be both set.
+func secretValue(plain, writeOnly types.String) *string {
+	if !writeOnly.IsNull() {
+		return writeOnly.ValueStringPointer()
+	}
+	return plain.ValueStringPointer()
+}
+
 func NewTFStreamConnections(ctx context.Context,
 	streamConnectionsConfig *TFStreamConnectionsDSModel,
 	paginatedResult *admin.PaginatedApiStreamsConnection) (*TFStreamConnectionsDSModel, diag.Diagnostics) {
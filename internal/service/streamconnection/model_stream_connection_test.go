@@ -11,23 +11,27 @@ import (
 )
 
 const (
-	connectionName            = "Connection"
-	typeValue                 = ""
-	clusterName               = "Cluster0"
-	dummyProjectID            = "111111111111111111111111"
-	instanceName              = "InstanceName"
-	authMechanism             = "PLAIN"
-	authUsername              = "user1"
-	securityProtocol          = "SASL_SSL"
-	bootstrapServers          = "localhost:9092,another.host:9092"
-	dbRole                    = "customRole"
-	dbRoleType                = "CUSTOM"
-	sampleConnectionName      = "sample_stream_solar"
-	networkingType            = "PUBLIC"
-	privatelinkNetworkingType = "PRIVATE_LINK"
-	awslambdaConnectionName   = "aws_lambda_connection"
-	sampleRoleArn             = "rn:aws:iam::123456789123:role/sample"
-	httpsURL                  = "https://example.com"
+	connectionName               = "Connection"
+	typeValue                    = ""
+	clusterName                  = "Cluster0"
+	dummyProjectID               = "111111111111111111111111"
+	instanceName                 = "InstanceName"
+	authMechanism                = "PLAIN"
+	authUsername                 = "user1"
+	securityProtocol             = "SASL_SSL"
+	bootstrapServers             = "localhost:9092,another.host:9092"
+	dbRole                       = "customRole"
+	dbRoleType                   = "CUSTOM"
+	sampleConnectionName         = "sample_stream_solar"
+	networkingType               = "PUBLIC"
+	privatelinkNetworkingType    = "PRIVATE_LINK"
+	awslambdaConnectionName      = "aws_lambda_connection"
+	sampleRoleArn                = "rn:aws:iam::123456789123:role/sample"
+	httpsURL                     = "https://example.com"
+	transitGatewayNetworkingType = "TRANSIT_GATEWAY"
+	tgwID                        = "tgw-0123456789abcdef0"
+	vpcCIDR                      = "10.0.0.0/16"
+	clientCert                   = "-----BEGIN CERTIFICATE-----\nclient\n-----END CERTIFICATE-----"
 )
 
 var (
@@ -50,6 +54,11 @@ type sdkToTFModelTestCase struct {
 
 func TestStreamConnectionSDKToTFModel(t *testing.T) {
 	var authConfigWithPasswordDefined = tfAuthenticationObject(t, authMechanism, authUsername, "raw password")
+	var authConfigWithMutualTLS = tfMutualTLSObject(t, streamconnection.TFConnectionAuthenticationModel{
+		SSLKey:           types.StringValue("key"),
+		SSLKeyPasswordWO: types.StringValue("key password"),
+		SecretsVersion:   types.Int64Value(1),
+	})
 
 	testCases := []sdkToTFModelTestCase{
 		{
@@ -115,6 +124,41 @@ func TestStreamConnectionSDKToTFModel(t *testing.T) {
 				Headers:          types.MapNull(types.StringType),
 			},
 		},
+		{
+			name: "Kafka connection type with mutual TLS keeps secrets from config",
+			SDKResp: &admin.StreamsConnection{
+				Name: admin.PtrString(connectionName),
+				Type: admin.PtrString("Kafka"),
+				Authentication: &admin.StreamsKafkaAuthentication{
+					SslCertificate: admin.PtrString(clientCert),
+				},
+				BootstrapServers: admin.PtrString(bootstrapServers),
+				Networking: &admin.StreamsKafkaNetworking{
+					Access: &admin.StreamsKafkaNetworkingAccess{
+						Type:    admin.PtrString(transitGatewayNetworkingType),
+						TgwId:   admin.PtrString(tgwID),
+						VpcCIDR: admin.PtrString(vpcCIDR),
+					},
+				},
+			},
+			providedProjID:       dummyProjectID,
+			providedInstanceName: instanceName,
+			providedAuthConfig:   &authConfigWithMutualTLS,
+			expectedTFModel: &streamconnection.TFStreamConnectionModel{
+				ProjectID:        types.StringValue(dummyProjectID),
+				InstanceName:     types.StringValue(instanceName),
+				ConnectionName:   types.StringValue(connectionName),
+				Type:             types.StringValue("Kafka"),
+				Authentication:   tfMutualTLSObject(t, streamconnection.TFConnectionAuthenticationModel{SSLKey: types.StringValue("key"), SecretsVersion: types.Int64Value(1)}),
+				BootstrapServers: types.StringValue(bootstrapServers),
+				Config:           types.MapNull(types.StringType),
+				Security:         types.ObjectNull(streamconnection.ConnectionSecurityObjectType.AttrTypes),
+				DBRoleToExecute:  types.ObjectNull(streamconnection.DBRoleToExecuteObjectType.AttrTypes),
+				Networking:       tfNetworkingAccessObject(t, streamconnection.TFNetworkingAccessModel{Type: types.StringValue(transitGatewayNetworkingType), TgwID: types.StringValue(tgwID), VpcCIDR: types.StringValue(vpcCIDR)}),
+				AWS:              types.ObjectNull(streamconnection.AWSObjectType.AttrTypes),
+				Headers:          types.MapNull(types.StringType),
+			},
+		},
 		{
 			name: "Kafka connection type SDK response with no optional values provided",
 			SDKResp: &admin.StreamsConnection{
@@ -477,6 +521,37 @@ func TestStreamInstanceTFToSDKCreateModel(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Kafka type with mutual TLS and write-only secrets",
+			tfModel: &streamconnection.TFStreamConnectionModel{
+				ProjectID:      types.StringValue(dummyProjectID),
+				InstanceName:   types.StringValue(instanceName),
+				ConnectionName: types.StringValue(connectionName),
+				Type:           types.StringValue("Kafka"),
+				Authentication: tfMutualTLSObject(t, streamconnection.TFConnectionAuthenticationModel{
+					SSLKeyWO:       types.StringValue("key"),
+					SSLKeyPassword: types.StringValue("key password"),
+				}),
+				BootstrapServers: types.StringValue(bootstrapServers),
+				Networking:       tfNetworkingAccessObject(t, streamconnection.TFNetworkingAccessModel{Type: types.StringValue(privatelinkNetworkingType), Name: types.StringValue("endpoint")}),
+			},
+			expectedSDKReq: &admin.StreamsConnection{
+				Name: admin.PtrString(connectionName),
+				Type: admin.PtrString("Kafka"),
+				Authentication: &admin.StreamsKafkaAuthentication{
+					SslCertificate: admin.PtrString(clientCert),
+					SslKey:         admin.PtrString("key"),
+					SslKeyPassword: admin.PtrString("key password"),
+				},
+				BootstrapServers: admin.PtrString(bootstrapServers),
+				Networking: &admin.StreamsKafkaNetworking{
+					Access: &admin.StreamsKafkaNetworkingAccess{
+						Type: admin.PtrString(privatelinkNetworkingType),
+						Name: admin.PtrString("endpoint"),
+					},
+				},
+			},
+		},
 		{
 			name: "Kafka type TF state with no optional attributes",
 			tfModel: &streamconnection.TFStreamConnectionModel{
@@ -565,6 +640,28 @@ func tfAuthenticationObject(t *testing.T, mechanism, username, password string)
 	return auth
 }
 
+func TestCopyWriteOnly(t *testing.T) {
+	plan := &streamconnection.TFStreamConnectionModel{
+		Authentication: tfMutualTLSObject(t, streamconnection.TFConnectionAuthenticationModel{SecretsVersion: types.Int64Value(2)}),
+	}
+	config := &streamconnection.TFStreamConnectionModel{
+		Authentication: tfMutualTLSObject(t, streamconnection.TFConnectionAuthenticationModel{
+			PasswordWO:       types.StringValue("password"),
+			SSLKeyWO:         types.StringValue("key"),
+			SSLKeyPasswordWO: types.StringValue("key password"),
+		}),
+	}
+	diags := streamconnection.CopyWriteOnly(t.Context(), plan, config)
+	assert.False(t, diags.HasError())
+	expected := tfMutualTLSObject(t, streamconnection.TFConnectionAuthenticationModel{
+		PasswordWO:       types.StringValue("password"),
+		SSLKeyWO:         types.StringValue("key"),
+		SSLKeyPasswordWO: types.StringValue("key password"),
+		SecretsVersion:   types.Int64Value(2),
+	})
+	assert.Equal(t, expected, plan.Authentication)
+}
+
 func tfAuthenticationObjectWithNoPassword(t *testing.T, mechanism, username string) types.Object {
 	t.Helper()
 	auth, diags := types.ObjectValueFrom(t.Context(), streamconnection.ConnectionAuthenticationObjectType.AttrTypes, streamconnection.TFConnectionAuthenticationModel{
@@ -638,3 +735,29 @@ func tfAWSLambdaConfigObject(t *testing.T, roleArn string) types.Object {
 	}
 	return aws
 }
+
+// tfMutualTLSObject returns an authentication object with the client certificate and the values of auth.
+func tfMutualTLSObject(t *testing.T, auth streamconnection.TFConnectionAuthenticationModel) types.Object {
+	t.Helper()
+	auth.SSLCertificate = types.StringValue(clientCert)
+	obj, diags := types.ObjectValueFrom(t.Context(), streamconnection.ConnectionAuthenticationObjectType.AttrTypes, auth)
+	if diags.HasError() {
+		t.Errorf("failed to create terraform data model: %s", diags.Errors()[0].Summary())
+	}
+	return obj
+}
+
+func tfNetworkingAccessObject(t *testing.T, access streamconnection.TFNetworkingAccessModel) types.Object {
+	t.Helper()
+	networkingAccessModel, diags := types.ObjectValueFrom(t.Context(), streamconnection.NetworkingAccessObjectType.AttrTypes, access)
+	if diags.HasError() {
+		t.Errorf("failed to create terraform data model: %s", diags.Errors()[0].Summary())
+	}
+	networking, diags := types.ObjectValueFrom(t.Context(), streamconnection.NetworkingObjectType.AttrTypes, streamconnection.TFNetworkingModel{
+		Access: networkingAccessModel,
+	})
+	if diags.HasError() {
+		t.Errorf("failed to create terraform data model: %s", diags.Errors()[0].Summary())
+	}
+	return networking
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -75,10 +76,37 @@ func ResourceSchema(ctx context.Context) schema.Schema {
 					"password": schema.StringAttribute{
 						Optional:  true,
 						Sensitive: true,
+						Validators: []validator.String{
+							stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("password_wo")),
+						},
 					},
+					"password_wo": writeOnlyAttribute("password"),
 					"username": schema.StringAttribute{
 						Optional: true,
 					},
+					// mutual TLS
+					"ssl_certificate": schema.StringAttribute{
+						Optional: true,
+					},
+					"ssl_key": schema.StringAttribute{
+						Optional:  true,
+						Sensitive: true,
+						Validators: []validator.String{
+							stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("ssl_key_wo")),
+						},
+					},
+					"ssl_key_wo": writeOnlyAttribute("ssl_key"),
+					"ssl_key_password": schema.StringAttribute{
+						Optional:  true,
+						Sensitive: true,
+						Validators: []validator.String{
+							stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("ssl_key_password_wo")),
+						},
+					},
+					"ssl_key_password_wo": writeOnlyAttribute("ssl_key_password"),
+					"secrets_version": schema.Int64Attribute{
+						Optional: true,
+					},
 				},
 			},
 			"bootstrap_servers": schema.StringAttribute{
@@ -96,6 +124,9 @@ func ResourceSchema(ctx context.Context) schema.Schema {
 					},
 					"protocol": schema.StringAttribute{
 						Optional: true,
+						Validators: []validator.String{
+							stringvalidator.OneOf("SASL_PLAINTEXT", "SASL_SSL", "SSL"),
+						},
 					},
 				},
 			},
@@ -111,10 +142,18 @@ func ResourceSchema(ctx context.Context) schema.Schema {
 						Attributes: map[string]schema.Attribute{
 							"type": schema.StringAttribute{
 								Required: true,
+								Validators: []validator.String{
+									stringvalidator.OneOf(networkingAccessTypes()...),
+								},
 							},
+							// PRIVATE_LINK
 							"connection_id": schema.StringAttribute{
 								Optional: true,
 							},
+							"name": optionalComputedString(),
+							// TRANSIT_GATEWAY
+							"tgw_id":   optionalComputedString(),
+							"vpc_cidr": optionalComputedString(),
 						},
 					},
 				},
@@ -141,3 +180,26 @@ func ResourceSchema(ctx context.Context) schema.Schema {
 		},
 	}
 }
+
+// writeOnlyAttribute returns the write-only alternative of a secret attribute of authentication. Write-only values are never stored
+// in the state, secrets_version must be changed to send a new value.
+func writeOnlyAttribute(name string) schema.StringAttribute {
+	return schema.StringAttribute{
+		Optional:  true,
+		Sensitive: true,
+		WriteOnly: true,
+		Validators: []validator.String{
+			stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName(name)),
+		},
+	}
+}
+
+func optionalComputedString() schema.StringAttribute {
+	return schema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+}
@@ -20,6 +20,7 @@ const streamConnectionName = "stream_connection"
 
 var _ resource.ResourceWithConfigure = &streamConnectionRS{}
 var _ resource.ResourceWithImportState = &streamConnectionRS{}
+var _ resource.ResourceWithValidateConfig = &streamConnectionRS{}
 
 func Resource() resource.Resource {
 	return &streamConnectionRS{
@@ -53,15 +54,29 @@ type TFStreamConnectionModel struct {
 }
 
 type TFConnectionAuthenticationModel struct {
-	Mechanism types.String `tfsdk:"mechanism"`
-	Password  types.String `tfsdk:"password"`
-	Username  types.String `tfsdk:"username"`
+	Mechanism        types.String `tfsdk:"mechanism"`
+	Password         types.String `tfsdk:"password"`
+	PasswordWO       types.String `tfsdk:"password_wo"`
+	Username         types.String `tfsdk:"username"`
+	SSLCertificate   types.String `tfsdk:"ssl_certificate"`
+	SSLKey           types.String `tfsdk:"ssl_key"`
+	SSLKeyWO         types.String `tfsdk:"ssl_key_wo"`
+	SSLKeyPassword   types.String `tfsdk:"ssl_key_password"`
+	SSLKeyPasswordWO types.String `tfsdk:"ssl_key_password_wo"`
+	SecretsVersion   types.Int64  `tfsdk:"secrets_version"`
 }
 
 var ConnectionAuthenticationObjectType = types.ObjectType{AttrTypes: map[string]attr.Type{
-	"mechanism": types.StringType,
-	"password":  types.StringType,
-	"username":  types.StringType,
+	"mechanism":           types.StringType,
+	"password":            types.StringType,
+	"password_wo":         types.StringType,
+	"username":            types.StringType,
+	"ssl_certificate":     types.StringType,
+	"ssl_key":             types.StringType,
+	"ssl_key_wo":          types.StringType,
+	"ssl_key_password":    types.StringType,
+	"ssl_key_password_wo": types.StringType,
+	"secrets_version":     types.Int64Type,
 }}
 
 type TFConnectionSecurityModel struct {
@@ -87,11 +102,17 @@ var DBRoleToExecuteObjectType = types.ObjectType{AttrTypes: map[string]attr.Type
 type TFNetworkingAccessModel struct {
 	Type         types.String `tfsdk:"type"`
 	ConnectionID types.String `tfsdk:"connection_id"`
+	Name         types.String `tfsdk:"name"`
+	TgwID        types.String `tfsdk:"tgw_id"`
+	VpcCIDR      types.String `tfsdk:"vpc_cidr"`
 }
 
 var NetworkingAccessObjectType = types.ObjectType{AttrTypes: map[string]attr.Type{
 	"type":          types.StringType,
 	"connection_id": types.StringType,
+	"name":          types.StringType,
+	"tgw_id":        types.StringType,
+	"vpc_cidr":      types.StringType,
 }}
 
 type TFNetworkingModel struct {
@@ -115,9 +136,23 @@ func (r *streamConnectionRS) Schema(ctx context.Context, req resource.SchemaRequ
 	conversion.UpdateSchemaDescription(&resp.Schema)
 }
 
+func (r *streamConnectionRS) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var cfg TFStreamConnectionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &cfg)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(ValidateConnectionConfig(ctx, &cfg)...)
+}
+
 func (r *streamConnectionRS) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var streamConnectionPlan TFStreamConnectionModel
+	var streamConnectionPlan, streamConnectionConfig TFStreamConnectionModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &streamConnectionPlan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &streamConnectionConfig)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(CopyWriteOnly(ctx, &streamConnectionPlan, &streamConnectionConfig)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -174,8 +209,13 @@ func (r *streamConnectionRS) Read(ctx context.Context, req resource.ReadRequest,
 }
 
 func (r *streamConnectionRS) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var streamConnectionPlan TFStreamConnectionModel
+	var streamConnectionPlan, streamConnectionConfig TFStreamConnectionModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &streamConnectionPlan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &streamConnectionConfig)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(CopyWriteOnly(ctx, &streamConnectionPlan, &streamConnectionConfig)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
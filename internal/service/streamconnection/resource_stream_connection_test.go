@@ -5,6 +5,7 @@ import (
 	_ "embed"
 	"fmt"
 	"os"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -312,6 +313,28 @@ func TestAccStreamRSStreamConnection_AWSLambda(t *testing.T) {
 	})
 }
 
+func TestAccStreamRSStreamConnection_invalidTypeAttributes(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acc.PreCheckBasic(t) },
+		ProtoV6ProviderFactories: acc.TestAccProviderV6Factories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "mongodbatlas_stream_connection" "test" {
+						project_id        = "111111111111111111111111"
+						instance_name     = "instance"
+						connection_name   = "connection"
+						type              = "Cluster"
+						cluster_name      = "Cluster0"
+						bootstrap_servers = "localhost:9092"
+					}
+				`,
+				ExpectError: regexp.MustCompile("bootstrap_servers can't be set for Cluster connections"),
+			},
+		},
+	})
+}
+
 func configureKafka(projectID, instanceName, username, password, bootstrapServers, configValue, networkingConfig string, useSSL bool) string {
 	projectAndStreamInstanceConfig := acc.StreamInstanceConfig(projectID, instanceName, "VIRGINIA_USA", "AWS")
 	securityConfig := `
@@ -0,0 +1,80 @@
+package streaminstance
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"go.mongodb.org/atlas-sdk/v20250312003/admin"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/dsschema"
+)
+
+// DependentObjects returns the names of the connections and stream processors of a stream instance, sorted. They are destroyed
+// when the stream instance is replaced.
+func DependentObjects(ctx context.Context, api admin.StreamsApi, projectID, instanceName string) (connections, processors []string, err error) {
+	apiConnections, err := dsschema.AllPages(ctx, func(ctx context.Context, pageNum int) (dsschema.PaginateResponse[admin.StreamsConnection], *http.Response, error) {
+		return api.ListStreamConnectionsWithParams(ctx, &admin.ListStreamConnectionsApiParams{GroupId: projectID, TenantName: instanceName, PageNum: &pageNum}).Execute()
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("error listing stream connections: %w", err)
+	}
+	apiProcessors, err := dsschema.AllPages(ctx, func(ctx context.Context, pageNum int) (dsschema.PaginateResponse[admin.StreamsProcessorWithStats], *http.Response, error) {
+		return api.ListStreamProcessorsWithParams(ctx, &admin.ListStreamProcessorsApiParams{GroupId: projectID, TenantName: instanceName, PageNum: &pageNum}).Execute()
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("error listing stream processors: %w", err)
+	}
+	connections = make([]string, 0, len(apiConnections))
+	for i := range apiConnections {
+		connections = append(connections, apiConnections[i].GetName())
+	}
+	processors = make([]string, 0, len(apiProcessors))
+	for i := range apiProcessors {
+		processors = append(processors, apiProcessors[i].GetName())
+	}
+	sort.Strings(connections)
+	sort.Strings(processors)
+	return connections, processors, nil
+}
+
+// ReplacementDiagnostics returns a warning listing the connections and stream processors destroyed when the stream instance is
+// replaced because of the changes in paths, or an error if preventReplacement is true. connections and processors are nil when they
+// couldn't be listed.
+func ReplacementDiagnostics(instanceName string, paths path.Paths, connections, processors []string, preventReplacement bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+	attrs := make([]string, 0, len(paths))
+	for _, p := range paths {
+		attrs = append(attrs, p.String())
+	}
+	detail := fmt.Sprintf("Changing %s replaces stream instance %s.", strings.Join(attrs, ", "), instanceName)
+	if connections == nil && processors == nil {
+		detail += " Its stream connections and stream processors will be destroyed, they couldn't be listed."
+	} else {
+		detail += fmt.Sprintf(" It will be destroyed with %s and %s.", describeObjects("stream connection", connections), describeObjects("stream processor", processors))
+	}
+	detail += " Stream processors lose their checkpoints. mongodbatlas_stream_connection and mongodbatlas_stream_processor resources are " +
+		"only created again in the next apply, once Terraform detects they no longer exist."
+	if preventReplacement {
+		diags.AddAttributeError(path.Root("prevent_replacement"), "Stream instance replacement prevented",
+			detail+" Set prevent_replacement to false to allow the replacement.")
+		return diags
+	}
+	diags.AddWarning("Stream instance will be replaced", detail)
+	return diags
+}
+
+func describeObjects(kind string, names []string) string {
+	switch len(names) {
+	case 0:
+		return "no " + kind + "s"
+	case 1:
+		return fmt.Sprintf("its %s %s", kind, names[0])
+	default:
+		return fmt.Sprintf("its %d %ss (%s)", len(names), kind, strings.Join(names, ", "))
+	}
+}
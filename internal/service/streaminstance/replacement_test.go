@@ -0,0 +1,82 @@
+package streaminstance_test
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/atlas-sdk/v20250312003/admin"
+	"go.mongodb.org/atlas-sdk/v20250312003/mockadmin"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/streaminstance"
+)
+
+func TestDependentObjects(t *testing.T) {
+	m := mockadmin.NewStreamsApi(t)
+	m.EXPECT().ListStreamConnectionsWithParams(mock.Anything, mock.Anything).Return(admin.ListStreamConnectionsApiRequest{ApiService: m})
+	m.EXPECT().ListStreamConnectionsExecute(mock.Anything).Return(&admin.PaginatedApiStreamsConnection{
+		Results:    &[]admin.StreamsConnection{{Name: admin.PtrString("kafka")}, {Name: admin.PtrString("cluster")}},
+		TotalCount: admin.PtrInt(2),
+	}, &http.Response{StatusCode: http.StatusOK}, nil).Once()
+	m.EXPECT().ListStreamProcessorsWithParams(mock.Anything, mock.Anything).Return(admin.ListStreamProcessorsApiRequest{ApiService: m})
+	m.EXPECT().ListStreamProcessorsExecute(mock.Anything).Return(&admin.PaginatedApiStreamsStreamProcessorWithStats{
+		Results:    &[]admin.StreamsProcessorWithStats{{Name: "processor"}},
+		TotalCount: admin.PtrInt(1),
+	}, &http.Response{StatusCode: http.StatusOK}, nil).Once()
+
+	connections, processors, err := streaminstance.DependentObjects(t.Context(), m, dummyProjectID, instanceName)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"cluster", "kafka"}, connections)
+	assert.Equal(t, []string{"processor"}, processors)
+}
+
+func TestDependentObjectsError(t *testing.T) {
+	m := mockadmin.NewStreamsApi(t)
+	m.EXPECT().ListStreamConnectionsWithParams(mock.Anything, mock.Anything).Return(admin.ListStreamConnectionsApiRequest{ApiService: m})
+	m.EXPECT().ListStreamConnectionsExecute(mock.Anything).Return(nil, &http.Response{StatusCode: http.StatusInternalServerError}, errors.New("server error")).Once()
+
+	_, _, err := streaminstance.DependentObjects(t.Context(), m, dummyProjectID, instanceName)
+	assert.ErrorContains(t, err, "error listing stream connections: server error")
+}
+
+func TestReplacementDiagnostics(t *testing.T) {
+	paths := path.Paths{path.Root("stream_config").AtName("tier")}
+	testCases := map[string]struct {
+		connections        []string
+		processors         []string
+		expectedDetail     string
+		preventReplacement bool
+	}{
+		"objects are listed": {
+			connections:    []string{"cluster", "kafka"},
+			processors:     []string{"processor"},
+			expectedDetail: "Changing stream_config.tier replaces stream instance InstanceName. It will be destroyed with its 2 stream connections (cluster, kafka) and its stream processor processor.",
+		},
+		"empty instance": {
+			connections:    []string{},
+			processors:     []string{},
+			expectedDetail: "It will be destroyed with no stream connections and no stream processors.",
+		},
+		"objects couldn't be listed": {
+			expectedDetail: "Its stream connections and stream processors will be destroyed, they couldn't be listed.",
+		},
+		"replacement prevented": {
+			connections:        []string{"cluster"},
+			processors:         []string{},
+			preventReplacement: true,
+			expectedDetail:     "Set prevent_replacement to false to allow the replacement.",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			diags := streaminstance.ReplacementDiagnostics(instanceName, paths, tc.connections, tc.processors, tc.preventReplacement)
+			require.Len(t, diags, 1)
+			assert.Equal(t, tc.preventReplacement, diags.HasError())
+			assert.Contains(t, diags[0].Detail(), tc.expectedDetail)
+		})
+	}
+}
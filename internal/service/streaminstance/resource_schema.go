@@ -5,6 +5,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -46,24 +47,36 @@ func ResourceSchema(ctx context.Context) schema.Schema {
 			"stream_config": schema.SingleNestedAttribute{
 				Optional: true,
 				Computed: true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
 				Attributes: map[string]schema.Attribute{
 					"tier": schema.StringAttribute{
 						Optional: true,
 						Computed: true,
+						// the tier of a stream instance can't be updated, the stream instance is replaced
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+							stringplanmodifier.RequiresReplace(),
+						},
 					},
 				},
 			},
+			"prevent_replacement": schema.BoolAttribute{
+				Optional: true,
+			},
 		},
 	}
 }
 
 type TFStreamInstanceModel struct {
-	ID                types.String `tfsdk:"id"`
-	InstanceName      types.String `tfsdk:"instance_name"`
-	ProjectID         types.String `tfsdk:"project_id"`
-	DataProcessRegion types.Object `tfsdk:"data_process_region"`
-	StreamConfig      types.Object `tfsdk:"stream_config"`
-	Hostnames         types.List   `tfsdk:"hostnames"`
+	ID                 types.String `tfsdk:"id"`
+	InstanceName       types.String `tfsdk:"instance_name"`
+	ProjectID          types.String `tfsdk:"project_id"`
+	DataProcessRegion  types.Object `tfsdk:"data_process_region"`
+	StreamConfig       types.Object `tfsdk:"stream_config"`
+	Hostnames          types.List   `tfsdk:"hostnames"`
+	PreventReplacement types.Bool   `tfsdk:"prevent_replacement"`
 }
 
 type TFInstanceProcessRegionSpecModel struct {
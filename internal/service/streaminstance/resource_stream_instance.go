@@ -7,6 +7,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/conversion"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/validate"
@@ -15,6 +16,7 @@ import (
 
 var _ resource.ResourceWithConfigure = &streamInstanceRS{}
 var _ resource.ResourceWithImportState = &streamInstanceRS{}
+var _ resource.ResourceWithModifyPlan = &streamInstanceRS{}
 
 const streamInstanceName = "stream_instance"
 
@@ -35,6 +37,26 @@ func (r *streamInstanceRS) Schema(ctx context.Context, req resource.SchemaReques
 	conversion.UpdateSchemaDescription(&resp.Schema)
 }
 
+// ModifyPlan warns about the connections and stream processors destroyed when the stream instance is replaced, or refuses the
+// replacement if prevent_replacement is true.
+func (r *streamInstanceRS) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() || len(resp.RequiresReplace) == 0 {
+		return
+	}
+	var state, plan TFStreamInstanceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	instanceName := state.InstanceName.ValueString()
+	connections, processors, err := DependentObjects(ctx, r.Client.AtlasV2.StreamsApi, state.ProjectID.ValueString(), instanceName)
+	if err != nil {
+		tflog.Warn(ctx, "couldn't list the objects of the stream instance to be replaced", map[string]any{"error": err.Error()})
+	}
+	resp.Diagnostics.Append(ReplacementDiagnostics(instanceName, resp.RequiresReplace, connections, processors, plan.PreventReplacement.ValueBool())...)
+}
+
 func (r *streamInstanceRS) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var streamInstancePlan TFStreamInstanceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &streamInstancePlan)...)
@@ -60,6 +82,7 @@ func (r *streamInstanceRS) Create(ctx context.Context, req resource.CreateReques
 		resp.Diagnostics.Append(diags...)
 		return
 	}
+	newStreamInstanceModel.PreventReplacement = streamInstancePlan.PreventReplacement
 	resp.Diagnostics.Append(resp.State.Set(ctx, newStreamInstanceModel)...)
 }
 
@@ -88,6 +111,7 @@ func (r *streamInstanceRS) Read(ctx context.Context, req resource.ReadRequest, r
 		resp.Diagnostics.Append(diags...)
 		return
 	}
+	newStreamInstanceModel.PreventReplacement = streamInstanceState.PreventReplacement
 	resp.Diagnostics.Append(resp.State.Set(ctx, newStreamInstanceModel)...)
 }
 
@@ -117,6 +141,7 @@ func (r *streamInstanceRS) Update(ctx context.Context, req resource.UpdateReques
 		resp.Diagnostics.Append(diags...)
 		return
 	}
+	newStreamInstanceModel.PreventReplacement = streamInstancePlan.PreventReplacement
 	resp.Diagnostics.Append(resp.State.Set(ctx, newStreamInstanceModel)...)
 }
 
@@ -3,6 +3,7 @@ package streaminstance_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -66,6 +67,53 @@ func TestAccStreamRSStreamInstance_withStreamConfig(t *testing.T) {
 	})
 }
 
+func TestAccStreamRSStreamInstance_tierReplacement(t *testing.T) {
+	var (
+		resourceName = "mongodbatlas_stream_instance.test"
+		projectID    = acc.ProjectIDExecution(t)
+		instanceName = acc.RandomName()
+	)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acc.PreCheckBasic(t) },
+		ProtoV6ProviderFactories: acc.TestAccProviderV6Factories,
+		CheckDestroy:             acc.CheckDestroyStreamInstance,
+		Steps: []resource.TestStep{
+			{
+				Config: configPreventReplacement(projectID, instanceName, "SP10", true),
+				Check:  resource.TestCheckResourceAttr(resourceName, "stream_config.tier", "SP10"),
+			},
+			{
+				Config:      configPreventReplacement(projectID, instanceName, "SP30", true),
+				ExpectError: regexp.MustCompile("Stream instance replacement prevented"),
+			},
+			{
+				Config: configPreventReplacement(projectID, instanceName, "SP30", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					streamInstanceAttributeChecks(resourceName, instanceName, region, cloudProvider),
+					resource.TestCheckResourceAttr(resourceName, "stream_config.tier", "SP30"),
+				),
+			},
+		},
+	})
+}
+
+func configPreventReplacement(projectID, instanceName, tier string, preventReplacement bool) string {
+	return fmt.Sprintf(`
+		resource "mongodbatlas_stream_instance" "test" {
+			project_id = %[1]q
+			instance_name = %[2]q
+			data_process_region = {
+				region = %[3]q
+				cloud_provider = %[4]q
+			}
+			stream_config = {
+				tier = %[5]q
+			}
+			prevent_replacement = %[6]t
+		}
+	`, projectID, instanceName, region, cloudProvider, tier, preventReplacement)
+}
+
 func streamInstanceAttributeChecks(resourceName, instanceName, region, cloudProvider string) resource.TestCheckFunc {
 	resourceChecks := []resource.TestCheckFunc{
 		checkSearchInstanceExists(),
@@ -3,6 +3,7 @@ package streamprocessor
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -12,7 +13,7 @@ import (
 )
 
 func NewStreamProcessorReq(ctx context.Context, plan *TFStreamProcessorRSModel) (*admin.StreamsProcessor, diag.Diagnostics) {
-	pipeline, diags := convertPipelineToSdk(plan.Pipeline.ValueString())
+	pipeline, diags := newPipeline(ctx, plan)
 	if diags != nil {
 		return nil, diags
 	}
@@ -43,7 +44,7 @@ func NewStreamProcessorReq(ctx context.Context, plan *TFStreamProcessorRSModel)
 }
 
 func NewStreamProcessorUpdateReq(ctx context.Context, plan *TFStreamProcessorRSModel) (*admin.ModifyStreamProcessorApiParams, diag.Diagnostics) {
-	pipeline, diags := convertPipelineToSdk(plan.Pipeline.ValueString())
+	pipeline, diags := newPipeline(ctx, plan)
 	if diags != nil {
 		return nil, diags
 	}
@@ -76,6 +77,13 @@ func NewStreamProcessorUpdateReq(ctx context.Context, plan *TFStreamProcessorRSM
 		}
 	}
 
+	if !plan.ResumeFromCheckpoint.IsNull() && !plan.ResumeFromCheckpoint.IsUnknown() {
+		if streamProcessorAPIParams.StreamsModifyStreamProcessor.Options == nil {
+			streamProcessorAPIParams.StreamsModifyStreamProcessor.Options = &admin.StreamsModifyStreamProcessorOptions{}
+		}
+		streamProcessorAPIParams.StreamsModifyStreamProcessor.Options.ResumeFromCheckpoint = plan.ResumeFromCheckpoint.ValueBoolPointer()
+	}
+
 	return streamProcessorAPIParams, nil
 }
 
@@ -102,12 +110,31 @@ func NewStreamProcessorWithStats(ctx context.Context, projectID, instanceName st
 		ProcessorID:   types.StringPointerValue(&apiResp.Id),
 		ProcessorName: types.StringPointerValue(&apiResp.Name),
 		ProjectID:     types.StringPointerValue(&projectID),
+		Stages:        types.ListNull(StageObjectType),
 		State:         types.StringPointerValue(&apiResp.State),
 		Stats:         statsTF,
 	}
 	return tfModel, nil
 }
 
+// KeepPipelineFormat moves the pipeline returned by Atlas to stages when prior, the plan or state, uses stages instead of pipeline.
+func KeepPipelineFormat(ctx context.Context, model, prior *TFStreamProcessorRSModel) diag.Diagnostics {
+	if prior.Stages.IsNull() {
+		return nil
+	}
+	pipeline, diags := convertPipelineToSdk(model.Pipeline.ValueString())
+	if diags.HasError() {
+		return diags
+	}
+	stages, diags := convertStagesToTF(ctx, pipeline)
+	if diags.HasError() {
+		return diags
+	}
+	model.Stages = stages
+	model.Pipeline = fwtypes.JSONStringNull()
+	return nil
+}
+
 func NewTFStreamprocessorDSModel(ctx context.Context, projectID, instanceName string, apiResp *admin.StreamsProcessorWithStats) (*TFStreamProcessorDSModel, diag.Diagnostics) {
 	if apiResp == nil {
 		return nil, diag.Diagnostics{diag.NewErrorDiagnostic("streamProcessor API response is nil", "")}
@@ -219,3 +246,101 @@ func convertPipelineToSdk(pipeline string) ([]any, diag.Diagnostics) {
 	}
 	return pipelineSliceOfMaps, nil
 }
+
+// newPipeline returns the pipeline of the request, either from pipeline or composed from stages.
+func newPipeline(ctx context.Context, plan *TFStreamProcessorRSModel) ([]any, diag.Diagnostics) {
+	if plan.Stages.IsNull() || plan.Stages.IsUnknown() {
+		return convertPipelineToSdk(plan.Pipeline.ValueString())
+	}
+	var stages []TFStageModel
+	if diags := plan.Stages.ElementsAs(ctx, &stages, false); diags.HasError() {
+		return nil, diags
+	}
+	return convertStagesToSdk(stages)
+}
+
+// convertStagesToSdk composes the pipeline from the stages, every stage is a document with the stage name as its only key.
+func convertStagesToSdk(stages []TFStageModel) ([]any, diag.Diagnostics) {
+	pipeline := make([]any, len(stages))
+	for i := range stages {
+		var definition any
+		if err := json.Unmarshal([]byte(stages[i].Definition.ValueString()), &definition); err != nil {
+			return nil, diag.Diagnostics{diag.NewErrorDiagnostic(fmt.Sprintf("failed to unmarshal definition of stage %d", i), err.Error())}
+		}
+		pipeline[i] = map[string]any{stages[i].Name.ValueString(): definition}
+	}
+	return pipeline, nil
+}
+
+func convertStagesToTF(ctx context.Context, pipeline []any) (types.List, diag.Diagnostics) {
+	stages := make([]TFStageModel, 0, len(pipeline))
+	for i, item := range pipeline {
+		stage, ok := item.(map[string]any)
+		if !ok || len(stage) != 1 {
+			return types.ListNull(StageObjectType), diag.Diagnostics{diag.NewErrorDiagnostic(fmt.Sprintf("stage %d of the pipeline can't be represented in stages", i),
+				"every stage must be a document with a single stage name, use pipeline instead")}
+		}
+		for name, definition := range stage {
+			definitionJSON, err := json.Marshal(definition)
+			if err != nil {
+				return types.ListNull(StageObjectType), diag.Diagnostics{diag.NewErrorDiagnostic(fmt.Sprintf("failed to marshal definition of stage %d", i), err.Error())}
+			}
+			stages = append(stages, TFStageModel{Name: types.StringValue(name), Definition: fwtypes.JSONStringValue(string(definitionJSON))})
+		}
+	}
+	return types.ListValueFrom(ctx, StageObjectType, stages)
+}
+
+// planPipeline returns the planned pipeline as a JSON string, either from pipeline or composed from stages. It returns false if the
+// pipeline is not known yet.
+func planPipeline(ctx context.Context, plan *TFStreamProcessorRSModel) (string, bool) {
+	if plan.Stages.IsUnknown() {
+		return "", false
+	}
+	if plan.Stages.IsNull() {
+		return plan.Pipeline.ValueString(), !plan.Pipeline.IsUnknown()
+	}
+	var stages []TFStageModel
+	if diags := plan.Stages.ElementsAs(ctx, &stages, false); diags.HasError() {
+		return "", false
+	}
+	return composePipeline(stages)
+}
+
+// composePipeline returns the pipeline composed from the stages as a JSON string. It returns false if a stage is not known yet or
+// its definition is not valid JSON.
+func composePipeline(stages []TFStageModel) (string, bool) {
+	for i := range stages {
+		if stages[i].Name.IsUnknown() || stages[i].Definition.IsUnknown() || stages[i].Definition.IsNull() {
+			return "", false
+		}
+	}
+	pipeline, diags := convertStagesToSdk(stages)
+	if diags.HasError() {
+		return "", false
+	}
+	pipelineJSON, err := json.Marshal(pipeline)
+	if err != nil {
+		return "", false
+	}
+	return string(pipelineJSON), true
+}
+
+// dlqConnectionName returns the connection of the dead letter queue, or an empty string if it's not set or not known yet.
+func dlqConnectionName(ctx context.Context, plan *TFStreamProcessorRSModel) (string, diag.Diagnostics) {
+	if plan.Options.IsNull() || plan.Options.IsUnknown() {
+		return "", nil
+	}
+	optionsModel := &TFOptionsModel{}
+	if diags := plan.Options.As(ctx, optionsModel, basetypes.ObjectAsOptions{}); diags.HasError() {
+		return "", diags
+	}
+	if optionsModel.Dlq.IsNull() || optionsModel.Dlq.IsUnknown() {
+		return "", nil
+	}
+	dlqModel := &TFDlqModel{}
+	if diags := optionsModel.Dlq.As(ctx, dlqModel, basetypes.ObjectAsOptions{}); diags.HasError() {
+		return "", diags
+	}
+	return dlqModel.ConnectionName.ValueString(), nil
+}
@@ -193,6 +193,7 @@ func TestSDKToTFModel(t *testing.T) {
 				Pipeline:      fwtypes.JSONStringValue("[{\"$source\":{\"connectionName\":\"sample_stream_solar\"}},{\"$emit\":{\"connectionName\":\"__testLog\"}}]"),
 				ProcessorName: types.StringValue(processorName),
 				ProjectID:     types.StringValue(projectID),
+				Stages:        types.ListNull(streamprocessor.StageObjectType),
 				State:         types.StringValue("CREATED"),
 				Stats:         types.StringNull(),
 			},
@@ -207,6 +208,7 @@ func TestSDKToTFModel(t *testing.T) {
 				Pipeline:      fwtypes.JSONStringValue("[{\"$source\":{\"connectionName\":\"sample_stream_solar\"}},{\"$emit\":{\"connectionName\":\"__testLog\"}}]"),
 				ProcessorName: types.StringValue(processorName),
 				ProjectID:     types.StringValue(projectID),
+				Stages:        types.ListNull(streamprocessor.StageObjectType),
 				State:         types.StringValue("STARTED"),
 				Stats:         types.StringValue(statsExample),
 			},
@@ -221,6 +223,7 @@ func TestSDKToTFModel(t *testing.T) {
 				Pipeline:      fwtypes.JSONStringValue("[{\"$source\":{\"connectionName\":\"sample_stream_solar\"}},{\"$emit\":{\"connectionName\":\"__testLog\"}}]"),
 				ProcessorName: types.StringValue(processorName),
 				ProjectID:     types.StringValue(projectID),
+				Stages:        types.ListNull(streamprocessor.StageObjectType),
 				State:         types.StringValue("STARTED"),
 				Stats:         types.StringNull(),
 			},
@@ -292,3 +295,112 @@ func TestPluralDSSDKToTFModel(t *testing.T) {
 		})
 	}
 }
+
+func stagesTFList(t *testing.T) types.List {
+	t.Helper()
+	stages, diags := types.ListValueFrom(t.Context(), streamprocessor.StageObjectType, []streamprocessor.TFStageModel{
+		{Name: types.StringValue("$source"), Definition: fwtypes.JSONStringValue("{\"connectionName\":\"sample_stream_solar\"}")},
+		{Name: types.StringValue("$emit"), Definition: fwtypes.JSONStringValue("{\"connectionName\":\"__testLog\"}")},
+	})
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	return stages
+}
+
+func TestStagesToSDKModel(t *testing.T) {
+	plan := &streamprocessor.TFStreamProcessorRSModel{
+		InstanceName:  types.StringValue(instanceName),
+		Options:       types.ObjectNull(streamprocessor.OptionsObjectType.AttrTypes),
+		Pipeline:      fwtypes.JSONStringNull(),
+		ProcessorName: types.StringValue(processorName),
+		ProjectID:     types.StringValue(projectID),
+		Stages:        stagesTFList(t),
+	}
+	expectedPipeline := []any{pipelineStageSourceSample, pipelineStageEmitLog}
+
+	createReq, diags := streamprocessor.NewStreamProcessorReq(t.Context(), plan)
+	if diags.HasError() {
+		t.Fatalf("unexpected errors found: %s", diags.Errors()[0].Summary())
+	}
+	assert.Equal(t, expectedPipeline, createReq.GetPipeline())
+
+	updateReq, diags := streamprocessor.NewStreamProcessorUpdateReq(t.Context(), plan)
+	if diags.HasError() {
+		t.Fatalf("unexpected errors found: %s", diags.Errors()[0].Summary())
+	}
+	assert.Equal(t, expectedPipeline, updateReq.StreamsModifyStreamProcessor.GetPipeline())
+}
+
+func TestKeepPipelineFormat(t *testing.T) {
+	testCases := map[string]struct {
+		prior            *streamprocessor.TFStreamProcessorRSModel
+		expectedStages   types.List
+		expectedPipeline bool
+	}{
+		"prior with pipeline": {
+			prior:            &streamprocessor.TFStreamProcessorRSModel{Stages: types.ListNull(streamprocessor.StageObjectType)},
+			expectedStages:   types.ListNull(streamprocessor.StageObjectType),
+			expectedPipeline: true,
+		},
+		"prior with stages": {
+			prior:          &streamprocessor.TFStreamProcessorRSModel{Stages: stagesTFList(t)},
+			expectedStages: stagesTFList(t),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			model, diags := streamprocessor.NewStreamProcessorWithStats(t.Context(), projectID, instanceName, streamProcessorWithStats(t, nil))
+			if diags.HasError() {
+				t.Fatalf("unexpected errors found: %s", diags.Errors()[0].Summary())
+			}
+			diags = streamprocessor.KeepPipelineFormat(t.Context(), model, tc.prior)
+			if diags.HasError() {
+				t.Fatalf("unexpected errors found: %s", diags.Errors()[0].Summary())
+			}
+			assert.Equal(t, tc.expectedStages, model.Stages)
+			assert.Equal(t, tc.expectedPipeline, !model.Pipeline.IsNull())
+		})
+	}
+}
+
+func TestUpdateReqResumeFromCheckpoint(t *testing.T) {
+	testCases := map[string]struct {
+		resumeFromCheckpoint types.Bool
+		options              types.Object
+		expected             *admin.StreamsModifyStreamProcessorOptions
+	}{
+		"not set": {
+			resumeFromCheckpoint: types.BoolNull(),
+			options:              types.ObjectNull(streamprocessor.OptionsObjectType.AttrTypes),
+		},
+		"without options": {
+			resumeFromCheckpoint: types.BoolValue(false),
+			options:              types.ObjectNull(streamprocessor.OptionsObjectType.AttrTypes),
+			expected:             &admin.StreamsModifyStreamProcessorOptions{ResumeFromCheckpoint: admin.PtrBool(false)},
+		},
+		"with options": {
+			resumeFromCheckpoint: types.BoolValue(true),
+			options:              optionsToTFModel(t, &streamOptionsExample),
+			expected:             &admin.StreamsModifyStreamProcessorOptions{Dlq: streamOptionsExample.Dlq, ResumeFromCheckpoint: admin.PtrBool(true)},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			plan := &streamprocessor.TFStreamProcessorRSModel{
+				InstanceName:         types.StringValue(instanceName),
+				Options:              tc.options,
+				Pipeline:             fwtypes.JSONStringValue("[{\"$source\":{\"connectionName\":\"sample_stream_solar\"}},{\"$emit\":{\"connectionName\":\"__testLog\"}}]"),
+				ProcessorName:        types.StringValue(processorName),
+				ProjectID:            types.StringValue(projectID),
+				ResumeFromCheckpoint: tc.resumeFromCheckpoint,
+				Stages:               types.ListNull(streamprocessor.StageObjectType),
+			}
+			req, diags := streamprocessor.NewStreamProcessorUpdateReq(t.Context(), plan)
+			if diags.HasError() {
+				t.Fatalf("unexpected errors found: %s", diags.Errors()[0].Summary())
+			}
+			assert.Equal(t, tc.expected, req.StreamsModifyStreamProcessor.Options)
+		})
+	}
+}
@@ -0,0 +1,106 @@
+package streamprocessor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/atlas-sdk/v20250312003/admin"
+)
+
+// errorCodeCheckpointIncompatible is returned by Atlas when a modified stream processor can't resume from its checkpoint.
+const errorCodeCheckpointIncompatible = "STREAM_PROCESSOR_CHECKPOINT_INCOMPATIBLE"
+
+const checkpointHint = "Atlas can't resume the modified stream processor from its checkpoint. Set resume_from_checkpoint to false to discard " +
+	"the checkpoint and process the source from the start, or revert the change."
+
+// ModifyStreamProcessor updates a stream processor from currentState to plannedState. A STARTED stream processor is stopped before
+// being modified and started again afterwards if plannedState is STARTED. modifyParams is nil when only the state changes, so the
+// stream processor is not modified and keeps its checkpoint. If Atlas refuses the modification, a stream processor that was stopped is
+// started again so it keeps running with its previous definition.
+func ModifyStreamProcessor(ctx context.Context, client admin.StreamsApi, requestParams *admin.GetStreamProcessorApiParams, modifyParams *admin.ModifyStreamProcessorApiParams,
+	currentState, plannedState string, timeout time.Duration) (*admin.StreamsProcessorWithStats, error) {
+	if errMsg, isValidStateTransition := ValidateUpdateStateTransition(currentState, plannedState); !isValidStateTransition {
+		return nil, errors.New(errMsg)
+	}
+	var streamProcessor *admin.StreamsProcessorWithStats
+	stopped := false
+	if currentState == StartedState && (plannedState != StartedState || modifyParams != nil) {
+		if err := stopStreamProcessor(ctx, client, requestParams, timeout); err != nil {
+			return nil, err
+		}
+		stopped = true
+	}
+	if modifyParams != nil {
+		var err error
+		streamProcessor, _, err = client.ModifyStreamProcessorWithParams(ctx, modifyParams).Execute()
+		if err != nil {
+			return nil, modifyError(ctx, client, requestParams, err, stopped, timeout)
+		}
+	}
+	if plannedState == StartedState && (currentState != StartedState || stopped) {
+		return startStreamProcessor(ctx, client, requestParams, timeout)
+	}
+	if streamProcessor != nil && !stopped {
+		return streamProcessor, nil
+	}
+	streamProcessor, _, err := client.GetStreamProcessorWithParams(ctx, requestParams).Execute()
+	if err != nil {
+		return nil, fmt.Errorf("error fetching stream processor: %w", err)
+	}
+	return streamProcessor, nil
+}
+
+// Restarts returns true if ModifyStreamProcessor starts the stream processor, either because it's stopped before being modified or
+// because plannedState is STARTED and it was not started.
+func Restarts(currentState, plannedState string, modified bool) bool {
+	return plannedState == StartedState && (currentState != StartedState || modified)
+}
+
+func stopStreamProcessor(ctx context.Context, client admin.StreamsApi, requestParams *admin.GetStreamProcessorApiParams, timeout time.Duration) error {
+	_, err := client.StopStreamProcessorWithParams(ctx, &admin.StopStreamProcessorApiParams{
+		GroupId:       requestParams.GroupId,
+		TenantName:    requestParams.TenantName,
+		ProcessorName: requestParams.ProcessorName,
+	}).Execute()
+	if err != nil {
+		return fmt.Errorf("error stopping stream processor: %w", err)
+	}
+	if _, err := WaitStateTransition(ctx, requestParams, client, []string{StartedState}, []string{StoppedState}, timeout); err != nil {
+		return fmt.Errorf("error changing state of stream processor: %w", err)
+	}
+	return nil
+}
+
+func startStreamProcessor(ctx context.Context, client admin.StreamsApi, requestParams *admin.GetStreamProcessorApiParams, timeout time.Duration) (*admin.StreamsProcessorWithStats, error) {
+	_, err := client.StartStreamProcessorWithParams(ctx, &admin.StartStreamProcessorApiParams{
+		GroupId:       requestParams.GroupId,
+		TenantName:    requestParams.TenantName,
+		ProcessorName: requestParams.ProcessorName,
+	}).Execute()
+	if err != nil {
+		return nil, fmt.Errorf("error starting stream processor: %w", err)
+	}
+	streamProcessor, err := WaitStateTransition(ctx, requestParams, client, []string{CreatedState, StoppedState}, []string{StartedState}, timeout)
+	if err != nil {
+		return nil, fmt.Errorf("error changing state of stream processor: %w", err)
+	}
+	return streamProcessor, nil
+}
+
+// modifyError returns the error of a refused modification, explaining checkpoint incompatibilities. A stream processor that was stopped
+// to be modified is started again.
+func modifyError(ctx context.Context, client admin.StreamsApi, requestParams *admin.GetStreamProcessorApiParams, err error, stopped bool, timeout time.Duration) error {
+	result := fmt.Errorf("error modifying stream processor: %w", err)
+	if admin.IsErrorCode(err, errorCodeCheckpointIncompatible) {
+		result = fmt.Errorf("%w. %s", result, checkpointHint)
+	}
+	if !stopped {
+		return result
+	}
+	if _, startErr := startStreamProcessor(ctx, client, requestParams, timeout); startErr != nil {
+		return fmt.Errorf("%w. The stream processor was stopped to be modified and couldn't be started again: %w", result, startErr)
+	}
+	return fmt.Errorf("%w. The stream processor was started again with its previous definition", result)
+}
@@ -0,0 +1,171 @@
+package streamprocessor_test
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.mongodb.org/atlas-sdk/v20250312003/admin"
+	"go.mongodb.org/atlas-sdk/v20250312003/mockadmin"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/streamprocessor"
+)
+
+type modifyMocks struct {
+	modifyErr  error
+	getStates  []string
+	stop       bool
+	modify     bool
+	start      bool
+	startFails bool
+}
+
+func (mm *modifyMocks) setup(m *mockadmin.StreamsApi) {
+	if len(mm.getStates) > 0 {
+		m.EXPECT().GetStreamProcessorWithParams(mock.Anything, mock.Anything).Return(admin.GetStreamProcessorApiRequest{ApiService: m})
+		for i := range mm.getStates {
+			m.EXPECT().GetStreamProcessorExecute(mock.Anything).Return(responseWithState(&mm.getStates[i]), &http.Response{StatusCode: http.StatusOK}, nil).Once()
+		}
+	}
+	if mm.stop {
+		m.EXPECT().StopStreamProcessorWithParams(mock.Anything, mock.Anything).Return(admin.StopStreamProcessorApiRequest{ApiService: m})
+		m.EXPECT().StopStreamProcessorExecute(mock.Anything).Return(&http.Response{StatusCode: http.StatusOK}, nil).Once()
+	}
+	if mm.modify {
+		m.EXPECT().ModifyStreamProcessorWithParams(mock.Anything, mock.Anything).Return(admin.ModifyStreamProcessorApiRequest{ApiService: m})
+		if mm.modifyErr != nil {
+			m.EXPECT().ModifyStreamProcessorExecute(mock.Anything).Return(nil, &http.Response{StatusCode: http.StatusBadRequest}, mm.modifyErr).Once()
+		} else {
+			m.EXPECT().ModifyStreamProcessorExecute(mock.Anything).Return(responseWithState(&StoppedState), &http.Response{StatusCode: http.StatusOK}, nil).Once()
+		}
+	}
+	if mm.start {
+		m.EXPECT().StartStreamProcessorWithParams(mock.Anything, mock.Anything).Return(admin.StartStreamProcessorApiRequest{ApiService: m})
+		if mm.startFails {
+			m.EXPECT().StartStreamProcessorExecute(mock.Anything).Return(&http.Response{StatusCode: http.StatusInternalServerError}, errors.New("start failed")).Once()
+		} else {
+			m.EXPECT().StartStreamProcessorExecute(mock.Anything).Return(&http.Response{StatusCode: http.StatusOK}, nil).Once()
+		}
+	}
+}
+
+func TestModifyStreamProcessor(t *testing.T) {
+	modifyParams := &admin.ModifyStreamProcessorApiParams{ProcessorName: streamProcessorName}
+	testCases := map[string]struct {
+		modifyParams    *admin.ModifyStreamProcessorApiParams
+		mocks           modifyMocks
+		currentState    string
+		plannedState    string
+		expectedState   string
+		expectedError   []string
+		unexpectedError []string
+	}{
+		"started processor is stopped, modified and started": {
+			modifyParams:  modifyParams,
+			currentState:  StartedState,
+			plannedState:  StartedState,
+			mocks:         modifyMocks{stop: true, modify: true, start: true, getStates: []string{StoppedState, StartedState}},
+			expectedState: StartedState,
+		},
+		"started processor is stopped and modified": {
+			modifyParams:  modifyParams,
+			currentState:  StartedState,
+			plannedState:  StoppedState,
+			mocks:         modifyMocks{stop: true, modify: true, getStates: []string{StoppedState, StoppedState}},
+			expectedState: StoppedState,
+		},
+		"state change doesn't modify the processor": {
+			currentState:  StartedState,
+			plannedState:  StoppedState,
+			mocks:         modifyMocks{stop: true, getStates: []string{StoppedState, StoppedState}},
+			expectedState: StoppedState,
+		},
+		"no changes only reads the processor": {
+			currentState:  StartedState,
+			plannedState:  StartedState,
+			mocks:         modifyMocks{getStates: []string{StartedState}},
+			expectedState: StartedState,
+		},
+		"created processor is modified and started": {
+			modifyParams:  modifyParams,
+			currentState:  CreatedState,
+			plannedState:  StartedState,
+			mocks:         modifyMocks{modify: true, start: true, getStates: []string{StartedState}},
+			expectedState: StartedState,
+		},
+		"stopped processor is modified": {
+			modifyParams:  modifyParams,
+			currentState:  StoppedState,
+			plannedState:  StoppedState,
+			mocks:         modifyMocks{modify: true},
+			expectedState: StoppedState,
+		},
+		"invalid state transition": {
+			modifyParams:  modifyParams,
+			currentState:  CreatedState,
+			plannedState:  StoppedState,
+			expectedError: []string{"Stream Processor must be in STARTED state to transition to STOPPED state"},
+		},
+		"checkpoint incompatibility restarts the previous definition": {
+			modifyParams: modifyParams,
+			currentState: StartedState,
+			plannedState: StartedState,
+			mocks: modifyMocks{stop: true, modify: true, start: true, getStates: []string{StoppedState, StartedState},
+				modifyErr: apiError("STREAM_PROCESSOR_CHECKPOINT_INCOMPATIBLE", "pipeline is not compatible with the existing checkpoint")},
+			expectedError: []string{"error modifying stream processor", "Set resume_from_checkpoint to false", "started again with its previous definition"},
+		},
+		"other errors mentioning checkpoints don't suggest resume_from_checkpoint": {
+			modifyParams:    modifyParams,
+			currentState:    CreatedState,
+			plannedState:    StartedState,
+			mocks:           modifyMocks{modify: true, modifyErr: apiError("INVALID_ATTRIBUTE", "invalid checkpoint interval")},
+			expectedError:   []string{"error modifying stream processor", "invalid checkpoint interval"},
+			unexpectedError: []string{"resume_from_checkpoint"},
+		},
+		"refused modification of a created processor": {
+			modifyParams:  modifyParams,
+			currentState:  CreatedState,
+			plannedState:  StartedState,
+			mocks:         modifyMocks{modify: true, modifyErr: errors.New("invalid pipeline")},
+			expectedError: []string{"error modifying stream processor: invalid pipeline"},
+		},
+		"restart fails after refused modification": {
+			modifyParams: modifyParams,
+			currentState: StartedState,
+			plannedState: StartedState,
+			mocks: modifyMocks{stop: true, modify: true, start: true, startFails: true, getStates: []string{StoppedState},
+				modifyErr: errors.New("invalid pipeline")},
+			expectedError: []string{"error modifying stream processor: invalid pipeline", "couldn't be started again", "start failed"},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			m := mockadmin.NewStreamsApi(t)
+			tc.mocks.setup(m)
+			resp, err := streamprocessor.ModifyStreamProcessor(t.Context(), m, requestParams, tc.modifyParams, tc.currentState, tc.plannedState, time.Minute)
+			if len(tc.expectedError) > 0 {
+				assert.Error(t, err)
+				for _, expected := range tc.expectedError {
+					assert.Contains(t, err.Error(), expected)
+				}
+				for _, unexpected := range tc.unexpectedError {
+					assert.NotContains(t, err.Error(), unexpected)
+				}
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedState, resp.GetState())
+			assert.Equal(t, streamprocessor.Restarts(tc.currentState, tc.plannedState, tc.modifyParams != nil), tc.mocks.start)
+		})
+	}
+}
+
+func apiError(code, detail string) error {
+	err := &admin.GenericOpenAPIError{}
+	err.SetError(detail)
+	err.SetModel(admin.ApiError{ErrorCode: code, Error: http.StatusBadRequest, Detail: admin.PtrString(detail)})
+	return err
+}
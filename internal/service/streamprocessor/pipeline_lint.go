@@ -0,0 +1,297 @@
+package streamprocessor
+
+import (
+	"context"
+	_ "embed" // used to embed pipeline_stages.json
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/validate"
+)
+
+// pipeline_stages.json lists the top-level stages supported by Atlas Stream Processing pipelines, grouped by their position in the
+// pipeline. Atlas adds stages faster than provider releases, so stages missing from the catalog only raise warnings. Stages that differ
+// from a catalog entry only by case, and sources or sinks out of place are errors.
+//
+//go:embed pipeline_stages.json
+var pipelineStagesJSON []byte
+
+var pipelineStages = mustLoadPipelineStages()
+
+const (
+	connTypeCluster = "Cluster"
+	// builtInConnectionPrefix is the prefix of the connections that Atlas provides in every stream instance, e.g. __testLog.
+	builtInConnectionPrefix = "__"
+)
+
+type pipelineStagesCatalog struct {
+	Sources []string `json:"sources"`
+	Sinks   []string `json:"sinks"`
+	Stages  []string `json:"stages"`
+}
+
+func (c *pipelineStagesCatalog) isSource(name string) bool {
+	return slices.Contains(c.Sources, name)
+}
+
+func (c *pipelineStagesCatalog) isSink(name string) bool {
+	return slices.Contains(c.Sinks, name)
+}
+
+func mustLoadPipelineStages() *pipelineStagesCatalog {
+	var c pipelineStagesCatalog
+	if err := json.Unmarshal(pipelineStagesJSON, &c); err != nil {
+		panic(fmt.Sprintf("invalid pipeline stages catalog: %s", err))
+	}
+	return &c
+}
+
+// PipelineStages returns the stage names known by the catalog, sorted.
+func PipelineStages() []string {
+	names := slices.Concat(pipelineStages.Sources, pipelineStages.Sinks, pipelineStages.Stages)
+	sort.Strings(names)
+	return names
+}
+
+// PipelineStage is a top-level stage of a stream processing pipeline. Body is nil when the stage value is not a JSON object.
+type PipelineStage struct {
+	Body  map[string]any
+	Name  string
+	Index int
+}
+
+// PipelineIssue is a problem found in a pipeline. Index is the position of the offending stage, or -1 when the issue is about the
+// whole pipeline.
+type PipelineIssue struct {
+	Detail  string
+	Index   int
+	Warning bool
+}
+
+func (i PipelineIssue) String() string {
+	if i.Index < 0 {
+		return i.Detail
+	}
+	return fmt.Sprintf("stage %d: %s", i.Index, i.Detail)
+}
+
+// ParsePipeline returns the stages of a pipeline and the issues of the stages that are not objects with a single stage name.
+// Pipelines that are not valid JSON return no stages nor issues, they are reported by the JSON string type.
+func ParsePipeline(pipeline string) ([]PipelineStage, []PipelineIssue) {
+	var raw any
+	if err := json.Unmarshal([]byte(pipeline), &raw); err != nil {
+		return nil, nil
+	}
+	items, ok := raw.([]any)
+	if !ok {
+		return nil, []PipelineIssue{{Index: -1, Detail: "pipeline must be a JSON array of stages"}}
+	}
+	stages := make([]PipelineStage, 0, len(items))
+	var issues []PipelineIssue
+	for i, item := range items {
+		obj, ok := item.(map[string]any)
+		if !ok || len(obj) != 1 {
+			issues = append(issues, PipelineIssue{Index: i, Detail: "a stage must be a JSON object with a single stage name, e.g. {\"$source\": {...}}"})
+			continue
+		}
+		for name, value := range obj {
+			body, _ := value.(map[string]any)
+			stages = append(stages, PipelineStage{Index: i, Name: name, Body: body})
+		}
+	}
+	return stages, issues
+}
+
+// LintPipeline checks that a pipeline starts with a $source stage, ends with a sink stage and only uses known stage names.
+func LintPipeline(pipeline string) []PipelineIssue {
+	stages, issues := ParsePipeline(pipeline)
+	if stages == nil && issues == nil {
+		return nil
+	}
+	if len(issues) > 0 {
+		return issues
+	}
+	if len(stages) == 0 {
+		return []PipelineIssue{{Index: -1, Detail: "pipeline must have at least a $source stage and a $emit or $merge stage"}}
+	}
+	last := len(stages) - 1
+	for _, stage := range stages {
+		if detail, warning := checkStageName(stage.Name); detail != "" {
+			issues = append(issues, PipelineIssue{Index: stage.Index, Detail: detail, Warning: warning})
+		}
+		if pipelineStages.isSource(stage.Name) && stage.Index != 0 {
+			issues = append(issues, PipelineIssue{Index: stage.Index, Detail: fmt.Sprintf("%s can only be the first stage of the pipeline", stage.Name)})
+		}
+		if pipelineStages.isSink(stage.Name) && stage.Index != last {
+			issues = append(issues, PipelineIssue{Index: stage.Index, Detail: fmt.Sprintf("%s is a sink stage and can only be the last stage of the pipeline", stage.Name)})
+		}
+	}
+	if first := stages[0]; !pipelineStages.isSource(first.Name) {
+		issues = append(issues, PipelineIssue{Index: 0, Detail: fmt.Sprintf("pipeline must start with a $source stage, found %s", first.Name)})
+	}
+	if !pipelineStages.isSink(stages[last].Name) {
+		issues = append(issues, PipelineIssue{Index: last, Detail: fmt.Sprintf("pipeline must end with a $emit or $merge stage, found %s", stages[last].Name)})
+	}
+	return issues
+}
+
+// checkStageName returns an error detail for names that only differ from a known stage by case, and a warning detail for unknown names.
+func checkStageName(name string) (detail string, warning bool) {
+	known := PipelineStages()
+	if slices.Contains(known, name) {
+		return "", false
+	}
+	suggestion := validate.ClosestMatch(name, known)
+	if strings.EqualFold(suggestion, name) {
+		return fmt.Sprintf("%s is not a valid stage, stage names are case sensitive. Did you mean %s?", name, suggestion), false
+	}
+	detail = fmt.Sprintf("%s is not a known Atlas Stream Processing stage, Atlas may reject it.", name)
+	if suggestion != "" {
+		detail += fmt.Sprintf(" Did you mean %s?", suggestion)
+	}
+	return detail, true
+}
+
+// PipelineConnection is a connection referenced by a pipeline stage.
+type PipelineConnection struct {
+	Stage PipelineStage
+	Name  string
+}
+
+// PipelineConnections returns the connections referenced by the stages, either directly in connectionName ($source, $emit, $https,
+// $externalFunction) or in the into or from documents ($merge, $lookup, $cachedLookup).
+func PipelineConnections(stages []PipelineStage) []PipelineConnection {
+	var connections []PipelineConnection
+	for _, stage := range stages {
+		docs := []map[string]any{stage.Body}
+		for _, key := range []string{"into", "from"} {
+			if doc, ok := stage.Body[key].(map[string]any); ok {
+				docs = append(docs, doc)
+			}
+		}
+		for _, doc := range docs {
+			if name, ok := doc["connectionName"].(string); ok && name != "" {
+				connections = append(connections, PipelineConnection{Stage: stage, Name: name})
+			}
+		}
+	}
+	return connections
+}
+
+// CheckPipelineConnections returns a warning for every connection referenced by the stages that is not in connections, a map from
+// connection name to connection type, as it may be created in the same apply. Built-in connections like __testLog are not checked.
+func CheckPipelineConnections(stages []PipelineStage, connections map[string]string, instanceName string) []PipelineIssue {
+	var issues []PipelineIssue
+	for _, conn := range PipelineConnections(stages) {
+		if detail := checkConnectionName(conn.Name, connections, instanceName); detail != "" {
+			issues = append(issues, PipelineIssue{Index: conn.Stage.Index, Detail: fmt.Sprintf("%s %s", conn.Stage.Name, detail), Warning: true})
+		}
+	}
+	return issues
+}
+
+// CheckDLQConnection returns a detail if the dead letter queue connection is not in connections, with warning true as it may be
+// created in the same apply, or if it's not a Cluster connection. Connections with an unknown (empty) type are not checked.
+func CheckDLQConnection(name string, connections map[string]string, instanceName string) (detail string, warning bool) {
+	if detail := checkConnectionName(name, connections, instanceName); detail != "" {
+		return detail, true
+	}
+	if connType := connections[name]; connType != "" && connType != connTypeCluster {
+		return fmt.Sprintf("connection %q is of type %s, the dead letter queue must use a %s connection", name, connType, connTypeCluster), false
+	}
+	return "", false
+}
+
+func checkConnectionName(name string, connections map[string]string, instanceName string) string {
+	if _, found := connections[name]; found || strings.HasPrefix(name, builtInConnectionPrefix) {
+		return ""
+	}
+	detail := fmt.Sprintf("connection %q doesn't exist in stream instance %s.", name, instanceName)
+	names := make([]string, 0, len(connections))
+	for known := range connections {
+		names = append(names, known)
+	}
+	sort.Strings(names)
+	if suggestion := validate.ClosestMatch(name, names); suggestion != "" {
+		detail += fmt.Sprintf(" Did you mean %s?", suggestion)
+	}
+	return detail + " Ignore this warning if the connection is created in the same apply, referencing its mongodbatlas_stream_connection " +
+		"connection_name skips the check."
+}
+
+// PipelineValidator checks the structure and stage names of the pipeline at plan time.
+func PipelineValidator() validator.String {
+	return pipelineValidator{}
+}
+
+type pipelineValidator struct{}
+
+func (v pipelineValidator) Description(_ context.Context) string {
+	return "pipeline must start with a $source stage, end with a $emit or $merge stage and only use Atlas Stream Processing stages"
+}
+
+func (v pipelineValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v pipelineValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsUnknown() || req.ConfigValue.IsNull() {
+		return
+	}
+	resp.Diagnostics.Append(pipelineDiagnostics(req.Path, LintPipeline(req.ConfigValue.ValueString()))...)
+}
+
+// StagesValidator checks the pipeline composed from the stages like PipelineValidator, pointing at the offending stage.
+func StagesValidator() validator.List {
+	return stagesValidator{}
+}
+
+type stagesValidator struct{}
+
+func (v stagesValidator) Description(_ context.Context) string {
+	return "stages must start with a $source stage, end with a $emit or $merge stage and only use Atlas Stream Processing stages"
+}
+
+func (v stagesValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v stagesValidator) ValidateList(ctx context.Context, req validator.ListRequest, resp *validator.ListResponse) {
+	if req.ConfigValue.IsUnknown() || req.ConfigValue.IsNull() {
+		return
+	}
+	var stages []TFStageModel
+	if diags := req.ConfigValue.ElementsAs(ctx, &stages, false); diags.HasError() {
+		return
+	}
+	pipeline, ok := composePipeline(stages)
+	if !ok {
+		return
+	}
+	for _, issue := range LintPipeline(pipeline) {
+		p := req.Path
+		if issue.Index >= 0 {
+			p = p.AtListIndex(issue.Index)
+		}
+		resp.Diagnostics.Append(pipelineDiagnostics(p, []PipelineIssue{issue})...)
+	}
+}
+
+func pipelineDiagnostics(p path.Path, issues []PipelineIssue) diag.Diagnostics {
+	var diags diag.Diagnostics
+	for _, issue := range issues {
+		if issue.Warning {
+			diags.AddAttributeWarning(p, "Unknown stream processing stage", issue.String())
+		} else {
+			diags.AddAttributeError(p, "Invalid stream processing pipeline", issue.String())
+		}
+	}
+	return diags
+}
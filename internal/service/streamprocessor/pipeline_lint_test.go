@@ -0,0 +1,152 @@
+package streamprocessor_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/fwtypes"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/streamprocessor"
+)
+
+func TestLintPipeline(t *testing.T) {
+	testCases := map[string]struct {
+		pipeline string
+		expected []streamprocessor.PipelineIssue
+	}{
+		"valid pipeline": {
+			pipeline: `[{"$source":{"connectionName":"src"}},{"$match":{"a":1}},{"$emit":{"connectionName":"__testLog"}}]`,
+		},
+		"merge sink": {
+			pipeline: `[{"$source":{"connectionName":"src"}},{"$merge":{"into":{"connectionName":"dest","db":"d","coll":"c"}}}]`,
+		},
+		"invalid JSON is left to the JSON type": {
+			pipeline: `[{"$source":{"connectionName":"src"}`,
+		},
+		"not an array": {
+			pipeline: `{"$source":{}}`,
+			expected: []streamprocessor.PipelineIssue{{Index: -1, Detail: "pipeline must be a JSON array of stages"}},
+		},
+		"empty pipeline": {
+			pipeline: `[]`,
+			expected: []streamprocessor.PipelineIssue{{Index: -1, Detail: "pipeline must have at least a $source stage and a $emit or $merge stage"}},
+		},
+		"stage with several names": {
+			pipeline: `[{"$source":{},"$emit":{}}]`,
+			expected: []streamprocessor.PipelineIssue{{Index: 0, Detail: `a stage must be a JSON object with a single stage name, e.g. {"$source": {...}}`}},
+		},
+		"missing sink": {
+			pipeline: `[{"$source":{"connectionName":"src"}},{"$match":{"a":1}}]`,
+			expected: []streamprocessor.PipelineIssue{{Index: 1, Detail: "pipeline must end with a $emit or $merge stage, found $match"}},
+		},
+		"missing source": {
+			pipeline: `[{"$match":{"a":1}},{"$emit":{"connectionName":"dest"}}]`,
+			expected: []streamprocessor.PipelineIssue{{Index: 0, Detail: "pipeline must start with a $source stage, found $match"}},
+		},
+		"sources and sinks out of place": {
+			pipeline: `[{"$source":{}},{"$emit":{}},{"$source":{}},{"$merge":{}}]`,
+			expected: []streamprocessor.PipelineIssue{
+				{Index: 1, Detail: "$emit is a sink stage and can only be the last stage of the pipeline"},
+				{Index: 2, Detail: "$source can only be the first stage of the pipeline"},
+			},
+		},
+		"wrong case is an error": {
+			pipeline: `[{"$source":{}},{"$addfields":{}},{"$emit":{}}]`,
+			expected: []streamprocessor.PipelineIssue{{Index: 1, Detail: "$addfields is not a valid stage, stage names are case sensitive. Did you mean $addFields?"}},
+		},
+		"unknown stage warns with hint": {
+			pipeline: `[{"$source":{}},{"$matchh":{}},{"$emit":{}}]`,
+			expected: []streamprocessor.PipelineIssue{{Index: 1, Warning: true, Detail: "$matchh is not a known Atlas Stream Processing stage, Atlas may reject it. Did you mean $match?"}},
+		},
+		"misspelled sink": {
+			pipeline: `[{"$source":{}},{"$emitt":{}}]`,
+			expected: []streamprocessor.PipelineIssue{
+				{Index: 1, Warning: true, Detail: "$emitt is not a known Atlas Stream Processing stage, Atlas may reject it. Did you mean $emit?"},
+				{Index: 1, Detail: "pipeline must end with a $emit or $merge stage, found $emitt"},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, streamprocessor.LintPipeline(tc.pipeline))
+		})
+	}
+}
+
+func TestPipelineIssueString(t *testing.T) {
+	assert.Equal(t, "stage 2: detail", streamprocessor.PipelineIssue{Index: 2, Detail: "detail"}.String())
+	assert.Equal(t, "detail", streamprocessor.PipelineIssue{Index: -1, Detail: "detail"}.String())
+}
+
+func TestPipelineConnections(t *testing.T) {
+	stages, issues := streamprocessor.ParsePipeline(`[
+		{"$source":{"connectionName":"kafka"}},
+		{"$lookup":{"from":{"connectionName":"cluster","db":"d","coll":"c"}}},
+		{"$https":{"connectionName":"api"}},
+		{"$match":{"connectionName":1}},
+		{"$merge":{"into":{"connectionName":"cluster","db":"d","coll":"c"}}}
+	]`)
+	require.Empty(t, issues)
+	var names []string
+	var indexes []int
+	for _, conn := range streamprocessor.PipelineConnections(stages) {
+		names = append(names, conn.Name)
+		indexes = append(indexes, conn.Stage.Index)
+	}
+	assert.Equal(t, []string{"kafka", "cluster", "api", "cluster"}, names)
+	assert.Equal(t, []int{0, 1, 2, 4}, indexes)
+}
+
+func TestCheckPipelineConnections(t *testing.T) {
+	connections := map[string]string{"kafkaSrc": "Kafka", "clusterDest": "Cluster"}
+	stages, _ := streamprocessor.ParsePipeline(`[{"$source":{"connectionName":"kafkaSrcc"}},{"$emit":{"connectionName":"__testLog"}},{"$merge":{"into":{"connectionName":"clusterDest"}}}]`)
+	issues := streamprocessor.CheckPipelineConnections(stages, connections, "instance")
+	require.Len(t, issues, 1)
+	assert.Equal(t, 0, issues[0].Index)
+	assert.True(t, issues[0].Warning)
+	assert.Contains(t, issues[0].Detail, `$source connection "kafkaSrcc" doesn't exist in stream instance instance. Did you mean kafkaSrc?`)
+}
+
+func TestCheckDLQConnection(t *testing.T) {
+	connections := map[string]string{"kafka": "Kafka", "cluster": "Cluster", "planned": ""}
+	testCases := map[string]struct {
+		name           string
+		detailContains string
+		warning        bool
+	}{
+		"cluster connection":          {name: "cluster"},
+		"unknown type is not checked": {name: "planned"},
+		"missing connection":          {name: "other", detailContains: `connection "other" doesn't exist in stream instance instance`, warning: true},
+		"not a cluster connection":    {name: "kafka", detailContains: `connection "kafka" is of type Kafka, the dead letter queue must use a Cluster connection`},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			detail, warning := streamprocessor.CheckDLQConnection(tc.name, connections, "instance")
+			assert.Equal(t, tc.warning, warning)
+			if tc.detailContains == "" {
+				assert.Empty(t, detail)
+			} else {
+				assert.Contains(t, detail, tc.detailContains)
+			}
+		})
+	}
+}
+
+func TestStagesValidator(t *testing.T) {
+	stages, diags := types.ListValueFrom(t.Context(), streamprocessor.StageObjectType, []streamprocessor.TFStageModel{
+		{Name: types.StringValue("$source"), Definition: fwtypes.JSONStringValue(`{"connectionName":"src"}`)},
+		{Name: types.StringValue("$match"), Definition: fwtypes.JSONStringValue(`{"a":1}`)},
+	})
+	require.False(t, diags.HasError())
+	req := validator.ListRequest{Path: path.Root("stages"), ConfigValue: stages}
+	resp := &validator.ListResponse{}
+	streamprocessor.StagesValidator().ValidateList(t.Context(), req, resp)
+	require.Len(t, resp.Diagnostics, 1)
+	assert.Equal(t, path.Root("stages").AtListIndex(1), resp.Diagnostics[0].(diag.DiagnosticWithPath).Path())
+	assert.Equal(t, "stage 1: pipeline must end with a $emit or $merge stage, found $match", resp.Diagnostics[0].Detail())
+}
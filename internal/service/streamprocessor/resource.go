@@ -3,14 +3,21 @@ package streamprocessor
 import (
 	"context"
 	"errors"
+	"fmt"
+	"log"
+	"net/http"
 	"regexp"
+	"time"
 
 	"go.mongodb.org/atlas-sdk/v20250312003/admin"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/conversion"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/dsschema"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/validate"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/config"
 )
@@ -19,11 +26,13 @@ const StreamProcessorName = "stream_processor"
 
 var _ resource.ResourceWithConfigure = &streamProcessorRS{}
 var _ resource.ResourceWithImportState = &streamProcessorRS{}
+var _ resource.ResourceWithModifyPlan = &streamProcessorRS{}
 
 const (
 	errorCreateStartActions    = "You need to fix the processor and import the resource or delete it manually and re-run terraform apply."
 	errorCreateStart           = "Error starting stream processor. " + errorCreateStartActions
 	errorCreateStartTransition = "Error changing state of stream processor. " + errorCreateStartActions
+	errorUnhealthy             = "Stream processor is not healthy after starting"
 )
 
 func Resource() resource.Resource {
@@ -43,6 +52,57 @@ func (r *streamProcessorRS) Schema(ctx context.Context, req resource.SchemaReque
 	conversion.UpdateSchemaDescription(&resp.Schema)
 }
 
+// ModifyPlan warns about the connections referenced by the pipeline and the dead letter queue that don't exist in the stream instance
+// in Atlas, as they may be created in the same apply. The check is skipped if the stream instance doesn't exist yet.
+func (r *streamProcessorRS) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	var plan TFStreamProcessorRSModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.ProjectID.IsUnknown() || plan.InstanceName.IsUnknown() || plan.Options.IsUnknown() {
+		return
+	}
+	if !req.State.Raw.IsNull() {
+		var state TFStreamProcessorRSModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() || (plan.Pipeline.Equal(state.Pipeline) && plan.Stages.Equal(state.Stages) && plan.Options.Equal(state.Options)) {
+			return
+		}
+	}
+	pipeline, known := planPipeline(ctx, &plan)
+	if !known {
+		return
+	}
+	stages, _ := ParsePipeline(pipeline)
+	dlqConnection, diags := dlqConnectionName(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || (len(PipelineConnections(stages)) == 0 && dlqConnection == "") {
+		return
+	}
+	projectID := plan.ProjectID.ValueString()
+	instanceName := plan.InstanceName.ValueString()
+	connections, err := listConnections(ctx, r.Client.AtlasV2, projectID, instanceName)
+	if err != nil {
+		log.Printf("[WARN] skipping %s connections plan validation, unable to list connections of stream instance %s: %s", StreamProcessorName, instanceName, err)
+		return
+	}
+	for _, issue := range CheckPipelineConnections(stages, connections, instanceName) {
+		resp.Diagnostics.AddAttributeWarning(path.Root("pipeline"), "Unknown stream connection", issue.String())
+	}
+	if dlqConnection == "" {
+		return
+	}
+	dlqPath := path.Root("options").AtName("dlq").AtName("connection_name")
+	switch detail, warning := CheckDLQConnection(dlqConnection, connections, instanceName); {
+	case detail == "":
+	case warning:
+		resp.Diagnostics.AddAttributeWarning(dlqPath, "Unknown dead letter queue connection", detail)
+	default:
+		resp.Diagnostics.AddAttributeError(dlqPath, "Invalid dead letter queue connection", detail)
+	}
+}
+
 func (r *streamProcessorRS) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan TFStreamProcessorRSModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -56,6 +116,12 @@ func (r *streamProcessorRS) Create(ctx context.Context, req resource.CreateReque
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, DefaultTimeout)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
 	var needsStarting bool
 	if !plan.State.IsNull() && !plan.State.IsUnknown() {
 		switch plan.State.ValueString() {
@@ -86,12 +152,13 @@ func (r *streamProcessorRS) Create(ctx context.Context, req resource.CreateReque
 		ProcessorName: processorName,
 	}
 
-	streamProcessorResp, err := WaitStateTransition(ctx, streamProcessorParams, connV2.StreamsApi, []string{InitiatingState, CreatingState}, []string{CreatedState})
+	streamProcessorResp, err := WaitStateTransition(ctx, streamProcessorParams, connV2.StreamsApi, []string{InitiatingState, CreatingState}, []string{CreatedState}, createTimeout)
 	if err != nil {
 		resp.Diagnostics.AddError("Error creating stream processor", err.Error())
 		return
 	}
 
+	var healthErr error
 	if needsStarting {
 		_, err := connV2.StreamsApi.StartStreamProcessorWithParams(ctx,
 			&admin.StartStreamProcessorApiParams{
@@ -104,11 +171,12 @@ func (r *streamProcessorRS) Create(ctx context.Context, req resource.CreateReque
 			resp.Diagnostics.AddError(errorCreateStart, err.Error())
 			return
 		}
-		streamProcessorResp, err = WaitStateTransition(ctx, streamProcessorParams, connV2.StreamsApi, []string{CreatedState}, []string{StartedState})
+		streamProcessorResp, err = WaitStateTransition(ctx, streamProcessorParams, connV2.StreamsApi, []string{CreatedState}, []string{StartedState}, createTimeout)
 		if err != nil {
 			resp.Diagnostics.AddError(errorCreateStartTransition, err.Error())
 			return
 		}
+		streamProcessorResp, healthErr = waitHealthy(ctx, connV2.StreamsApi, streamProcessorParams, &plan, streamProcessorResp)
 	}
 
 	newStreamProcessorModel, diags := NewStreamProcessorWithStats(ctx, projectID, instanceName, streamProcessorResp)
@@ -116,7 +184,14 @@ func (r *streamProcessorRS) Create(ctx context.Context, req resource.CreateReque
 		resp.Diagnostics.Append(diags...)
 		return
 	}
+	if diags := keepConfigAttributes(ctx, newStreamProcessorModel, &plan); diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, newStreamProcessorModel)...)
+	if healthErr != nil {
+		resp.Diagnostics.AddError(errorUnhealthy, healthErr.Error())
+	}
 }
 
 func (r *streamProcessorRS) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -145,6 +220,10 @@ func (r *streamProcessorRS) Read(ctx context.Context, req resource.ReadRequest,
 		resp.Diagnostics.Append(diags...)
 		return
 	}
+	if diags := keepConfigAttributes(ctx, newStreamProcessorModel, &state); diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, newStreamProcessorModel)...)
 }
 
@@ -158,6 +237,12 @@ func (r *streamProcessorRS) Update(ctx context.Context, req resource.UpdateReque
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, DefaultTimeout)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
 	plannedState := plan.State.ValueString()
 	if plannedState == "" {
 		plannedState = state.State.ValueString()
@@ -168,7 +253,6 @@ func (r *streamProcessorRS) Update(ctx context.Context, req resource.UpdateReque
 	processorName := plan.ProcessorName.ValueString()
 	currentState := state.State.ValueString()
 	connV2 := r.Client.AtlasV2
-	var streamProcessorResp *admin.StreamsProcessorWithStats
 
 	// requestParams are needed for the state transition via the GET API
 	requestParams := &admin.GetStreamProcessorApiParams{
@@ -177,65 +261,26 @@ func (r *streamProcessorRS) Update(ctx context.Context, req resource.UpdateReque
 		ProcessorName: processorName,
 	}
 
-	if errMsg, isValidStateTransition := ValidateUpdateStateTransition(currentState, plannedState); !isValidStateTransition {
-		resp.Diagnostics.AddError(errMsg, "")
-		return
-	}
-
-	// we must stop the current stream processor if the current state is started
-	if currentState == StartedState {
-		_, err := connV2.StreamsApi.StopStreamProcessorWithParams(ctx,
-			&admin.StopStreamProcessorApiParams{
-				GroupId:       plan.ProjectID.ValueString(),
-				TenantName:    plan.InstanceName.ValueString(),
-				ProcessorName: plan.ProcessorName.ValueString(),
-			},
-		).Execute()
-		if err != nil {
-			resp.Diagnostics.AddError("Error stopping stream processor", err.Error())
-			return
-		}
-
-		// wait for transition from started to stopped
-		_, err = WaitStateTransition(ctx, requestParams, r.Client.AtlasV2.StreamsApi, []string{StartedState}, []string{StoppedState})
-		if err != nil {
-			resp.Diagnostics.AddError("Error changing state of stream processor", err.Error())
+	// the stream processor is only modified if its definition changes, so state changes keep the checkpoint
+	var modifyAPIRequestParams *admin.ModifyStreamProcessorApiParams
+	modified := isModified(&plan, &state)
+	if modified {
+		modifyAPIRequestParams, diags = NewStreamProcessorUpdateReq(ctx, &plan)
+		if diags.HasError() {
+			resp.Diagnostics.Append(diags...)
 			return
 		}
 	}
 
-	// modify the stream processor
-	modifyAPIRequestParams, diags := NewStreamProcessorUpdateReq(ctx, &plan)
-	if diags.HasError() {
-		resp.Diagnostics.Append(diags...)
-		return
-	}
-	streamProcessorResp, _, err := r.Client.AtlasV2.StreamsApi.ModifyStreamProcessorWithParams(ctx, modifyAPIRequestParams).Execute()
+	streamProcessorResp, err := ModifyStreamProcessor(ctx, connV2.StreamsApi, requestParams, modifyAPIRequestParams, currentState, plannedState, updateTimeout)
 	if err != nil {
-		resp.Diagnostics.AddError("Error modifying stream processor", err.Error())
+		resp.Diagnostics.AddError("Error updating stream processor", err.Error())
 		return
 	}
 
-	// start the stream processor if the desired state is started
-	if plannedState == StartedState {
-		_, err := r.Client.AtlasV2.StreamsApi.StartStreamProcessorWithParams(ctx,
-			&admin.StartStreamProcessorApiParams{
-				GroupId:       projectID,
-				TenantName:    instanceName,
-				ProcessorName: processorName,
-			},
-		).Execute()
-		if err != nil {
-			resp.Diagnostics.AddError("Error starting stream processor", err.Error())
-			return
-		}
-
-		// wait for transition from stopped to started
-		streamProcessorResp, err = WaitStateTransition(ctx, requestParams, r.Client.AtlasV2.StreamsApi, []string{StoppedState}, []string{StartedState})
-		if err != nil {
-			resp.Diagnostics.AddError("Error changing state of stream processor", err.Error())
-			return
-		}
+	var healthErr error
+	if Restarts(currentState, plannedState, modified) {
+		streamProcessorResp, healthErr = waitHealthy(ctx, connV2.StreamsApi, requestParams, &plan, streamProcessorResp)
 	}
 
 	newStreamProcessorModel, diags := NewStreamProcessorWithStats(ctx, projectID, instanceName, streamProcessorResp)
@@ -243,7 +288,14 @@ func (r *streamProcessorRS) Update(ctx context.Context, req resource.UpdateReque
 		resp.Diagnostics.Append(diags...)
 		return
 	}
+	if diags := keepConfigAttributes(ctx, newStreamProcessorModel, &plan); diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, newStreamProcessorModel)...)
+	if healthErr != nil {
+		resp.Diagnostics.AddError(errorUnhealthy, healthErr.Error())
+	}
 }
 
 func (r *streamProcessorRS) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -287,3 +339,54 @@ func splitImportID(id string) (projectID, instanceName, processorName *string, e
 
 	return
 }
+
+// isModified returns true if the definition of the stream processor changes, not only its state.
+func isModified(plan, state *TFStreamProcessorRSModel) bool {
+	return !plan.ProcessorName.Equal(state.ProcessorName) || !plan.Pipeline.Equal(state.Pipeline) || !plan.Stages.Equal(state.Stages) ||
+		!plan.Options.Equal(state.Options)
+}
+
+// keepConfigAttributes keeps the attributes that are not returned by Atlas from prior, the plan or state.
+func keepConfigAttributes(ctx context.Context, model, prior *TFStreamProcessorRSModel) diag.Diagnostics {
+	model.Timeouts = prior.Timeouts
+	model.WaitForHealthy = prior.WaitForHealthy
+	model.ResumeFromCheckpoint = prior.ResumeFromCheckpoint
+	return KeepPipelineFormat(ctx, model, prior)
+}
+
+// waitHealthy runs the health checks configured in wait_for_healthy on a started stream processor. It returns the last stream
+// processor returned by Atlas, or started if the checks are not configured.
+func waitHealthy(ctx context.Context, client admin.StreamsApi, requestParams *admin.GetStreamProcessorApiParams, plan *TFStreamProcessorRSModel,
+	started *admin.StreamsProcessorWithStats) (*admin.StreamsProcessorWithStats, error) {
+	if plan.WaitForHealthy.IsNull() || plan.WaitForHealthy.IsUnknown() {
+		return started, nil
+	}
+	var waitForHealthy TFWaitForHealthyModel
+	if diags := plan.WaitForHealthy.As(ctx, &waitForHealthy, basetypes.ObjectAsOptions{}); diags.HasError() {
+		return started, fmt.Errorf("invalid wait_for_healthy: %v", diags)
+	}
+	warmUp, err := time.ParseDuration(waitForHealthy.WarmUp.ValueString())
+	if err != nil {
+		return started, fmt.Errorf("invalid wait_for_healthy.warm_up: %w", err)
+	}
+	streamProcessor, err := WaitHealthy(ctx, requestParams, client, warmUp, waitForHealthy.MaxDLQMessageCount.ValueInt64Pointer())
+	if streamProcessor == nil {
+		return started, err
+	}
+	return streamProcessor, err
+}
+
+// listConnections returns the connections of a stream instance, from connection name to connection type.
+func listConnections(ctx context.Context, connV2 *admin.APIClient, projectID, instanceName string) (map[string]string, error) {
+	apiConnections, err := dsschema.AllPages(ctx, func(ctx context.Context, pageNum int) (dsschema.PaginateResponse[admin.StreamsConnection], *http.Response, error) {
+		return connV2.StreamsApi.ListStreamConnections(ctx, projectID, instanceName).PageNum(pageNum).Execute()
+	})
+	if err != nil {
+		return nil, err
+	}
+	connections := make(map[string]string, len(apiConnections))
+	for i := range apiConnections {
+		connections[apiConnections[i].GetName()] = apiConnections[i].GetType()
+	}
+	return connections, nil
+}
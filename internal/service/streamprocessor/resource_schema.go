@@ -3,14 +3,23 @@ package streamprocessor
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/fwtypes"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/validate"
 )
 
+const maxWarmUpMinutes = 60
+
 func ResourceSchema(ctx context.Context) schema.Schema {
 	return schema.Schema{
 		Attributes: map[string]schema.Attribute{
@@ -27,9 +36,40 @@ func ResourceSchema(ctx context.Context) schema.Schema {
 			},
 			"pipeline": schema.StringAttribute{
 				CustomType: fwtypes.JSONStringType,
-				Required:   true,
+				Optional:   true,
+				Validators: []validator.String{
+					PipelineValidator(),
+					stringvalidator.ExactlyOneOf(path.MatchRoot("stages")),
+				},
 				MarkdownDescription: "Stream aggregation pipeline you want to apply to your streaming data. [MongoDB Atlas Docs](https://www.mongodb.com/docs/atlas/atlas-stream-processing/stream-aggregation/#std-label-stream-aggregation)" +
-					" contain more information. Using [jsonencode](https://developer.hashicorp.com/terraform/language/functions/jsonencode) is recommended when setting this attribute. For more details see the [Aggregation Pipelines Documentation](https://www.mongodb.com/docs/atlas/atlas-stream-processing/stream-aggregation/)",
+					" contain more information. Using [jsonencode](https://developer.hashicorp.com/terraform/language/functions/jsonencode) is recommended when setting this attribute. For more details see the [Aggregation Pipelines Documentation](https://www.mongodb.com/docs/atlas/atlas-stream-processing/stream-aggregation/)" +
+					"\n\n**NOTE**: The pipeline is validated at plan time. It must start with a `$source` stage and end with a `$emit` or `$merge` stage, and a warning is shown for the connections it references that don't exist in the stream instance yet.",
+			},
+			"resume_from_checkpoint": schema.BoolAttribute{
+				Optional: true,
+				MarkdownDescription: "Whether the stream processor resumes from its last checkpoint when its pipeline or options are modified. Set to `false` to discard the checkpoint, e.g. when Atlas refuses a modification because the modified pipeline is not compatible with the checkpoint." +
+					" If not set, the Atlas default is used. Changing only the `state` of the stream processor doesn't modify it, so it always keeps its checkpoint.",
+			},
+			"stages": schema.ListNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "Stages of the stream aggregation pipeline, an alternative to `pipeline` that shows plan differences per stage. Exactly one of `pipeline` or `stages` must be set.",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					StagesValidator(),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "Name of the stage, including the `$` prefix, e.g. `$source`.",
+						},
+						"definition": schema.StringAttribute{
+							CustomType:          fwtypes.JSONStringType,
+							Required:            true,
+							MarkdownDescription: "Definition of the stage as a JSON object. Using [jsonencode](https://developer.hashicorp.com/terraform/language/functions/jsonencode) is recommended when setting this attribute.",
+						},
+					},
+				},
 			},
 			"processor_name": schema.StringAttribute{
 				Required:            true,
@@ -73,21 +113,65 @@ func ResourceSchema(ctx context.Context) schema.Schema {
 				Computed:            true,
 				MarkdownDescription: "The stats associated with the stream processor. Refer to the [MongoDB Atlas Docs](https://www.mongodb.com/docs/atlas/atlas-stream-processing/manage-stream-processor/#view-statistics-of-a-stream-processor) for more information.",
 			},
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+			}),
+			"wait_for_healthy": schema.SingleNestedAttribute{
+				Optional: true,
+				MarkdownDescription: "Checks the health of the stream processor after it's started by Terraform, until the warm-up ends. The apply fails if the stream processor reports a `FAILED` state or more dead letter queue messages than `max_dlq_message_count`." +
+					" A stream processor that fails the checks is still saved in the state, if it was being created it's marked as tainted and replaced in the next apply.",
+				Attributes: map[string]schema.Attribute{
+					"warm_up": schema.StringAttribute{
+						Required:            true,
+						MarkdownDescription: "Duration of the warm-up, e.g. `2m`. The stream processor is checked every 10 seconds during the warm-up. Must be between 0 and 60 minutes.",
+						Validators: []validator.String{
+							validate.ValidDurationBetween(0, maxWarmUpMinutes),
+						},
+					},
+					"max_dlq_message_count": schema.Int64Attribute{
+						Optional:            true,
+						MarkdownDescription: "Maximum number of messages the stream processor can send to the dead letter queue during the warm-up, as reported by its stats. If not set, dead letter queue messages are not checked.",
+						Validators: []validator.Int64{
+							int64validator.AtLeast(0),
+						},
+					},
+				},
+			},
 		},
 	}
 }
 
 type TFStreamProcessorRSModel struct {
-	InstanceName  types.String       `tfsdk:"instance_name"`
-	Options       types.Object       `tfsdk:"options"`
-	Pipeline      fwtypes.JSONString `tfsdk:"pipeline"`
-	ProcessorID   types.String       `tfsdk:"id"`
-	ProcessorName types.String       `tfsdk:"processor_name"`
-	ProjectID     types.String       `tfsdk:"project_id"`
-	State         types.String       `tfsdk:"state"`
-	Stats         types.String       `tfsdk:"stats"`
+	InstanceName         types.String       `tfsdk:"instance_name"`
+	Options              types.Object       `tfsdk:"options"`
+	Pipeline             fwtypes.JSONString `tfsdk:"pipeline"`
+	ProcessorID          types.String       `tfsdk:"id"`
+	ProcessorName        types.String       `tfsdk:"processor_name"`
+	ProjectID            types.String       `tfsdk:"project_id"`
+	ResumeFromCheckpoint types.Bool         `tfsdk:"resume_from_checkpoint"`
+	Stages               types.List         `tfsdk:"stages"`
+	State                types.String       `tfsdk:"state"`
+	Stats                types.String       `tfsdk:"stats"`
+	Timeouts             timeouts.Value     `tfsdk:"timeouts"`
+	WaitForHealthy       types.Object       `tfsdk:"wait_for_healthy"`
 }
 
+type TFWaitForHealthyModel struct {
+	MaxDLQMessageCount types.Int64  `tfsdk:"max_dlq_message_count"`
+	WarmUp             types.String `tfsdk:"warm_up"`
+}
+
+type TFStageModel struct {
+	Definition fwtypes.JSONString `tfsdk:"definition"`
+	Name       types.String       `tfsdk:"name"`
+}
+
+var StageObjectType = types.ObjectType{AttrTypes: map[string]attr.Type{
+	"definition": fwtypes.JSONStringType,
+	"name":       types.StringType,
+}}
+
 type TFOptionsModel struct {
 	Dlq types.Object `tfsdk:"dlq"`
 }
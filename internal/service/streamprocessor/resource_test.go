@@ -118,6 +118,70 @@ func TestAccStreamProcessor_withOptions(t *testing.T) {
 		}})
 }
 
+func TestAccStreamProcessor_stages(t *testing.T) {
+	var (
+		projectID     = acc.ProjectIDExecution(t)
+		processorName = "new-processor-stages"
+		instanceName  = acc.RandomName()
+	)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acc.PreCheckBasic(t) },
+		ProtoV6ProviderFactories: acc.TestAccProviderV6Factories,
+		CheckDestroy:             checkDestroyStreamProcessor,
+		Steps: []resource.TestStep{
+			{
+				Config: configStages(projectID, instanceName, processorName, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					checkExists(resourceName),
+					resource.TestCheckNoResourceAttr(resourceName, "pipeline"),
+					resource.TestCheckResourceAttr(resourceName, "stages.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "stages.0.name", "$source"),
+					resource.TestCheckResourceAttr(resourceName, "stages.1.name", "$emit"),
+				),
+			},
+			{
+				Config: configStages(projectID, instanceName, processorName, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					checkExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "stages.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "stages.1.name", "$match"),
+					resource.TestCheckResourceAttr(resourceName, "stages.2.name", "$emit"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportStateIdFunc:       importStateIDFunc(resourceName),
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"stats", "pipeline", "stages"},
+			},
+		}})
+}
+
+func TestAccStreamProcessor_waitForHealthy(t *testing.T) {
+	var (
+		projectID     = acc.ProjectIDExecution(t)
+		processorName = "new-processor-healthy"
+		instanceName  = acc.RandomName()
+	)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acc.PreCheckBasic(t) },
+		ProtoV6ProviderFactories: acc.TestAccProviderV6Factories,
+		CheckDestroy:             checkDestroyStreamProcessor,
+		Steps: []resource.TestStep{
+			{
+				Config: configWaitForHealthy(projectID, instanceName, processorName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					checkExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "state", streamprocessor.StartedState),
+					resource.TestCheckResourceAttr(resourceName, "wait_for_healthy.warm_up", "30s"),
+					resource.TestCheckResourceAttr(resourceName, "wait_for_healthy.max_dlq_message_count", "0"),
+					resource.TestCheckResourceAttr(resourceName, "timeouts.create", "10m"),
+				),
+			},
+		}})
+}
+
 func TestAccStreamProcessor_StateTransitionsUpdates(t *testing.T) {
 	transitions := []struct {
 		name         string
@@ -477,6 +541,85 @@ func configToUpdateStreamProcessor(projectID, instanceName, processorName, state
 		`, projectID, instanceName, processorName, pipeline, state)
 }
 
+func configWaitForHealthy(projectID, instanceName, processorName string) string {
+	return fmt.Sprintf(`resource "mongodbatlas_stream_instance" "instance" {
+			project_id    = %[1]q
+			instance_name = %[2]q
+			data_process_region = {
+				region         = "VIRGINIA_USA"
+				cloud_provider = "AWS"
+			}
+		}
+
+		resource "mongodbatlas_stream_connection" "sample" {
+			project_id      = %[1]q
+			instance_name   = mongodbatlas_stream_instance.instance.instance_name
+			connection_name = "sample_stream_solar"
+			type            = "Sample"
+		}
+
+		resource "mongodbatlas_stream_processor" "processor" {
+			project_id     = %[1]q
+			instance_name  = mongodbatlas_stream_instance.instance.instance_name
+			processor_name = %[3]q
+			pipeline = jsonencode([
+				{ "$source" = { connectionName = mongodbatlas_stream_connection.sample.connection_name } },
+				{ "$emit" = { connectionName = "__testLog" } }
+			])
+			state = "STARTED"
+			wait_for_healthy = {
+				warm_up               = "30s"
+				max_dlq_message_count = 0
+			}
+			timeouts = {
+				create = "10m"
+				update = "10m"
+			}
+		}
+		`, projectID, instanceName, processorName)
+}
+
+func configStages(projectID, instanceName, processorName string, withMatch bool) string {
+	matchStage := ""
+	if withMatch {
+		matchStage = `{
+				name       = "$match"
+				definition = jsonencode({ "obs.watts" = { "$gt" = 10 } })
+			},`
+	}
+	return fmt.Sprintf(`resource "mongodbatlas_stream_instance" "instance" {
+			project_id    = %[1]q
+			instance_name = %[2]q
+			data_process_region = {
+				region         = "VIRGINIA_USA"
+				cloud_provider = "AWS"
+			}
+		}
+
+		resource "mongodbatlas_stream_connection" "sample" {
+			project_id      = %[1]q
+			instance_name   = mongodbatlas_stream_instance.instance.instance_name
+			connection_name = "sample_stream_solar"
+			type            = "Sample"
+		}
+
+		resource "mongodbatlas_stream_processor" "processor" {
+			project_id     = %[1]q
+			instance_name  = mongodbatlas_stream_instance.instance.instance_name
+			processor_name = %[3]q
+			stages = [{
+				name       = "$source"
+				definition = jsonencode({ connectionName = mongodbatlas_stream_connection.sample.connection_name })
+			},
+			%[4]s
+			{
+				name       = "$emit"
+				definition = jsonencode({ connectionName = "__testLog" })
+			}]
+		}
+		`, projectID, instanceName, processorName, matchStage)
+}
+
 func checkAttributesFromBasicUpdateFlow(projectID, instanceName, processorName, state, expectedPipelineStr string) resource.TestCheckFunc {
 	checks := []resource.TestCheckFunc{checkExists(resourceName)}
 	attributes := map[string]string{
@@ -21,17 +21,26 @@ const (
 	FailedState     = "FAILED"
 )
 
+const (
+	// DefaultTimeout is the default timeout of state transitions. Big pipelines can take a while to stop due to checkpointing,
+	// the API usually raises an error after ~3 minutes.
+	DefaultTimeout = 5 * time.Minute
+	// healthCheckInterval is the time between health checks during the warm-up of a started stream processor.
+	healthCheckInterval = 10 * time.Second
+	dlqMessageCountStat = "dlqMessageCount"
+)
+
 const (
 	ErrorUpdateStateTransition = "Stream Processor must be in %s state to transition to %s state"
 	ErrorUpdateToCreatedState  = "Stream Processor cannot transition from %s to CREATED"
 )
 
-func WaitStateTransition(ctx context.Context, requestParams *admin.GetStreamProcessorApiParams, client admin.StreamsApi, pendingStates, desiredStates []string) (*admin.StreamsProcessorWithStats, error) {
+func WaitStateTransition(ctx context.Context, requestParams *admin.GetStreamProcessorApiParams, client admin.StreamsApi, pendingStates, desiredStates []string, timeout time.Duration) (*admin.StreamsProcessorWithStats, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending:    pendingStates,
 		Target:     desiredStates,
 		Refresh:    refreshFunc(ctx, requestParams, client),
-		Timeout:    5 * time.Minute, // big pipelines can take a while to stop due to checkpointing. We prefer the API to raise the error (~ 3min) than having to expose custom timeouts.
+		Timeout:    timeout,
 		MinTimeout: 3 * time.Second,
 		Delay:      0,
 	}
@@ -48,6 +57,50 @@ func WaitStateTransition(ctx context.Context, requestParams *admin.GetStreamProc
 	return nil, errors.New("did not obtain valid result when waiting for stream processor state transition")
 }
 
+// WaitHealthy checks the health of a started stream processor until the warm-up ends, returning an error as soon as the processor
+// is unhealthy. The last processor returned by Atlas is returned even if it's unhealthy. See CheckHealth for the checks done.
+func WaitHealthy(ctx context.Context, requestParams *admin.GetStreamProcessorApiParams, client admin.StreamsApi, warmUp time.Duration, maxDLQMessageCount *int64) (*admin.StreamsProcessorWithStats, error) {
+	deadline := time.Now().Add(warmUp)
+	for {
+		streamProcessor, _, err := client.GetStreamProcessorWithParams(ctx, requestParams).Execute()
+		if err != nil {
+			return nil, err
+		}
+		if err := CheckHealth(streamProcessor, maxDLQMessageCount); err != nil {
+			return streamProcessor, err
+		}
+		remaining := time.Until(deadline)
+		if remaining <= 0 {
+			return streamProcessor, nil
+		}
+		select {
+		case <-ctx.Done():
+			return streamProcessor, fmt.Errorf("timeout while waiting for stream processor %s warm-up: %w", requestParams.ProcessorName, ctx.Err())
+		case <-time.After(min(remaining, healthCheckInterval)):
+		}
+	}
+}
+
+// CheckHealth returns an error if the stream processor is FAILED or, when maxDLQMessageCount is not nil, if its stats report more
+// dead letter queue messages than maxDLQMessageCount.
+func CheckHealth(streamProcessor *admin.StreamsProcessorWithStats, maxDLQMessageCount *int64) error {
+	if state := streamProcessor.GetState(); state == FailedState {
+		return fmt.Errorf("stream processor %s is in %s state", streamProcessor.GetName(), state)
+	}
+	if maxDLQMessageCount == nil {
+		return nil
+	}
+	stats, ok := streamProcessor.GetStats().(map[string]any)
+	if !ok {
+		return nil
+	}
+	if count, ok := stats[dlqMessageCountStat].(float64); ok && int64(count) > *maxDLQMessageCount {
+		return fmt.Errorf("stream processor %s sent %d messages to the dead letter queue, more than the maximum of %d",
+			streamProcessor.GetName(), int64(count), *maxDLQMessageCount)
+	}
+	return nil
+}
+
 func ValidateUpdateStateTransition(currentState, plannedState string) (errMsg string, isValidTransition bool) {
 	if currentState == plannedState {
 		return "", true
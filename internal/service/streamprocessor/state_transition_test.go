@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/http"
 	"testing"
+	"time"
 
 	"go.mongodb.org/atlas-sdk/v20250312003/admin"
 	"go.mongodb.org/atlas-sdk/v20250312003/mockadmin"
@@ -122,7 +123,7 @@ func TestStreamProcessorStateTransition(t *testing.T) {
 				modelResp, httpResp, err := resp.get()
 				m.EXPECT().GetStreamProcessorExecute(mock.Anything).Return(modelResp, httpResp, err).Once()
 			}
-			resp, err := streamprocessor.WaitStateTransition(t.Context(), requestParams, m, tc.pendingStates, tc.desiredStates)
+			resp, err := streamprocessor.WaitStateTransition(t.Context(), requestParams, m, tc.pendingStates, tc.desiredStates, time.Minute)
 			assert.Equal(t, tc.expectedError, err != nil)
 			if resp != nil {
 				assert.Equal(t, *tc.expectedState, resp.State)
@@ -279,3 +280,72 @@ func TestValidateUpdateStateTransition(t *testing.T) {
 		})
 	}
 }
+
+func TestCheckHealth(t *testing.T) {
+	withDLQMessages := func(state string, count float64) *admin.StreamsProcessorWithStats {
+		return &admin.StreamsProcessorWithStats{Name: streamProcessorName, State: state, Stats: map[string]any{"dlqMessageCount": count}}
+	}
+	testCases := map[string]struct {
+		streamProcessor    *admin.StreamsProcessorWithStats
+		maxDLQMessageCount *int64
+		expectedError      string
+	}{
+		"started":                  {streamProcessor: responseWithState(&StartedState)},
+		"failed":                   {streamProcessor: responseWithState(&FailedState), expectedError: "stream processor processorName is in FAILED state"},
+		"dlq messages not checked": {streamProcessor: withDLQMessages(StartedState, 5)},
+		"dlq messages under max":   {streamProcessor: withDLQMessages(StartedState, 5), maxDLQMessageCount: conversion.Pointer(int64(5))},
+		"dlq messages over max": {
+			streamProcessor:    withDLQMessages(StartedState, 6),
+			maxDLQMessageCount: conversion.Pointer(int64(5)),
+			expectedError:      "stream processor processorName sent 6 messages to the dead letter queue, more than the maximum of 5",
+		},
+		"no stats": {streamProcessor: responseWithState(&StartedState), maxDLQMessageCount: conversion.Pointer(int64(0))},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := streamprocessor.CheckHealth(tc.streamProcessor, tc.maxDLQMessageCount)
+			if tc.expectedError == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tc.expectedError)
+			}
+		})
+	}
+}
+
+func TestWaitHealthy(t *testing.T) {
+	testCases := map[string]struct {
+		mockResponses []response
+		warmUp        time.Duration
+		expectedError bool
+	}{
+		"healthy without warm-up": {
+			mockResponses: []response{{state: &StartedState, statusCode: sc200}},
+		},
+		"healthy during warm-up": {
+			mockResponses: []response{{state: &StartedState, statusCode: sc200}, {state: &StartedState, statusCode: sc200}},
+			warmUp:        50 * time.Millisecond,
+		},
+		"failed during warm-up": {
+			mockResponses: []response{{state: &StartedState, statusCode: sc200}, {state: &FailedState, statusCode: sc200}},
+			warmUp:        50 * time.Millisecond,
+			expectedError: true,
+		},
+		"error getting the stream processor": {
+			mockResponses: []response{{statusCode: sc500, err: errors.New("Internal server error")}},
+			expectedError: true,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			m := mockadmin.NewStreamsApi(t)
+			m.EXPECT().GetStreamProcessorWithParams(mock.Anything, mock.Anything).Return(admin.GetStreamProcessorApiRequest{ApiService: m})
+			for _, resp := range tc.mockResponses {
+				modelResp, httpResp, err := resp.get()
+				m.EXPECT().GetStreamProcessorExecute(mock.Anything).Return(modelResp, httpResp, err).Once()
+			}
+			_, err := streamprocessor.WaitHealthy(t.Context(), requestParams, m, tc.warmUp, nil)
+			assert.Equal(t, tc.expectedError, err != nil)
+		})
+	}
+}
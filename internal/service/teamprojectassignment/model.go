@@ -0,0 +1,49 @@
+package teamprojectassignment
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"go.mongodb.org/atlas-sdk/v20250312003/admin"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/conversion"
+)
+
+func NewTFModel(ctx context.Context, projectID string, team *admin.TeamRole) (*TFModel, diag.Diagnostics) {
+	roleNames, diags := types.SetValueFrom(ctx, types.StringType, team.GetRoleNames())
+	return &TFModel{
+		ProjectID: types.StringValue(projectID),
+		TeamID:    types.StringPointerValue(team.TeamId),
+		RoleNames: roleNames,
+	}, diags
+}
+
+func NewTeamRole(ctx context.Context, plan *TFModel) *admin.TeamRole {
+	return &admin.TeamRole{
+		TeamId:    plan.TeamID.ValueStringPointer(),
+		RoleNames: conversion.Pointer(conversion.TypesSetToString(ctx, plan.RoleNames)),
+	}
+}
+
+// FindTeam returns nil if the team is not assigned to the project.
+func FindTeam(teams []admin.TeamRole, teamID string) *admin.TeamRole {
+	for i := range teams {
+		if teams[i].GetTeamId() == teamID {
+			return &teams[i]
+		}
+	}
+	return nil
+}
+
+// SplitImportID parses the {project_id}-{team_id} import ID.
+func SplitImportID(id string) (projectID, teamID string, err error) {
+	projectID, teamID, found := strings.Cut(id, "-")
+	if !found || projectID == "" || teamID == "" {
+		return "", "", fmt.Errorf("import format error: to import a team project assignment use the format {project_id}-{team_id}, got %s", id)
+	}
+	return projectID, teamID, nil
+}
@@ -0,0 +1,63 @@
+package teamprojectassignment_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/atlas-sdk/v20250312003/admin"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/conversion"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/teamprojectassignment"
+)
+
+const (
+	projectID = "111111111111111111111111"
+	teamID    = "222222222222222222222222"
+)
+
+func TestNewTFModel(t *testing.T) {
+	team := &admin.TeamRole{
+		TeamId:    conversion.StringPtr(teamID),
+		RoleNames: &[]string{"GROUP_READ_ONLY", "GROUP_DATA_ACCESS_READ_ONLY"},
+	}
+	model, diags := teamprojectassignment.NewTFModel(t.Context(), projectID, team)
+	require.False(t, diags.HasError())
+	assert.Equal(t, &teamprojectassignment.TFModel{
+		ProjectID: types.StringValue(projectID),
+		TeamID:    types.StringValue(teamID),
+		RoleNames: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("GROUP_READ_ONLY"), types.StringValue("GROUP_DATA_ACCESS_READ_ONLY")}),
+	}, model)
+}
+
+func TestNewTeamRole(t *testing.T) {
+	plan := &teamprojectassignment.TFModel{
+		ProjectID: types.StringValue(projectID),
+		TeamID:    types.StringValue(teamID),
+		RoleNames: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("GROUP_OWNER")}),
+	}
+	assert.Equal(t, &admin.TeamRole{
+		TeamId:    conversion.StringPtr(teamID),
+		RoleNames: &[]string{"GROUP_OWNER"},
+	}, teamprojectassignment.NewTeamRole(t.Context(), plan))
+}
+
+func TestFindTeam(t *testing.T) {
+	teams := []admin.TeamRole{{TeamId: conversion.StringPtr("other")}, {TeamId: conversion.StringPtr(teamID)}}
+	assert.Equal(t, &teams[1], teamprojectassignment.FindTeam(teams, teamID))
+	assert.Nil(t, teamprojectassignment.FindTeam(teams, "missing"))
+}
+
+func TestSplitImportID(t *testing.T) {
+	gotProjectID, gotTeamID, err := teamprojectassignment.SplitImportID(projectID + "-" + teamID)
+	require.NoError(t, err)
+	assert.Equal(t, projectID, gotProjectID)
+	assert.Equal(t, teamID, gotTeamID)
+
+	for _, id := range []string{projectID, projectID + "-", "-" + teamID} {
+		_, _, err = teamprojectassignment.SplitImportID(id)
+		assert.Error(t, err, id)
+	}
+}
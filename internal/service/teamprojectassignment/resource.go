@@ -0,0 +1,168 @@
+package teamprojectassignment
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"go.mongodb.org/atlas-sdk/v20250312003/admin"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/conversion"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/dsschema"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/validate"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/config"
+)
+
+const (
+	resourceName     = "team_project_assignment"
+	fullResourceName = "mongodbatlas_" + resourceName
+	errorCreate      = "error creating resource " + fullResourceName
+	errorRead        = "error reading resource " + fullResourceName
+	errorUpdate      = "error updating resource " + fullResourceName
+	errorDelete      = "error deleting resource " + fullResourceName
+	errorImport      = "error importing resource " + fullResourceName
+)
+
+var _ resource.ResourceWithConfigure = &rs{}
+var _ resource.ResourceWithImportState = &rs{}
+
+func Resource() resource.Resource {
+	return &rs{
+		RSCommon: config.RSCommon{
+			ResourceName: resourceName,
+		},
+	}
+}
+
+type rs struct {
+	config.RSCommon
+}
+
+func (r *rs) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = ResourceSchema(ctx)
+	conversion.UpdateSchemaDescription(&resp.Schema)
+}
+
+func (r *rs) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan TFModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	api := r.Client.AtlasV2.TeamsApi
+	projectID := plan.ProjectID.ValueString()
+	teams := []admin.TeamRole{*NewTeamRole(ctx, &plan)}
+	if _, _, err := api.AddAllTeamsToProject(ctx, projectID, &teams).Execute(); err != nil {
+		resp.Diagnostics.AddError(errorCreate, err.Error())
+		return
+	}
+	team, err := getAssignedTeam(ctx, api, projectID, plan.TeamID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(errorCreate, err.Error())
+		return
+	}
+	model, diags := NewTFModel(ctx, projectID, team)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+}
+
+func (r *rs) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state TFModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	projectID, teamID := state.ProjectID.ValueString(), state.TeamID.ValueString()
+	team, err := getTeam(ctx, r.Client.AtlasV2.TeamsApi, projectID, teamID)
+	if err != nil {
+		resp.Diagnostics.AddError(errorRead, err.Error())
+		return
+	}
+	if team == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	model, diags := NewTFModel(ctx, projectID, team)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+}
+
+func (r *rs) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan TFModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	api := r.Client.AtlasV2.TeamsApi
+	projectID, teamID := plan.ProjectID.ValueString(), plan.TeamID.ValueString()
+	if _, _, err := api.UpdateTeamRoles(ctx, projectID, teamID, NewTeamRole(ctx, &plan)).Execute(); err != nil {
+		resp.Diagnostics.AddError(errorUpdate, err.Error())
+		return
+	}
+	team, err := getAssignedTeam(ctx, api, projectID, teamID)
+	if err != nil {
+		resp.Diagnostics.AddError(errorUpdate, err.Error())
+		return
+	}
+	model, diags := NewTFModel(ctx, projectID, team)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+}
+
+func (r *rs) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state TFModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	httpResp, err := r.Client.AtlasV2.TeamsApi.RemoveProjectTeam(ctx, state.ProjectID.ValueString(), state.TeamID.ValueString()).Execute()
+	if err != nil && !validate.StatusNotFound(httpResp) {
+		resp.Diagnostics.AddError(errorDelete, err.Error())
+	}
+}
+
+func (r *rs) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	projectID, teamID, err := SplitImportID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(errorImport, err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), projectID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("team_id"), teamID)...)
+}
+
+// getAssignedTeam reads the team after assigning it, failing if it's not assigned to the project.
+func getAssignedTeam(ctx context.Context, api admin.TeamsApi, projectID, teamID string) (*admin.TeamRole, error) {
+	team, err := getTeam(ctx, api, projectID, teamID)
+	if err == nil && team == nil {
+		err = fmt.Errorf("team %s is not assigned to project %s", teamID, projectID)
+	}
+	return team, err
+}
+
+// getTeam returns nil if the team is not assigned to the project. There is no endpoint to get a single team of a project.
+func getTeam(ctx context.Context, api admin.TeamsApi, projectID, teamID string) (*admin.TeamRole, error) {
+	teams, err := dsschema.AllPages(ctx, func(ctx context.Context, pageNum int) (dsschema.PaginateResponse[admin.TeamRole], *http.Response, error) {
+		params := &admin.ListProjectTeamsApiParams{
+			GroupId: projectID,
+			PageNum: &pageNum,
+		}
+		return api.ListProjectTeamsWithParams(ctx, params).Execute()
+	})
+	if err != nil {
+		return nil, err
+	}
+	return FindTeam(teams, teamID), nil
+}
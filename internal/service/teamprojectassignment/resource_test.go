@@ -0,0 +1,105 @@
+package teamprojectassignment_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/teamprojectassignment"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/testutil/acc"
+)
+
+const resourceName = "mongodbatlas_team_project_assignment.test"
+
+func TestAccTeamProjectAssignment_basic(t *testing.T) {
+	var (
+		orgID       = os.Getenv("MONGODB_ATLAS_ORG_ID")
+		projectName = acc.RandomProjectName()
+		teamID      = acc.GetProjectTeamsIDsWithPos(0)
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acc.PreCheckBasic(t); acc.PreCheckProjectTeamsIDsWithMinCount(t, 1) },
+		ProtoV6ProviderFactories: acc.TestAccProviderV6Factories,
+		CheckDestroy:             acc.CheckDestroyProject,
+		Steps: []resource.TestStep{
+			{
+				Config: configBasic(orgID, projectName, teamID, "GROUP_READ_ONLY"),
+				Check:  checkAssignment(teamID, "GROUP_READ_ONLY"),
+			},
+			{
+				Config: configBasic(orgID, projectName, teamID, "GROUP_DATA_ACCESS_ADMIN"),
+				Check:  checkAssignment(teamID, "GROUP_DATA_ACCESS_ADMIN"),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportStateIdFunc:                    importStateIDFunc(resourceName),
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "team_id",
+			},
+		},
+	})
+}
+
+func configBasic(orgID, projectName, teamID, roleName string) string {
+	return fmt.Sprintf(`
+resource "mongodbatlas_project" "test" {
+  org_id             = %[1]q
+  name               = %[2]q
+  managed_separately = ["teams"]
+}
+
+resource "mongodbatlas_team_project_assignment" "test" {
+  project_id = mongodbatlas_project.test.id
+  team_id    = %[3]q
+  role_names = [%[4]q]
+}
+`, orgID, projectName, teamID, roleName)
+}
+
+func checkAssignment(teamID, roleName string) resource.TestCheckFunc {
+	return resource.ComposeAggregateTestCheckFunc(
+		checkExists(resourceName, roleName),
+		resource.TestCheckResourceAttr(resourceName, "team_id", teamID),
+		resource.TestCheckResourceAttr(resourceName, "role_names.#", "1"),
+		resource.TestCheckTypeSetElemAttr(resourceName, "role_names.*", roleName),
+	)
+}
+
+func checkExists(resourceName, roleName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+		projectID, teamID := rs.Primary.Attributes["project_id"], rs.Primary.Attributes["team_id"]
+		teams, _, err := acc.ConnV2().TeamsApi.ListProjectTeams(context.Background(), projectID).Execute()
+		if err != nil {
+			return fmt.Errorf("teams of project (%s) could not be read: %w", projectID, err)
+		}
+		team := teamprojectassignment.FindTeam(teams.GetResults(), teamID)
+		if team == nil {
+			return fmt.Errorf("team (%s) is not assigned to project (%s)", teamID, projectID)
+		}
+		if !slices.Contains(team.GetRoleNames(), roleName) {
+			return fmt.Errorf("team (%s) doesn't have role %s in project (%s)", teamID, roleName, projectID)
+		}
+		return nil
+	}
+}
+
+func importStateIDFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", resourceName)
+		}
+		return fmt.Sprintf("%s-%s", rs.Primary.Attributes["project_id"], rs.Primary.Attributes["team_id"]), nil
+	}
+}
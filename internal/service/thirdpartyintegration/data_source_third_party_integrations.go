@@ -203,63 +203,3 @@ func schemaToIntegration(in *schema.ResourceData) (out *admin.ThirdPartyIntegrat
 
 	return out
 }
-
-func updateIntegrationFromSchema(d *schema.ResourceData, integration *admin.ThirdPartyIntegration) {
-	integration.ApiKey = conversion.StringPtr(d.Get("api_key").(string))
-
-	if d.HasChange("region") {
-		integration.Region = conversion.StringPtr(d.Get("region").(string))
-	}
-
-	if d.HasChange("service_key") {
-		integration.ServiceKey = conversion.StringPtr(d.Get("service_key").(string))
-	}
-
-	if d.HasChange("team_name") {
-		integration.TeamName = conversion.StringPtr(d.Get("team_name").(string))
-	}
-
-	if d.HasChange("channel_name") {
-		integration.ChannelName = conversion.StringPtr(d.Get("channel_name").(string))
-	}
-
-	if d.HasChange("routing_key") {
-		integration.RoutingKey = conversion.StringPtr(d.Get("routing_key").(string))
-	}
-
-	if d.HasChange("url") {
-		integration.Url = conversion.StringPtr(d.Get("url").(string))
-	}
-
-	if d.HasChange("secret") {
-		integration.Secret = conversion.StringPtr(d.Get("secret").(string))
-	}
-
-	if d.HasChange("microsoft_teams_webhook_url") {
-		integration.MicrosoftTeamsWebhookUrl = conversion.StringPtr(d.Get("microsoft_teams_webhook_url").(string))
-	}
-
-	if d.HasChange("user_name") {
-		integration.Username = conversion.StringPtr(d.Get("user_name").(string))
-	}
-
-	if d.HasChange("password") {
-		integration.Password = conversion.StringPtr(d.Get("password").(string))
-	}
-
-	if d.HasChange("service_discovery") {
-		integration.ServiceDiscovery = conversion.StringPtr(d.Get("service_discovery").(string))
-	}
-
-	if d.HasChange("enabled") {
-		integration.Enabled = admin.PtrBool(d.Get("enabled").(bool))
-	}
-
-	if d.HasChange("send_collection_latency_metrics") {
-		integration.SendCollectionLatencyMetrics = admin.PtrBool(d.Get("send_collection_latency_metrics").(bool))
-	}
-
-	if d.HasChange("send_database_metrics") {
-		integration.SendDatabaseMetrics = admin.PtrBool(d.Get("send_database_metrics").(bool))
-	}
-}
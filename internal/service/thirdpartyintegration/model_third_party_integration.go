@@ -0,0 +1,304 @@
+package thirdpartyintegration
+
+import (
+	"fmt"
+	"strings"
+
+	"go.mongodb.org/atlas-sdk/v20250312003/admin"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// redactedMarker is part of every secret returned by Atlas, e.g. ****************************1234.
+const redactedMarker = "****"
+
+type TFThirdPartyIntegrationModel struct {
+	ID                           types.String `tfsdk:"id"`
+	ProjectID                    types.String `tfsdk:"project_id"`
+	Type                         types.String `tfsdk:"type"`
+	APIKey                       types.String `tfsdk:"api_key"`
+	APIKeyWO                     types.String `tfsdk:"api_key_wo"`
+	Region                       types.String `tfsdk:"region"`
+	ServiceKey                   types.String `tfsdk:"service_key"`
+	ServiceKeyWO                 types.String `tfsdk:"service_key_wo"`
+	TeamName                     types.String `tfsdk:"team_name"`
+	ChannelName                  types.String `tfsdk:"channel_name"`
+	RoutingKey                   types.String `tfsdk:"routing_key"`
+	RoutingKeyWO                 types.String `tfsdk:"routing_key_wo"`
+	URL                          types.String `tfsdk:"url"`
+	Secret                       types.String `tfsdk:"secret"`
+	SecretWO                     types.String `tfsdk:"secret_wo"`
+	MicrosoftTeamsWebhookURL     types.String `tfsdk:"microsoft_teams_webhook_url"`
+	MicrosoftTeamsWebhookURLWO   types.String `tfsdk:"microsoft_teams_webhook_url_wo"`
+	UserName                     types.String `tfsdk:"user_name"`
+	Password                     types.String `tfsdk:"password"`
+	PasswordWO                   types.String `tfsdk:"password_wo"`
+	ServiceDiscovery             types.String `tfsdk:"service_discovery"`
+	SecretsVersion               types.Int64  `tfsdk:"secrets_version"`
+	Enabled                      types.Bool   `tfsdk:"enabled"`
+	SendCollectionLatencyMetrics types.Bool   `tfsdk:"send_collection_latency_metrics"`
+	SendDatabaseMetrics          types.Bool   `tfsdk:"send_database_metrics"`
+}
+
+// APISecret is the value returned by Atlas for a secret attribute after the last write, kept in private state to detect rotations
+// done outside of Terraform. WriteOnly is true when the secret was sent through its write-only attribute.
+type APISecret struct {
+	Value     string `json:"value"`
+	WriteOnly bool   `json:"writeOnly,omitempty"`
+}
+
+// APISecrets is keyed by the name of the plain secret attribute.
+type APISecrets map[string]APISecret
+
+// secretAttr is an attribute that Atlas returns redacted. writeOnly is nil for attributes without a write-only alternative.
+type secretAttr struct {
+	plain         func(*TFThirdPartyIntegrationModel) *types.String
+	writeOnly     func(*TFThirdPartyIntegrationModel) *types.String
+	api           func(*admin.ThirdPartyIntegration) **string
+	name          string
+	writeOnlyName string
+}
+
+var secretAttrs = []secretAttr{
+	{
+		name:          "api_key",
+		writeOnlyName: "api_key_wo",
+		plain:         func(m *TFThirdPartyIntegrationModel) *types.String { return &m.APIKey },
+		writeOnly:     func(m *TFThirdPartyIntegrationModel) *types.String { return &m.APIKeyWO },
+		api:           func(i *admin.ThirdPartyIntegration) **string { return &i.ApiKey },
+	},
+	{
+		name:          "service_key",
+		writeOnlyName: "service_key_wo",
+		plain:         func(m *TFThirdPartyIntegrationModel) *types.String { return &m.ServiceKey },
+		writeOnly:     func(m *TFThirdPartyIntegrationModel) *types.String { return &m.ServiceKeyWO },
+		api:           func(i *admin.ThirdPartyIntegration) **string { return &i.ServiceKey },
+	},
+	{
+		name:          "routing_key",
+		writeOnlyName: "routing_key_wo",
+		plain:         func(m *TFThirdPartyIntegrationModel) *types.String { return &m.RoutingKey },
+		writeOnly:     func(m *TFThirdPartyIntegrationModel) *types.String { return &m.RoutingKeyWO },
+		api:           func(i *admin.ThirdPartyIntegration) **string { return &i.RoutingKey },
+	},
+	{
+		name:          "secret",
+		writeOnlyName: "secret_wo",
+		plain:         func(m *TFThirdPartyIntegrationModel) *types.String { return &m.Secret },
+		writeOnly:     func(m *TFThirdPartyIntegrationModel) *types.String { return &m.SecretWO },
+		api:           func(i *admin.ThirdPartyIntegration) **string { return &i.Secret },
+	},
+	{
+		name:          "microsoft_teams_webhook_url",
+		writeOnlyName: "microsoft_teams_webhook_url_wo",
+		plain:         func(m *TFThirdPartyIntegrationModel) *types.String { return &m.MicrosoftTeamsWebhookURL },
+		writeOnly:     func(m *TFThirdPartyIntegrationModel) *types.String { return &m.MicrosoftTeamsWebhookURLWO },
+		api:           func(i *admin.ThirdPartyIntegration) **string { return &i.MicrosoftTeamsWebhookUrl },
+	},
+	{
+		name:          "password",
+		writeOnlyName: "password_wo",
+		plain:         func(m *TFThirdPartyIntegrationModel) *types.String { return &m.Password },
+		writeOnly:     func(m *TFThirdPartyIntegrationModel) *types.String { return &m.PasswordWO },
+		api:           func(i *admin.ThirdPartyIntegration) **string { return &i.Password },
+	},
+	{
+		name:  "url",
+		plain: func(m *TFThirdPartyIntegrationModel) *types.String { return &m.URL },
+		api:   func(i *admin.ThirdPartyIntegration) **string { return &i.Url },
+	},
+}
+
+func IsRedacted(value string) bool {
+	return strings.Contains(value, redactedMarker)
+}
+
+// CopyWriteOnly copies the write-only attributes from the config, they are always null in plan and state.
+func CopyWriteOnly(model, config *TFThirdPartyIntegrationModel) {
+	for _, secret := range secretAttrs {
+		if secret.writeOnly != nil {
+			*secret.writeOnly(model) = *secret.writeOnly(config)
+		}
+	}
+}
+
+func NewThirdPartyIntegrationReq(model *TFThirdPartyIntegrationModel) *admin.ThirdPartyIntegration {
+	req := &admin.ThirdPartyIntegration{
+		Type:                         model.Type.ValueStringPointer(),
+		Region:                       stringPtr(model.Region),
+		TeamName:                     stringPtr(model.TeamName),
+		ChannelName:                  stringPtr(model.ChannelName),
+		Username:                     stringPtr(model.UserName),
+		ServiceDiscovery:             stringPtr(model.ServiceDiscovery),
+		Enabled:                      boolPtr(model.Enabled),
+		SendCollectionLatencyMetrics: boolPtr(model.SendCollectionLatencyMetrics),
+		SendDatabaseMetrics:          boolPtr(model.SendDatabaseMetrics),
+	}
+	for _, secret := range secretAttrs {
+		value := *secret.plain(model)
+		if secret.writeOnly != nil && !secret.writeOnly(model).IsNull() {
+			value = *secret.writeOnly(model)
+		}
+		// a redacted value in state can only come from an import or a rotation outside Terraform, Atlas must keep the current secret
+		if ptr := stringPtr(value); ptr != nil && !IsRedacted(*ptr) {
+			*secret.api(req) = ptr
+		}
+	}
+	return req
+}
+
+// NewThirdPartyIntegrationUpdateReq keeps the current values of the attributes not set in the model, Atlas replaces the whole integration.
+func NewThirdPartyIntegrationUpdateReq(current *admin.ThirdPartyIntegration, model *TFThirdPartyIntegrationModel) *admin.ThirdPartyIntegration {
+	req := NewThirdPartyIntegrationReq(model)
+	keepCurrent(&req.Region, current.Region)
+	keepCurrent(&req.TeamName, current.TeamName)
+	keepCurrent(&req.ChannelName, current.ChannelName)
+	keepCurrent(&req.Username, current.Username)
+	keepCurrent(&req.ServiceDiscovery, current.ServiceDiscovery)
+	keepCurrent(&req.Enabled, current.Enabled)
+	keepCurrent(&req.SendCollectionLatencyMetrics, current.SendCollectionLatencyMetrics)
+	keepCurrent(&req.SendDatabaseMetrics, current.SendDatabaseMetrics)
+	for _, secret := range secretAttrs {
+		keepCurrent(secret.api(req), *secret.api(current))
+	}
+	return req
+}
+
+// NewTFModelAfterWrite returns the model to store after a create or update. Configured values are kept as Atlas returns secrets redacted,
+// unknown values are resolved from the API response. It also returns the secrets as returned by Atlas to store in private state.
+func NewTFModelAfterWrite(plan *TFThirdPartyIntegrationModel, integration *admin.ThirdPartyIntegration) (*TFThirdPartyIntegrationModel, APISecrets) {
+	model := *plan
+	model.ID = types.StringPointerValue(integration.Id)
+	mergeString(&model.Region, integration.Region, true)
+	mergeString(&model.TeamName, integration.TeamName, true)
+	mergeString(&model.ChannelName, integration.ChannelName, true)
+	mergeString(&model.UserName, integration.Username, true)
+	mergeString(&model.ServiceDiscovery, integration.ServiceDiscovery, true)
+	mergeBool(&model.Enabled, integration.Enabled, true)
+	mergeBool(&model.SendCollectionLatencyMetrics, integration.SendCollectionLatencyMetrics, true)
+	mergeBool(&model.SendDatabaseMetrics, integration.SendDatabaseMetrics, true)
+
+	apiSecrets := APISecrets{}
+	for _, secret := range secretAttrs {
+		apiValue := *secret.api(integration)
+		mergeString(secret.plain(&model), apiValue, true)
+		if apiValue != nil && *apiValue != "" {
+			writeOnly := secret.writeOnly != nil && !secret.writeOnly(plan).IsNull()
+			apiSecrets[secret.name] = APISecret{Value: *apiValue, WriteOnly: writeOnly}
+		}
+	}
+	clearWriteOnly(&model)
+	return &model, apiSecrets
+}
+
+// NewTFModelFromRead returns the model to store after a refresh. Redacted secrets don't overwrite the values in state unless they differ
+// from the ones returned after the last write, which means that the secret was rotated outside Terraform. A warning is returned in that case.
+// The returned secrets include the ones seen for the first time, e.g. after an import or an upgrade from a previous provider version.
+// Write-only secrets keep the last written value so the warning is shown until secrets_version is increased.
+func NewTFModelFromRead(state *TFThirdPartyIntegrationModel, integration *admin.ThirdPartyIntegration, lastSeen APISecrets) (*TFThirdPartyIntegrationModel, APISecrets, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	model := *state
+	model.ID = types.StringPointerValue(integration.Id)
+	mergeString(&model.Region, integration.Region, false)
+	mergeString(&model.TeamName, integration.TeamName, false)
+	mergeString(&model.ChannelName, integration.ChannelName, false)
+	mergeString(&model.UserName, integration.Username, false)
+	mergeString(&model.ServiceDiscovery, integration.ServiceDiscovery, false)
+	mergeBool(&model.Enabled, integration.Enabled, false)
+	mergeBool(&model.SendCollectionLatencyMetrics, integration.SendCollectionLatencyMetrics, false)
+	mergeBool(&model.SendDatabaseMetrics, integration.SendDatabaseMetrics, false)
+
+	apiSecrets := APISecrets{}
+	for name, value := range lastSeen {
+		apiSecrets[name] = value
+	}
+	for _, secret := range secretAttrs {
+		apiValue := *secret.api(integration)
+		plain := secret.plain(&model)
+		if apiValue == nil || *apiValue == "" {
+			continue // Atlas doesn't return some secrets, e.g. secret, keep the value in state
+		}
+		if !IsRedacted(*apiValue) {
+			*plain = types.StringValue(*apiValue)
+			continue
+		}
+		previous, found := lastSeen[secret.name]
+		switch {
+		case !found:
+			if plain.IsNull() || plain.ValueString() == "" {
+				*plain = types.StringValue(*apiValue)
+			}
+			apiSecrets[secret.name] = APISecret{Value: *apiValue}
+		case previous.Value == *apiValue:
+			continue
+		case previous.WriteOnly:
+			diags.AddAttributeWarning(path.Root(secret.writeOnlyName), "Secret changed outside Terraform",
+				fmt.Sprintf("%s returned by Atlas (%s) doesn't match the value set by Terraform. Increase secrets_version to apply the value in %s again.", secret.name, *apiValue, secret.writeOnlyName))
+		default:
+			*plain = types.StringValue(*apiValue)
+			apiSecrets[secret.name] = APISecret{Value: *apiValue}
+			diags.AddAttributeWarning(path.Root(secret.name), "Secret changed outside Terraform",
+				fmt.Sprintf("%s returned by Atlas (%s) doesn't match the value set by Terraform, the value in the configuration will be applied in the next apply.", secret.name, *apiValue))
+		}
+	}
+	clearWriteOnly(&model)
+	return &model, apiSecrets, diags
+}
+
+func clearWriteOnly(model *TFThirdPartyIntegrationModel) {
+	for _, secret := range secretAttrs {
+		if secret.writeOnly != nil {
+			*secret.writeOnly(model) = types.StringNull()
+		}
+	}
+}
+
+// mergeString keeps the current value when the API doesn't return one. After a write, known values are also kept so the result
+// is consistent with the plan.
+func mergeString(current *types.String, apiValue *string, afterWrite bool) {
+	if apiValue == nil || *apiValue == "" {
+		if current.IsUnknown() {
+			*current = types.StringNull()
+		}
+		return
+	}
+	if afterWrite && !current.IsUnknown() {
+		return
+	}
+	*current = types.StringValue(*apiValue)
+}
+
+func mergeBool(current *types.Bool, apiValue *bool, afterWrite bool) {
+	if apiValue == nil {
+		if current.IsUnknown() {
+			*current = types.BoolNull()
+		}
+		return
+	}
+	if afterWrite && !current.IsUnknown() {
+		return
+	}
+	*current = types.BoolValue(*apiValue)
+}
+
+func keepCurrent[T any](target **T, current *T) {
+	if *target == nil {
+		*target = current
+	}
+}
+
+func stringPtr(value types.String) *string {
+	if value.IsNull() || value.IsUnknown() || value.ValueString() == "" {
+		return nil
+	}
+	return value.ValueStringPointer()
+}
+
+func boolPtr(value types.Bool) *bool {
+	if value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+	return value.ValueBoolPointer()
+}
@@ -0,0 +1,203 @@
+package thirdpartyintegration_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/atlas-sdk/v20250312003/admin"
+
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/service/thirdpartyintegration"
+)
+
+const (
+	integrationID   = "6800000000000000000000aa"
+	redactedKey     = "****************************1111"
+	redactedRotated = "****************************2222"
+)
+
+func TestIsRedacted(t *testing.T) {
+	assert.True(t, thirdpartyintegration.IsRedacted(redactedKey))
+	assert.True(t, thirdpartyintegration.IsRedacted("https://hooks.example.com/****"))
+	assert.False(t, thirdpartyintegration.IsRedacted("11111111111111111111111111111111"))
+	assert.False(t, thirdpartyintegration.IsRedacted(""))
+}
+
+func TestNewThirdPartyIntegrationReq(t *testing.T) {
+	testCases := map[string]struct {
+		model    thirdpartyintegration.TFThirdPartyIntegrationModel
+		expected *admin.ThirdPartyIntegration
+	}{
+		"plain secret": {
+			model: thirdpartyintegration.TFThirdPartyIntegrationModel{
+				Type:   types.StringValue("DATADOG"),
+				APIKey: types.StringValue("key"),
+				Region: types.StringValue("US"),
+			},
+			expected: &admin.ThirdPartyIntegration{Type: admin.PtrString("DATADOG"), ApiKey: admin.PtrString("key"), Region: admin.PtrString("US")},
+		},
+		"write-only secret has precedence over redacted state": {
+			model: thirdpartyintegration.TFThirdPartyIntegrationModel{
+				Type:         types.StringValue("PAGER_DUTY"),
+				ServiceKey:   types.StringValue(redactedKey),
+				ServiceKeyWO: types.StringValue("new-key"),
+			},
+			expected: &admin.ThirdPartyIntegration{Type: admin.PtrString("PAGER_DUTY"), ServiceKey: admin.PtrString("new-key")},
+		},
+		"redacted and unknown values are not sent": {
+			model: thirdpartyintegration.TFThirdPartyIntegrationModel{
+				Type:       types.StringValue("VICTOR_OPS"),
+				APIKey:     types.StringValue("key"),
+				RoutingKey: types.StringValue(redactedKey),
+				Region:     types.StringUnknown(),
+				Enabled:    types.BoolUnknown(),
+			},
+			expected: &admin.ThirdPartyIntegration{Type: admin.PtrString("VICTOR_OPS"), ApiKey: admin.PtrString("key")},
+		},
+		"prometheus": {
+			model: thirdpartyintegration.TFThirdPartyIntegrationModel{
+				Type:             types.StringValue("PROMETHEUS"),
+				UserName:         types.StringValue("user"),
+				PasswordWO:       types.StringValue("password"),
+				ServiceDiscovery: types.StringValue("http"),
+				Enabled:          types.BoolValue(true),
+			},
+			expected: &admin.ThirdPartyIntegration{
+				Type:             admin.PtrString("PROMETHEUS"),
+				Username:         admin.PtrString("user"),
+				Password:         admin.PtrString("password"),
+				ServiceDiscovery: admin.PtrString("http"),
+				Enabled:          admin.PtrBool(true),
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, thirdpartyintegration.NewThirdPartyIntegrationReq(&tc.model))
+		})
+	}
+}
+
+func TestNewThirdPartyIntegrationUpdateReq(t *testing.T) {
+	current := &admin.ThirdPartyIntegration{
+		Id:         admin.PtrString(integrationID),
+		Type:       admin.PtrString("VICTOR_OPS"),
+		ApiKey:     admin.PtrString(redactedKey),
+		RoutingKey: admin.PtrString(redactedKey),
+	}
+	model := thirdpartyintegration.TFThirdPartyIntegrationModel{
+		Type:     types.StringValue("VICTOR_OPS"),
+		APIKeyWO: types.StringValue("new-key"),
+	}
+	expected := &admin.ThirdPartyIntegration{
+		Type:       admin.PtrString("VICTOR_OPS"),
+		ApiKey:     admin.PtrString("new-key"),
+		RoutingKey: admin.PtrString(redactedKey),
+	}
+	assert.Equal(t, expected, thirdpartyintegration.NewThirdPartyIntegrationUpdateReq(current, &model))
+}
+
+func TestNewTFModelAfterWrite(t *testing.T) {
+	plan := thirdpartyintegration.TFThirdPartyIntegrationModel{
+		ID:         types.StringUnknown(),
+		Type:       types.StringValue("VICTOR_OPS"),
+		APIKey:     types.StringValue("plain-key"),
+		RoutingKey: types.StringUnknown(),
+		Secret:     types.StringNull(),
+		SecretWO:   types.StringValue("write-only-secret"),
+		Region:     types.StringUnknown(),
+		Enabled:    types.BoolUnknown(),
+	}
+	integration := &admin.ThirdPartyIntegration{
+		Id:         admin.PtrString(integrationID),
+		ApiKey:     admin.PtrString(redactedKey),
+		RoutingKey: admin.PtrString(redactedRotated),
+		Secret:     admin.PtrString(redactedKey),
+	}
+	model, apiSecrets := thirdpartyintegration.NewTFModelAfterWrite(&plan, integration)
+	assert.Equal(t, integrationID, model.ID.ValueString())
+	assert.Equal(t, "plain-key", model.APIKey.ValueString(), "configured secret must be kept")
+	assert.Equal(t, redactedRotated, model.RoutingKey.ValueString(), "unknown secret is resolved from the API")
+	assert.True(t, model.Secret.IsNull(), "secret is not computed")
+	assert.True(t, model.SecretWO.IsNull(), "write-only values are never stored")
+	assert.True(t, model.Region.IsNull())
+	assert.True(t, model.Enabled.IsNull())
+	assert.Equal(t, thirdpartyintegration.APISecrets{
+		"api_key":     {Value: redactedKey},
+		"routing_key": {Value: redactedRotated},
+		"secret":      {Value: redactedKey, WriteOnly: true},
+	}, apiSecrets)
+}
+
+func TestNewTFModelFromRead(t *testing.T) {
+	testCases := map[string]struct {
+		state          thirdpartyintegration.TFThirdPartyIntegrationModel
+		integration    admin.ThirdPartyIntegration
+		lastSeen       thirdpartyintegration.APISecrets
+		expectedAPIKey types.String
+		expectedSeen   thirdpartyintegration.APISecrets
+		warningPath    string
+	}{
+		"redacted value matching last write keeps state": {
+			state:          thirdpartyintegration.TFThirdPartyIntegrationModel{APIKey: types.StringValue("plain-key")},
+			integration:    admin.ThirdPartyIntegration{ApiKey: admin.PtrString(redactedKey)},
+			lastSeen:       thirdpartyintegration.APISecrets{"api_key": {Value: redactedKey}},
+			expectedAPIKey: types.StringValue("plain-key"),
+			expectedSeen:   thirdpartyintegration.APISecrets{"api_key": {Value: redactedKey}},
+		},
+		"plain secret rotated outside terraform": {
+			state:          thirdpartyintegration.TFThirdPartyIntegrationModel{APIKey: types.StringValue("plain-key")},
+			integration:    admin.ThirdPartyIntegration{ApiKey: admin.PtrString(redactedRotated)},
+			lastSeen:       thirdpartyintegration.APISecrets{"api_key": {Value: redactedKey}},
+			expectedAPIKey: types.StringValue(redactedRotated),
+			expectedSeen:   thirdpartyintegration.APISecrets{"api_key": {Value: redactedRotated}},
+			warningPath:    "api_key",
+		},
+		"write-only secret rotated outside terraform": {
+			state:          thirdpartyintegration.TFThirdPartyIntegrationModel{APIKey: types.StringValue(redactedKey)},
+			integration:    admin.ThirdPartyIntegration{ApiKey: admin.PtrString(redactedRotated)},
+			lastSeen:       thirdpartyintegration.APISecrets{"api_key": {Value: redactedKey, WriteOnly: true}},
+			expectedAPIKey: types.StringValue(redactedKey),
+			expectedSeen:   thirdpartyintegration.APISecrets{"api_key": {Value: redactedKey, WriteOnly: true}},
+			warningPath:    "api_key_wo",
+		},
+		"state from previous provider version keeps plain value": {
+			state:          thirdpartyintegration.TFThirdPartyIntegrationModel{APIKey: types.StringValue("plain-key")},
+			integration:    admin.ThirdPartyIntegration{ApiKey: admin.PtrString(redactedKey)},
+			expectedAPIKey: types.StringValue("plain-key"),
+			expectedSeen:   thirdpartyintegration.APISecrets{"api_key": {Value: redactedKey}},
+		},
+		"import uses redacted value": {
+			state:          thirdpartyintegration.TFThirdPartyIntegrationModel{APIKey: types.StringNull()},
+			integration:    admin.ThirdPartyIntegration{ApiKey: admin.PtrString(redactedKey)},
+			expectedAPIKey: types.StringValue(redactedKey),
+			expectedSeen:   thirdpartyintegration.APISecrets{"api_key": {Value: redactedKey}},
+		},
+		"secret not returned keeps state": {
+			state:          thirdpartyintegration.TFThirdPartyIntegrationModel{APIKey: types.StringValue("plain-key")},
+			expectedAPIKey: types.StringValue("plain-key"),
+			expectedSeen:   thirdpartyintegration.APISecrets{},
+		},
+		"not redacted value is used": {
+			state:          thirdpartyintegration.TFThirdPartyIntegrationModel{APIKey: types.StringValue("plain-key")},
+			integration:    admin.ThirdPartyIntegration{ApiKey: admin.PtrString("other-key")},
+			expectedAPIKey: types.StringValue("other-key"),
+			expectedSeen:   thirdpartyintegration.APISecrets{},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			model, apiSecrets, diags := thirdpartyintegration.NewTFModelFromRead(&tc.state, &tc.integration, tc.lastSeen)
+			assert.False(t, diags.HasError())
+			assert.Equal(t, tc.expectedAPIKey, model.APIKey)
+			assert.Equal(t, tc.expectedSeen, apiSecrets)
+			if tc.warningPath == "" {
+				assert.Zero(t, diags.WarningsCount())
+				return
+			}
+			if assert.Equal(t, 1, diags.WarningsCount()) {
+				assert.Contains(t, diags.Warnings()[0].Detail(), tc.warningPath)
+			}
+		})
+	}
+}
@@ -0,0 +1,105 @@
+package thirdpartyintegration
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+func ResourceSchema(ctx context.Context) schema.Schema {
+	attrs := map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed: true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"project_id": schema.StringAttribute{
+			Required: true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"type": schema.StringAttribute{
+			Required: true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+			Validators: []validator.String{
+				validateIntegrationType(),
+			},
+		},
+		"region":            optionalComputedString(false),
+		"team_name":         optionalComputedString(false),
+		"channel_name":      optionalComputedString(false),
+		"url":               optionalComputedString(false),
+		"user_name":         optionalComputedString(true),
+		"service_discovery": optionalComputedString(true),
+		"secret": schema.StringAttribute{
+			Optional:  true,
+			Sensitive: true,
+			Validators: []validator.String{
+				stringvalidator.ConflictsWith(path.MatchRoot("secret_wo")),
+			},
+		},
+		"secrets_version": schema.Int64Attribute{
+			Optional: true,
+		},
+		"enabled":                         optionalComputedBool(),
+		"send_collection_latency_metrics": optionalComputedBool(),
+		"send_database_metrics":           optionalComputedBool(),
+	}
+	for _, secret := range secretAttrs {
+		if secret.writeOnlyName == "" {
+			continue
+		}
+		attrs[secret.writeOnlyName] = schema.StringAttribute{
+			Optional:  true,
+			Sensitive: true,
+			WriteOnly: true,
+			Validators: []validator.String{
+				stringvalidator.ConflictsWith(path.MatchRoot(secret.name)),
+			},
+		}
+		if secret.name == "secret" {
+			continue // secret isn't computed, defined above
+		}
+		// no UseStateForUnknown as Atlas returns a new redacted value when the secret is rotated with the write-only attribute
+		attrs[secret.name] = schema.StringAttribute{
+			Optional:  true,
+			Computed:  true,
+			Sensitive: true,
+			Validators: []validator.String{
+				stringvalidator.ConflictsWith(path.MatchRoot(secret.writeOnlyName)),
+			},
+		}
+	}
+	return schema.Schema{Attributes: attrs}
+}
+
+func optionalComputedString(sensitive bool) schema.StringAttribute {
+	return schema.StringAttribute{
+		Optional:  true,
+		Computed:  true,
+		Sensitive: sensitive,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+}
+
+func optionalComputedBool() schema.BoolAttribute {
+	return schema.BoolAttribute{
+		Optional: true,
+		Computed: true,
+		PlanModifiers: []planmodifier.Bool{
+			boolplanmodifier.UseStateForUnknown(),
+		},
+	}
+}
@@ -2,17 +2,34 @@ package thirdpartyintegration
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"regexp"
 
-	"github.com/hashicorp/go-cty/cty"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/conversion"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/validate"
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/config"
 )
 
+const (
+	resourceName     = "third_party_integration"
+	privateSecrets   = "api_secrets"
+	errorCreate      = "error creating third party integration %s"
+	errorRead        = "error getting third party integration resource info %s"
+	errorUpdate      = "error updating third party integration type %s"
+	errorDelete      = "error deleting third party integration type %s"
+	errorImport      = "couldn't import third party integration (%s) in project(%s)"
+	errorPrivateData = "error handling private state of third party integration"
+)
+
 var integrationTypes = []string{
 	"PAGER_DUTY",
 	"DATADOG",
@@ -33,236 +50,210 @@ var requiredPerType = map[string][]string{
 	"PROMETHEUS":      {"user_name", "password", "service_discovery", "enabled"},
 }
 
-func Resource() *schema.Resource {
-	return &schema.Resource{
-		CreateContext: resourceMongoDBAtlasThirdPartyIntegrationCreate,
-		ReadContext:   resourceMongoDBAtlasThirdPartyIntegrationRead,
-		UpdateContext: resourceMongoDBAtlasThirdPartyIntegrationUpdate,
-		DeleteContext: resourceMongoDBAtlasThirdPartyIntegrationDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: resourceMongoDBAtlasThirdPartyIntegrationImportState,
-		},
-		Schema: map[string]*schema.Schema{
-			"id": {
-				Type:     schema.TypeString,
-				Computed: true,
-			},
-			"project_id": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
-			},
-			"type": {
-				Type:             schema.TypeString,
-				Required:         true,
-				ForceNew:         true,
-				ValidateDiagFunc: validateIntegrationType(),
-			},
-			"api_key": {
-				Type:      schema.TypeString,
-				Optional:  true,
-				Computed:  true,
-				Sensitive: true,
-			},
-			"region": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
-			},
-			"service_key": {
-				Type:      schema.TypeString,
-				Optional:  true,
-				Computed:  true,
-				Sensitive: true,
-			},
-			"team_name": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
-			},
-			"channel_name": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
-			},
-			"routing_key": {
-				Type:      schema.TypeString,
-				Optional:  true,
-				Computed:  true,
-				Sensitive: true,
-			},
-			"url": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
-			},
-			"secret": {
-				Type:      schema.TypeString,
-				Optional:  true,
-				Sensitive: true,
-			},
-			"microsoft_teams_webhook_url": {
-				Type:      schema.TypeString,
-				Optional:  true,
-				Computed:  true,
-				Sensitive: true,
-			},
-			"user_name": {
-				Type:      schema.TypeString,
-				Optional:  true,
-				Computed:  true,
-				Sensitive: true,
-			},
-			"password": {
-				Type:      schema.TypeString,
-				Optional:  true,
-				Computed:  true,
-				Sensitive: true,
-			},
-			"service_discovery": {
-				Type:      schema.TypeString,
-				Optional:  true,
-				Computed:  true,
-				Sensitive: true,
-			},
-			"enabled": {
-				Type:     schema.TypeBool,
-				Computed: true,
-				Optional: true,
-			},
-			"send_collection_latency_metrics": {
-				Type:     schema.TypeBool,
-				Computed: true,
-				Optional: true,
-			},
-			"send_database_metrics": {
-				Type:     schema.TypeBool,
-				Computed: true,
-				Optional: true,
-			},
+var _ resource.ResourceWithConfigure = &rs{}
+var _ resource.ResourceWithImportState = &rs{}
+var _ resource.ResourceWithValidateConfig = &rs{}
+
+func Resource() resource.Resource {
+	return &rs{
+		RSCommon: config.RSCommon{
+			ResourceName: resourceName,
 		},
 	}
 }
 
-func resourceMongoDBAtlasThirdPartyIntegrationCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	connV2 := meta.(*config.MongoDBClient).AtlasV2
-	projectID := d.Get("project_id").(string)
-	integrationType := d.Get("type").(string)
+type rs struct {
+	config.RSCommon
+}
+
+// privateData is implemented by the private state of the framework requests and responses.
+type privateData interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
 
-	// checking per type fields
-	if requiredSet, ok := requiredPerType[integrationType]; ok {
-		for _, key := range requiredSet {
-			_, valid := d.GetOk(key)
+func (r *rs) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = ResourceSchema(ctx)
+	conversion.UpdateSchemaDescription(&resp.Schema)
+}
 
-			if !valid {
-				return diag.FromErr(fmt.Errorf("error attribute for third party integration %s. please set it", key))
+func (r *rs) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var integrationType types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("type"), &integrationType)...)
+	if resp.Diagnostics.HasError() || integrationType.IsNull() || integrationType.IsUnknown() {
+		return
+	}
+	for _, name := range requiredPerType[integrationType.ValueString()] {
+		names := []string{name}
+		for _, secret := range secretAttrs {
+			if secret.name == name && secret.writeOnlyName != "" {
+				names = append(names, secret.writeOnlyName)
 			}
 		}
+		if !isAnyAttributeSet(ctx, req, resp, names) {
+			resp.Diagnostics.AddAttributeError(path.Root(name), "Missing third party integration attribute",
+				fmt.Sprintf("%s is required when type is %s.", joinAlternatives(names), integrationType.ValueString()))
+		}
 	}
+}
 
-	requestBody := schemaToIntegration(d)
-
-	_, _, err := connV2.ThirdPartyIntegrationsApi.CreateThirdPartyIntegration(ctx, integrationType, projectID, requestBody).Execute()
-
+func (r *rs) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan, cfg TFThirdPartyIntegrationModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &cfg)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	CopyWriteOnly(&plan, &cfg)
+	connV2 := r.Client.AtlasV2
+	projectID := plan.ProjectID.ValueString()
+	integrationType := plan.Type.ValueString()
+	if _, _, err := connV2.ThirdPartyIntegrationsApi.CreateThirdPartyIntegration(ctx, integrationType, projectID, NewThirdPartyIntegrationReq(&plan)).Execute(); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf(errorCreate, integrationType), err.Error())
+		return
+	}
+	integration, _, err := connV2.ThirdPartyIntegrationsApi.GetThirdPartyIntegration(ctx, projectID, integrationType).Execute()
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error creating third party integration %s", err))
+		resp.Diagnostics.AddError(fmt.Sprintf(errorRead, integrationType), err.Error())
+		return
 	}
-
-	return resourceMongoDBAtlasThirdPartyIntegrationRead(ctx, d, meta)
+	model, apiSecrets := NewTFModelAfterWrite(&plan, integration)
+	resp.Diagnostics.Append(setAPISecrets(ctx, resp.Private, apiSecrets)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
 }
 
-func resourceMongoDBAtlasThirdPartyIntegrationRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	connV2 := meta.(*config.MongoDBClient).AtlasV2
-
-	projectID := d.Get("project_id").(string)
-	integrationType := d.Get("type").(string)
-
-	integration, resp, err := connV2.ThirdPartyIntegrationsApi.GetThirdPartyIntegration(ctx, projectID, integrationType).Execute()
+func (r *rs) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state TFThirdPartyIntegrationModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	connV2 := r.Client.AtlasV2
+	projectID := state.ProjectID.ValueString()
+	integrationType := state.Type.ValueString()
+	integration, apiResp, err := connV2.ThirdPartyIntegrationsApi.GetThirdPartyIntegration(ctx, projectID, integrationType).Execute()
 	if err != nil {
-		if validate.StatusNotFound(resp) {
-			d.SetId("")
-			return nil
+		if validate.StatusNotFound(apiResp) {
+			resp.State.RemoveResource(ctx)
+			return
 		}
-
-		return diag.FromErr(fmt.Errorf("error getting third party integration resource info %s %w", integrationType, err))
+		resp.Diagnostics.AddError(fmt.Sprintf(errorRead, integrationType), err.Error())
+		return
 	}
-
-	integrationMap := integrationToSchema(d, integration)
-
-	for key, val := range integrationMap {
-		if err := d.Set(key, val); err != nil {
-			return diag.FromErr(fmt.Errorf("error setting `%s` for third party integration (%s): %s", key, d.Id(), err))
-		}
+	lastSeen, diags := getAPISecrets(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
-
-	d.SetId(integration.GetId())
-	return nil
+	model, apiSecrets, diags := NewTFModelFromRead(&state, integration, lastSeen)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setAPISecrets(ctx, resp.Private, apiSecrets)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
 }
 
-func resourceMongoDBAtlasThirdPartyIntegrationUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	connV2 := meta.(*config.MongoDBClient).AtlasV2
-
-	projectID := d.Get("project_id").(string)
-	integrationType := d.Get("type").(string)
-
-	integration, _, err := connV2.ThirdPartyIntegrationsApi.GetThirdPartyIntegration(ctx, projectID, integrationType).Execute()
-
+func (r *rs) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, cfg TFThirdPartyIntegrationModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &cfg)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	CopyWriteOnly(&plan, &cfg)
+	connV2 := r.Client.AtlasV2
+	projectID := plan.ProjectID.ValueString()
+	integrationType := plan.Type.ValueString()
+	current, _, err := connV2.ThirdPartyIntegrationsApi.GetThirdPartyIntegration(ctx, projectID, integrationType).Execute()
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error getting third party integration resource info %s %w", integrationType, err))
+		resp.Diagnostics.AddError(fmt.Sprintf(errorRead, integrationType), err.Error())
+		return
 	}
-
-	// check for changed attributes per type
-
-	updateIntegrationFromSchema(d, integration)
-
-	_, _, err = connV2.ThirdPartyIntegrationsApi.UpdateThirdPartyIntegration(ctx, integrationType, projectID, integration).Execute()
-
+	if _, _, err := connV2.ThirdPartyIntegrationsApi.UpdateThirdPartyIntegration(ctx, integrationType, projectID, NewThirdPartyIntegrationUpdateReq(current, &plan)).Execute(); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf(errorUpdate, integrationType), err.Error())
+		return
+	}
+	integration, _, err := connV2.ThirdPartyIntegrationsApi.GetThirdPartyIntegration(ctx, projectID, integrationType).Execute()
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error updating third party integration type `%s` (%s): %w", integrationType, d.Id(), err))
+		resp.Diagnostics.AddError(fmt.Sprintf(errorRead, integrationType), err.Error())
+		return
 	}
-
-	return resourceMongoDBAtlasThirdPartyIntegrationRead(ctx, d, meta)
+	model, apiSecrets := NewTFModelAfterWrite(&plan, integration)
+	resp.Diagnostics.Append(setAPISecrets(ctx, resp.Private, apiSecrets)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
 }
 
-func resourceMongoDBAtlasThirdPartyIntegrationDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	conn := meta.(*config.MongoDBClient).Atlas
-
-	projectID := d.Get("project_id").(string)
-	integrationType := d.Get("type").(string)
-
-	_, err := conn.Integrations.Delete(ctx, projectID, integrationType)
-
-	if err != nil {
-		return diag.FromErr(fmt.Errorf("error deleting third party integration type `%s` (%s): %w", integrationType, d.Id(), err))
+func (r *rs) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state TFThirdPartyIntegrationModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	connV2 := r.Client.AtlasV2
+	integrationType := state.Type.ValueString()
+	apiResp, err := connV2.ThirdPartyIntegrationsApi.DeleteThirdPartyIntegration(ctx, integrationType, state.ProjectID.ValueString()).Execute()
+	if err != nil && !validate.StatusNotFound(apiResp) {
+		resp.Diagnostics.AddError(fmt.Sprintf(errorDelete, integrationType), err.Error())
 	}
-
-	return nil
 }
 
-func resourceMongoDBAtlasThirdPartyIntegrationImportState(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
-	connV2 := meta.(*config.MongoDBClient).AtlasV2
-
-	projectID, integrationType, err := splitIntegrationTypeID(d.Id())
-
+func (r *rs) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	projectID, integrationType, err := splitIntegrationTypeID(req.ID)
 	if err != nil {
-		return nil, err
+		resp.Diagnostics.AddError("error importing third party integration", err.Error())
+		return
+	}
+	if _, _, err := r.Client.AtlasV2.ThirdPartyIntegrationsApi.GetThirdPartyIntegration(ctx, projectID, integrationType).Execute(); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf(errorImport, integrationType, projectID), err.Error())
+		return
 	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), projectID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("type"), integrationType)...)
+}
 
-	_, _, err = connV2.ThirdPartyIntegrationsApi.GetThirdPartyIntegration(ctx, projectID, integrationType).Execute()
+func getAPISecrets(ctx context.Context, private privateData) (APISecrets, diag.Diagnostics) {
+	apiSecrets := APISecrets{}
+	value, diags := private.GetKey(ctx, privateSecrets)
+	if diags.HasError() || len(value) == 0 {
+		return apiSecrets, diags
+	}
+	if err := json.Unmarshal(value, &apiSecrets); err != nil {
+		diags.AddError(errorPrivateData, err.Error())
+	}
+	return apiSecrets, diags
+}
 
+func setAPISecrets(ctx context.Context, private privateData, apiSecrets APISecrets) diag.Diagnostics {
+	value, err := json.Marshal(apiSecrets)
 	if err != nil {
-		return nil, fmt.Errorf("couldn't import third party integration (%s) in project(%s), error: %w", integrationType, projectID, err)
+		return diag.Diagnostics{diag.NewErrorDiagnostic(errorPrivateData, err.Error())}
 	}
+	return private.SetKey(ctx, privateSecrets, value)
+}
 
-	if err := d.Set("project_id", projectID); err != nil {
-		return nil, fmt.Errorf("error setting `project_id` for third party integration (%s): %w", d.Id(), err)
+// isAnyAttributeSet returns true if any of the attributes is set in the config, unknown values are considered as set.
+// Booleans set to false are considered as unset.
+func isAnyAttributeSet(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse, names []string) bool {
+	for _, name := range names {
+		var value attr.Value
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(name), &value)...)
+		if value == nil || value.IsNull() {
+			continue
+		}
+		if b, ok := value.(types.Bool); ok && !b.IsUnknown() && !b.ValueBool() {
+			continue
+		}
+		if s, ok := value.(types.String); ok && !s.IsUnknown() && s.ValueString() == "" {
+			continue
+		}
+		return true
 	}
+	return false
+}
 
-	if err := d.Set("type", integrationType); err != nil {
-		return nil, fmt.Errorf("error setting `type` for third party integration (%s): %w", d.Id(), err)
+func joinAlternatives(names []string) string {
+	if len(names) == 1 {
+		return names[0]
 	}
-
-	return []*schema.ResourceData{d}, nil
+	return fmt.Sprintf("%s or %s", names[0], names[1])
 }
 
 // format {project_id}-{integration_type}
@@ -280,27 +271,6 @@ func splitIntegrationTypeID(id string) (projectID, integrationType string, err e
 	return
 }
 
-func validateIntegrationType() schema.SchemaValidateDiagFunc {
-	return func(v any, p cty.Path) diag.Diagnostics {
-		value := v.(string)
-		var diags diag.Diagnostics
-		if !isElementExist(integrationTypes, value) {
-			diagError := diag.Diagnostic{
-				Severity: diag.Error,
-				Summary:  "Invalid Third Party Integration type",
-				Detail:   fmt.Sprintf("Third Party integration type %q is not a valid value. Possible values are: %q.", value, integrationTypes),
-			}
-			diags = append(diags, diagError)
-		}
-		return diags
-	}
-}
-
-func isElementExist(s []string, str string) bool {
-	for _, v := range s {
-		if v == str {
-			return true
-		}
-	}
-	return false
+func validateIntegrationType() validator.String {
+	return stringvalidator.OneOf(integrationTypes...)
 }
@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 
 	"github.com/mongodb/terraform-provider-mongodbatlas/internal/testutil/acc"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/testutil/unit"
 )
 
 // dummy keys used for credential values in third party notifications
@@ -21,6 +22,8 @@ const resourceName = "mongodbatlas_third_party_integration.test"
 const dataSourceName = "data." + resourceName
 const dataSourcePluralName = "data.mongodbatlas_third_party_integrations.test"
 
+var mockConfig = unit.MockHTTPDataConfig{AllowMissingRequests: true, IsDiffMustSubstrings: []string{"/integrations"}}
+
 func TestAccThirdPartyIntegration_basicPagerDuty(t *testing.T) {
 	// basic test also include testing of plural data source which is why it cannot run in parallel
 	resource.Test(t, *basicPagerDutyTest(t))
@@ -50,6 +53,78 @@ func TestAccThirdPartyIntegration_webhook(t *testing.T) {
 	resource.ParallelTest(t, *webhookTest(t))
 }
 
+func TestAccMockableThirdPartyIntegration_pagerDuty(t *testing.T) {
+	unit.CaptureOrMockTestCaseAndRun(t, mockConfig, writeOnlyTest(t, "PAGER_DUTY", "service_key", "", "", nil))
+}
+
+func TestAccMockableThirdPartyIntegration_datadog(t *testing.T) {
+	unit.CaptureOrMockTestCaseAndRun(t, mockConfig, writeOnlyTest(t, "DATADOG", "api_key", "", `region = "US"`, map[string]string{"region": "US"}))
+}
+
+func TestAccMockableThirdPartyIntegration_opsGenie(t *testing.T) {
+	unit.CaptureOrMockTestCaseAndRun(t, mockConfig, writeOnlyTest(t, "OPS_GENIE", "api_key", "", `region = "EU"`, map[string]string{"region": "EU"}))
+}
+
+func TestAccMockableThirdPartyIntegration_victorOps(t *testing.T) {
+	unit.CaptureOrMockTestCaseAndRun(t, mockConfig, writeOnlyTest(t, "VICTOR_OPS", "api_key", "routing_key", "", nil))
+}
+
+func TestAccMockableThirdPartyIntegration_webhook(t *testing.T) {
+	unit.CaptureOrMockTestCaseAndRun(t, mockConfig, writeOnlyTest(t, "WEBHOOK", "secret", "", `url = "https://www.mongodb.com/webhook"`, map[string]string{"url": "https://www.mongodb.com/webhook"}))
+}
+
+func TestAccMockableThirdPartyIntegration_microsoftTeams(t *testing.T) {
+	unit.CaptureOrMockTestCaseAndRun(t, mockConfig, writeOnlyTest(t, "MICROSOFT_TEAMS", "microsoft_teams_webhook_url", "", "", nil))
+}
+
+func TestAccMockableThirdPartyIntegration_prometheus(t *testing.T) {
+	extraConfig := `
+		user_name         = "someuser"
+		service_discovery = "http"
+		enabled           = true`
+	unit.CaptureOrMockTestCaseAndRun(t, mockConfig, writeOnlyTest(t, "PROMETHEUS", "password", "", extraConfig, map[string]string{"user_name": "someuser", "enabled": "true"}))
+}
+
+// writeOnlyTest creates an integration with write-only secrets and rotates them by increasing secrets_version.
+// The plain secret attributes contain the redacted values returned by Atlas.
+func writeOnlyTest(tb testing.TB, intType, secretAttr, otherSecretAttr, extraConfig string, extraAttrs map[string]string) *resource.TestCase {
+	tb.Helper()
+	projectID := acc.ProjectIDExecution(tb)
+	checks := func(version int, redactedSuffix string) resource.TestCheckFunc {
+		attrs := map[string]string{
+			"type":            intType,
+			"secrets_version": fmt.Sprintf("%d", version),
+		}
+		for k, v := range extraAttrs {
+			attrs[k] = v
+		}
+		checks := acc.AddAttrChecks(resourceName, nil, attrs)
+		checks = append(checks,
+			resource.TestCheckResourceAttrSet(resourceName, "id"),
+			resource.TestCheckNoResourceAttr(resourceName, secretAttr+"_wo"),
+		)
+		if secretAttr != "secret" { // secret isn't computed
+			checks = append(checks, resource.TestCheckResourceAttr(resourceName, secretAttr, "****************************"+redactedSuffix))
+		}
+		return resource.ComposeAggregateTestCheckFunc(checks...)
+	}
+	return &resource.TestCase{
+		PreCheck:                 func() { acc.PreCheckBasic(tb) },
+		ProtoV6ProviderFactories: acc.TestAccProviderV6Factories,
+		CheckDestroy:             checkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: configWriteOnly(projectID, intType, secretAttr, otherSecretAttr, extraConfig, dummy32CharKey, 1),
+				Check:  checks(1, "1111"),
+			},
+			{
+				Config: configWriteOnly(projectID, intType, secretAttr, otherSecretAttr, extraConfig, dummy32CharKeyUpdated, 2),
+				Check:  checks(2, "1112"),
+			},
+		},
+	}
+}
+
 func basicPagerDutyTest(tb testing.TB) *resource.TestCase {
 	tb.Helper()
 	var (
@@ -523,6 +598,23 @@ func configWebhook(projectID, url string) string {
 	`, projectID, url) + singularDataStr
 }
 
+func configWriteOnly(projectID, intType, secretAttr, otherSecretAttr, extraConfig, secret string, secretsVersion int) string {
+	otherSecret := ""
+	if otherSecretAttr != "" {
+		otherSecret = fmt.Sprintf("%s_wo = %q", otherSecretAttr, secret)
+	}
+	return fmt.Sprintf(`
+	resource "mongodbatlas_third_party_integration" "test" {
+		project_id      = %[1]q
+		type            = %[2]q
+		%[3]s_wo = %[4]q
+		%[5]s
+		secrets_version = %[6]d
+		%[7]s
+	}
+	`, projectID, intType, secretAttr, secret, otherSecret, secretsVersion, extraConfig)
+}
+
 func checkExists(resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[resourceName]
@@ -6,7 +6,9 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/mongodb/terraform-provider-mongodbatlas/internal/common/dbrole"
 )
 
 func ResourceSchema(ctx context.Context) schema.Schema {
@@ -43,6 +45,9 @@ func ResourceSchema(ctx context.Context) schema.Schema {
 						},
 					},
 				},
+				Validators: []validator.List{
+					dbrole.ActionsValidator(),
+				},
 			},
 			"group_id": schema.StringAttribute{
 				Required:            true,
@@ -63,6 +68,9 @@ func ResourceSchema(ctx context.Context) schema.Schema {
 						},
 					},
 				},
+				Validators: []validator.Set{
+					dbrole.InheritedRolesValidator(),
+				},
 			},
 			"role_name": schema.StringAttribute{
 				Required:            true,
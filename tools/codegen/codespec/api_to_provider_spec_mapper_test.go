@@ -379,6 +379,12 @@ func TestConvertToProviderSpec_nested_schemaOverrides(t *testing.T) {
 							ComputedOptionalRequired: codespec.ComputedOptional,
 							String:                   &codespec.StringAttribute{},
 							Description:              conversion.StringPtr("Optional string that has config override to optional/computed"),
+							CustomValidators: []codespec.CustomValidator{
+								{
+									Definition: "stringvalidator.LengthAtLeast(1)",
+									Imports:    []string{"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"},
+								},
+							},
 						},
 						{
 							Name:                     "outer_object",
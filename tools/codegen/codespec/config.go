@@ -103,6 +103,12 @@ func applyOverrides(attr *Attribute, attrPathName string, schemaOptions config.S
 		if override.Sensitive != nil {
 			attr.Sensitive = *override.Sensitive
 		}
+		for _, v := range override.Validators {
+			attr.CustomValidators = append(attr.CustomValidators, CustomValidator{
+				Definition: v.Definition,
+				Imports:    v.Imports,
+			})
+		}
 	}
 }
 
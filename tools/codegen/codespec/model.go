@@ -75,10 +75,17 @@ type Attribute struct {
 	DeprecationMessage       *string
 	Name                     stringcase.SnakeCaseString
 	ComputedOptionalRequired ComputedOptionalRequired
+	CustomValidators         []CustomValidator
 	ReqBodyUsage             AttributeReqBodyUsage
 	Sensitive                bool
 }
 
+// CustomValidator is a validator defined in the resource config, Definition is the Go expression of the validator.
+type CustomValidator struct {
+	Definition string
+	Imports    []string
+}
+
 type AttributeReqBodyUsage int
 
 const (
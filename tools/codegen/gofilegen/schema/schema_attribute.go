@@ -112,6 +112,10 @@ func commonAttrStructure(attr *codespec.Attribute, typeDef string, specificPrope
 		properties = append(properties, specificProperties[i].Code)
 		imports = append(imports, specificProperties[i].Imports...)
 	}
+	if validators := customValidatorsProperty(attr, typeDef); validators != nil {
+		properties = append(properties, validators.Code)
+		imports = append(imports, validators.Imports...)
+	}
 
 	name := attr.Name
 	propsResultString := strings.Join(properties, ",\n") + ","
@@ -143,3 +147,27 @@ func commonProperties(attr *codespec.Attribute) []string {
 	}
 	return result
 }
+
+// customValidatorsProperty generates the Validators property from the validators defined in the resource config, e.g. schema.ListNestedAttribute uses validator.List.
+func customValidatorsProperty(attr *codespec.Attribute, typeDef string) *CodeStatement {
+	if len(attr.CustomValidators) == 0 {
+		return nil
+	}
+	validatorType := strings.TrimSuffix(strings.TrimPrefix(typeDef, "schema."), "Attribute")
+	switch validatorType {
+	case "SingleNested":
+		validatorType = "Object"
+	default:
+		validatorType = strings.TrimSuffix(validatorType, "Nested")
+	}
+	definitions := make([]string, len(attr.CustomValidators))
+	imports := []string{"github.com/hashicorp/terraform-plugin-framework/schema/validator"}
+	for i, v := range attr.CustomValidators {
+		definitions[i] = v.Definition + ","
+		imports = append(imports, v.Imports...)
+	}
+	return &CodeStatement{
+		Code:    fmt.Sprintf("Validators: []validator.%s{\n%s\n}", validatorType, strings.Join(definitions, "\n")),
+		Imports: imports,
+	}
+}
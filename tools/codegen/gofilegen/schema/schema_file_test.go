@@ -184,6 +184,65 @@ func TestSchemaGenerationFromCodeSpec(t *testing.T) {
 			withObjType:    true,
 			goldenFileName: "nested-attributes",
 		},
+		"Custom validators": {
+			inputModel: codespec.Resource{
+				Name: "test_name",
+				Schema: &codespec.Schema{
+					Attributes: []codespec.Attribute{
+						{
+							Name:                     "string_attr",
+							String:                   &codespec.StringAttribute{},
+							Description:              admin.PtrString("string description"),
+							ComputedOptionalRequired: codespec.Required,
+							CustomValidators: []codespec.CustomValidator{
+								{
+									Definition: "stringvalidator.LengthAtLeast(1)",
+									Imports:    []string{"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"},
+								},
+							},
+						},
+						{
+							Name:                     "nested_list_attr",
+							Description:              admin.PtrString("nested list attribute"),
+							ComputedOptionalRequired: codespec.Optional,
+							ListNested: &codespec.ListNestedAttribute{
+								NestedObject: codespec.NestedAttributeObject{
+									Attributes: []codespec.Attribute{stringAttr},
+								},
+							},
+							CustomValidators: []codespec.CustomValidator{
+								{
+									Definition: "listvalidator.SizeAtLeast(1)",
+									Imports:    []string{"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"},
+								},
+								{
+									Definition: "listvalidator.SizeAtMost(5)",
+									Imports:    []string{"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"},
+								},
+							},
+						},
+						{
+							Name:                     "nested_single_attr",
+							Description:              admin.PtrString("nested single attribute"),
+							ComputedOptionalRequired: codespec.Optional,
+							SingleNested: &codespec.SingleNestedAttribute{
+								NestedObject: codespec.NestedAttributeObject{
+									Attributes: []codespec.Attribute{stringAttr},
+								},
+							},
+							CustomValidators: []codespec.CustomValidator{
+								{
+									Definition: "objectvalidator.IsRequired()",
+									Imports:    []string{"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"},
+								},
+							},
+						},
+					},
+				},
+			},
+			withObjType:    false,
+			goldenFileName: "custom-validators",
+		},
 		"Timeout attribute": {
 			inputModel: codespec.Resource{
 				Name: "test_name",